@@ -1,6 +1,9 @@
 {{ define "csa.annotation.deployment" }}
 {{- end }}
 
+{{ define "csa.annotation.daemonset" }}
+{{- end }}
+
 {{ define "csa.annotation.pod" }}
 {{- if .Values.pod.extraAnnotations }}
 annotations: {{- toYaml .Values.pod.extraAnnotations | nindent 2 }}
@@ -15,3 +18,9 @@ annotations: {{- toYaml .Values.pod.extraAnnotations | nindent 2 }}
 
 {{ define "csa.annotation.clusterrolebinding" }}
 {{- end }}
+
+{{ define "csa.annotation.role" }}
+{{- end }}
+
+{{ define "csa.annotation.rolebinding" }}
+{{- end }}
@@ -12,10 +12,37 @@
 
 {{ define "csa.container.args" }}
 args:
+  {{- if or .Values.pod.nodeLocal .Values.pod.shardingReplicas }}
+  - --leader-election-enabled
+  - "false"
+  {{- else }}
   - --leader-election-enabled
   - "{{ .Values.pod.leaderElectionEnabled }}"
+  {{- end }}
   - --leader-election-resource-namespace
   - "{{ include "csa.name.namespace" . }}"
+  {{- if and .Values.pod.shardingReplicas (not .Values.pod.nodeLocal) }}
+  - --sharding-enabled
+  - "true"
+  - --sharding-lease-namespace
+  - "{{ include "csa.name.namespace" . }}"
+  {{- end }}
+  {{- if .Values.csa.class }}
+  - --class
+  - "{{ .Values.csa.class }}"
+  {{- end }}
+  {{- if .Values.csa.watchNamespaces }}
+  - --watch-namespaces
+  - "{{ join "," .Values.csa.watchNamespaces }}"
+  {{- end }}
+  {{- if .Values.csa.excludeNamespaces }}
+  - --exclude-namespaces
+  - "{{ join "," .Values.csa.excludeNamespaces }}"
+  {{- end }}
+  {{- if .Values.csa.namespaceLabelSelector }}
+  - --namespace-label-selector
+  - "{{ .Values.csa.namespaceLabelSelector }}"
+  {{- end }}
   {{- if .Values.csa.cacheSyncPeriodMins }}
   - --cache-sync-period-mins
   - "{{ .Values.csa.cacheSyncPeriodMins }}"
@@ -28,10 +55,54 @@ args:
   - --requeue-duration-secs
   - "{{ .Values.csa.requeueDurationSecs }}"
   {{- end }}
+  {{- if .Values.csa.requeueRateLimiter }}
+  - --requeue-rate-limiter
+  - "{{ .Values.csa.requeueRateLimiter }}"
+  {{- end }}
+  {{- if .Values.csa.requeueBaseDelayMillis }}
+  - --requeue-base-delay-millis
+  - "{{ .Values.csa.requeueBaseDelayMillis }}"
+  {{- end }}
+  {{- if .Values.csa.requeueMaxDelaySecs }}
+  - --requeue-max-delay-secs
+  - "{{ .Values.csa.requeueMaxDelaySecs }}"
+  {{- end }}
+  {{- if .Values.csa.requeueJitterPercent }}
+  - --requeue-jitter-percent
+  - "{{ .Values.csa.requeueJitterPercent }}"
+  {{- end }}
+  {{- if .Values.csa.requeueQps }}
+  - --requeue-qps
+  - "{{ .Values.csa.requeueQps }}"
+  {{- end }}
+  {{- if .Values.csa.requeueBurst }}
+  - --requeue-burst
+  - "{{ .Values.csa.requeueBurst }}"
+  {{- end }}
   {{- if .Values.csa.maxConcurrentReconciles }}
   - --max-concurrent-reconciles
   - "{{ .Values.csa.maxConcurrentReconciles }}"
   {{- end }}
+  {{- if .Values.csa.priorityQueueEnabled }}
+  - --priority-queue-enabled
+  - "{{ .Values.csa.priorityQueueEnabled }}"
+  {{- end }}
+  {{- if .Values.csa.kubeApiQps }}
+  - --kube-api-qps
+  - "{{ .Values.csa.kubeApiQps }}"
+  {{- end }}
+  {{- if .Values.csa.kubeApiBurst }}
+  - --kube-api-burst
+  - "{{ .Values.csa.kubeApiBurst }}"
+  {{- end }}
+  {{- if .Values.csa.kubeApiResizeQps }}
+  - --kube-api-resize-qps
+  - "{{ .Values.csa.kubeApiResizeQps }}"
+  {{- end }}
+  {{- if .Values.csa.kubeApiResizeBurst }}
+  - --kube-api-resize-burst
+  - "{{ .Values.csa.kubeApiResizeBurst }}"
+  {{- end }}
   {{- if .Values.csa.standardRetryAttempts }}
   - --standard-retry-attempts
   - "{{ .Values.csa.standardRetryAttempts }}"
@@ -40,10 +111,88 @@ args:
   - --standard-retry-delay-secs
   - "{{ .Values.csa.standardRetryDelaySecs }}"
   {{- end }}
+  {{- if .Values.csa.standardRetryStrategy }}
+  - --standard-retry-strategy
+  - "{{ .Values.csa.standardRetryStrategy }}"
+  {{- end }}
+  {{- if .Values.csa.standardRetryMaxDelaySecs }}
+  - --standard-retry-max-delay-secs
+  - "{{ .Values.csa.standardRetryMaxDelaySecs }}"
+  {{- end }}
+  {{- if .Values.csa.circuitBreakerEnabled }}
+  - --circuit-breaker-enabled
+  - "{{ .Values.csa.circuitBreakerEnabled }}"
+  {{- end }}
+  {{- if .Values.csa.circuitBreakerFailureRatePercent }}
+  - --circuit-breaker-failure-rate-percent
+  - "{{ .Values.csa.circuitBreakerFailureRatePercent }}"
+  {{- end }}
+  {{- if .Values.csa.circuitBreakerMinRequests }}
+  - --circuit-breaker-min-requests
+  - "{{ .Values.csa.circuitBreakerMinRequests }}"
+  {{- end }}
+  {{- if .Values.csa.circuitBreakerWindowSecs }}
+  - --circuit-breaker-window-secs
+  - "{{ .Values.csa.circuitBreakerWindowSecs }}"
+  {{- end }}
+  {{- if .Values.csa.circuitBreakerOpenSecs }}
+  - --circuit-breaker-open-secs
+  - "{{ .Values.csa.circuitBreakerOpenSecs }}"
+  {{- end }}
   {{- if .Values.csa.scaleWhenUnknownResources }}
   - --scale-when-unknown-resources
   - "{{ .Values.csa.scaleWhenUnknownResources }}"
   {{- end }}
+  {{- if .Values.csa.nodeCapacityStrategy }}
+  - --node-capacity-strategy
+  - "{{ .Values.csa.nodeCapacityStrategy }}"
+  {{- end }}
+  {{- if .Values.csa.nodeStartupSurplusBudgetCpu }}
+  - --node-startup-surplus-budget-cpu
+  - "{{ .Values.csa.nodeStartupSurplusBudgetCpu }}"
+  {{- end }}
+  {{- if .Values.csa.nodeStartupSurplusBudgetMemory }}
+  - --node-startup-surplus-budget-memory
+  - "{{ .Values.csa.nodeStartupSurplusBudgetMemory }}"
+  {{- end }}
+  {{- if .Values.csa.nodeUpscalePriorityOrdering }}
+  - --node-upscale-priority-ordering
+  - "{{ .Values.csa.nodeUpscalePriorityOrdering }}"
+  {{- end }}
+  {{- if .Values.csa.nodeUpscaleTieBreak }}
+  - --node-upscale-tie-break
+  - "{{ .Values.csa.nodeUpscaleTieBreak }}"
+  {{- end }}
+  {{- if .Values.csa.evictionFallbackDeferredTimeoutSecs }}
+  - --eviction-fallback-deferred-timeout-secs
+  - "{{ .Values.csa.evictionFallbackDeferredTimeoutSecs }}"
+  {{- end }}
+  {{- if .Values.csa.evictionFallbackCooldownSecs }}
+  - --eviction-fallback-cooldown-secs
+  - "{{ .Values.csa.evictionFallbackCooldownSecs }}"
+  {{- end }}
+  {{- if .Values.csa.startupEvictionProtection }}
+  - --startup-eviction-protection
+  - "{{ .Values.csa.startupEvictionProtection }}"
+  {{- end }}
+  {{- if .Values.csa.startupEvictionProtectionAnnotations }}
+  - --startup-eviction-protection-annotations
+  - "{{ join "," .Values.csa.startupEvictionProtectionAnnotations }}"
+  {{- end }}
+  {{- if .Values.csa.disabledFinalResources }}
+  - --disabled-final-resources
+  - "{{ .Values.csa.disabledFinalResources }}"
+  {{- end }}
+  {{- if .Values.csa.controlConfigMapName }}
+  - --control-config-map-namespace
+  - "{{ include "csa.name.namespace" . }}"
+  - --control-config-map-name
+  - "{{ .Values.csa.controlConfigMapName }}"
+  {{- end }}
+  {{- if .Values.csa.dryRun }}
+  - --dry-run
+  - "{{ .Values.csa.dryRun }}"
+  {{- end }}
   {{- if .Values.csa.logV }}
   - --log-v
   - "{{ .Values.csa.logV }}"
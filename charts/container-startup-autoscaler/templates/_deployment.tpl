@@ -1,5 +1,7 @@
 {{- define "csa.deployment.replicas" -}}
-{{- if .Values.pod.leaderElectionEnabled -}}
+{{- if .Values.pod.shardingReplicas -}}
+{{ .Values.pod.shardingReplicas }}
+{{- else if .Values.pod.leaderElectionEnabled -}}
 2
 {{- else -}}
 1
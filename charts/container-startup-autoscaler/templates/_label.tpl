@@ -2,6 +2,10 @@
 labels: {{- include "csa.label.core" . | nindent 2 }}
 {{- end }}
 
+{{ define "csa.label.daemonset" }}
+labels: {{- include "csa.label.core" . | nindent 2 }}
+{{- end }}
+
 {{ define "csa.label.pod" }}
 labels: {{- include "csa.label.core" . | nindent 2 }}
 {{- if .Values.pod.extraLabels }}
@@ -21,6 +25,14 @@ labels: {{- include "csa.label.core" . | nindent 2 }}
 labels: {{- include "csa.label.core" . | nindent 2 }}
 {{- end }}
 
+{{ define "csa.label.role" }}
+labels: {{- include "csa.label.core" . | nindent 2 }}
+{{- end }}
+
+{{ define "csa.label.rolebinding" }}
+labels: {{- include "csa.label.core" . | nindent 2 }}
+{{- end }}
+
 {{- define "csa.label.core" -}}
 helm.sh/chart: "{{ .Chart.Name }}-{{ .Chart.Version }}"
 app.kubernetes.io/managed-by: "{{ .Release.Service }}"
@@ -8,3 +8,59 @@ Must be graceful-shutdown-timeout-secs default + 5s (i.e. some margin)
 15
 {{- end }}
 {{- end }}
+
+{{ define "csa.pod.template" }}
+metadata:
+  {{- include "csa.label.pod" . | indent 2 }}
+  {{- include "csa.annotation.pod" . | indent 2 }}
+spec:
+  serviceAccountName: "{{ include "csa.name.release" . }}"
+  terminationGracePeriodSeconds: {{ include "csa.pod.terminationGracePeriodSeconds" . }}
+  {{- if .Values.pod.imagePullSecrets }}
+  imagePullSecrets: {{- toYaml .Values.pod.imagePullSecrets | nindent 4 }}
+  {{- end }}
+  containers:
+    - name: "{{ include "csa.name.app" . }}"
+      image: "{{ include "csa.container.imageTag" . }}"
+      imagePullPolicy: IfNotPresent
+      ports:
+        - containerPort: 8080 # Metrics
+        - containerPort: 8081 # Probes
+        - containerPort: 8082 # pprof
+      {{- include "csa.container.args" . | indent 6 }}
+      {{- if .Values.pod.nodeLocal }}
+      env:
+        # Supplies --node-name.
+        - name: CSA_NODE_NAME
+          valueFrom:
+            fieldRef:
+              fieldPath: spec.nodeName
+      {{- end }}
+      resources:
+        requests:
+          cpu: {{ .Values.container.cpu | quote }}
+          memory: {{ .Values.container.memory | quote }}
+        limits:
+          cpu: {{ .Values.container.cpu | quote }}
+          memory: {{ .Values.container.memory | quote }}
+      livenessProbe:
+        httpGet:
+          path: /healthz
+          port: 8081
+          scheme: HTTP
+        initialDelaySeconds: 5
+        periodSeconds: 5
+        timeoutSeconds: 5
+        successThreshold: 1
+        failureThreshold: 3
+      readinessProbe:
+        httpGet:
+          path: /readyz
+          port: 8081
+          scheme: HTTP
+        initialDelaySeconds: 5
+        periodSeconds: 5
+        timeoutSeconds: 5
+        successThreshold: 1
+        failureThreshold: 3
+{{- end }}
@@ -3,3 +3,9 @@ matchLabels:
   {{ include "csa.label.kubeName" . }}
   {{ include "csa.label.kubeInstance" . }}
 {{- end }}
+
+{{ define "csa.selector.daemonset" }}
+matchLabels:
+  {{ include "csa.label.kubeName" . }}
+  {{ include "csa.label.kubeInstance" . }}
+{{- end }}
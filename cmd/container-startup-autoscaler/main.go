@@ -21,11 +21,14 @@ import (
 
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
 	"github.com/spf13/cobra"
 	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
@@ -50,9 +53,13 @@ func main() {
 	}
 }
 
-// run is the root command work function. It obtains configuration, configures the controller-runtime manager,
+// run is the root command work function. It loads configuration, configures the controller-runtime manager,
 // initializes the CSA controller and starts the controller-runtime manager.
-func run(_ *cobra.Command, _ []string) {
+func run(cmd *cobra.Command, _ []string) {
+	if err := controllerConfig.Load(cmd); err != nil {
+		logging.Fatalf(nil, err, "unable to load configuration")
+	}
+
 	level := controllerConfig.LogV
 	if level < 0 || level > 2 {
 		level = int(logging.DefaultV)
@@ -61,24 +68,92 @@ func run(_ *cobra.Command, _ []string) {
 	logging.Infof(nil, logging.VInfo, "starting %s...", controller.Name)
 	controllerConfig.Log()
 
+	if err := controllerConfig.Validate(); err != nil {
+		logging.Fatalf(nil, err, "invalid configuration")
+	}
+
 	if controllerConfig.KubeConfig != "" {
 		if err := os.Setenv("KUBECONFIG", controllerConfig.KubeConfig); err != nil {
 			logging.Fatalf(nil, err, "unable to set KUBECONFIG environment variable")
 		}
 	}
 
+	// Pods with the enabled label set to 'false' are also cached so that they may be handed back.
+	enabledLabelExists, err := labels.NewRequirement(kubecommon.LabelEnabled, selection.Exists, nil)
+	if err != nil {
+		logging.Fatalf(nil, err, "unable to create enabled label requirement")
+	}
+
+	// Only pods of this installation's class are cached, so that multiple installations don't reconcile the same pods.
+	classLabelMatches, err := labels.NewRequirement(kubecommon.LabelClass, selection.DoesNotExist, nil)
+	if controllerConfig.Class != "" {
+		classLabelMatches, err = labels.NewRequirement(
+			kubecommon.LabelClass,
+			selection.Equals,
+			[]string{controllerConfig.Class},
+		)
+	}
+	if err != nil {
+		logging.Fatalf(nil, err, "unable to create class label requirement")
+	}
+
 	cacheSyncPeriod := controllerConfig.CacheSyncPeriodMinsDuration()
 	gracefulShutdownTimeout := controllerConfig.GracefulShutdownTimeoutSecsDuration()
 
+	podByObject := cache.ByObject{
+		// Restrict caching to pods that have enabled label to avoid caching everything.
+		Label: labels.NewSelector().Add(*enabledLabelExists, *classLabelMatches),
+		// Strip pod fields that aren't read to reduce memory.
+		Transform: kube.TransformPodForCache,
+	}
+
+	// Restrict caching to pods scheduled on this node if running node-local (e.g. as a daemonset), so that each
+	// instance only watches and reconciles its own node's pods.
+	if controllerConfig.NodeName != "" {
+		podByObject.Field = fields.OneTermEqualSelector("spec.nodeName", controllerConfig.NodeName)
+	}
+
+	cacheByObject := map[client.Object]cache.ByObject{
+		&v1.Pod{}: podByObject,
+	}
+
+	// Restrict caching to watched namespaces if supplied, allowing CSA to operate with namespace-scoped permissions.
+	// Excluded namespaces and the namespace label selector are applied via predicates.
+	var defaultNamespaces map[string]cache.Config
+	if len(controllerConfig.WatchNamespaces) > 0 {
+		defaultNamespaces = map[string]cache.Config{}
+		for _, namespace := range controllerConfig.WatchNamespaces {
+			defaultNamespaces[namespace] = cache.Config{}
+		}
+	}
+
+	if controllerConfig.ControlConfigMapName != "" {
+		// Restrict caching to the control configmap only, which is watched so that changes take effect immediately.
+		cacheByObject[&v1.ConfigMap{}] = cache.ByObject{
+			Namespaces: map[string]cache.Config{controllerConfig.ControlConfigMapNamespace: {}},
+			Field:      fields.OneTermEqualSelector("metadata.name", controllerConfig.ControlConfigMapName),
+		}
+	}
+
+	if controllerConfig.NamespaceLabelSelector != "" {
+		// Cache namespaces for evaluating the namespace label selector, stripping all but labels to reduce memory.
+		cacheByObject[&v1.Namespace{}] = cache.ByObject{Transform: kube.TransformNamespaceForCache}
+	}
+
+	if controllerConfig.NodeCapacityStrategy != controllercommon.NodeCapacityStrategyDisabled {
+		// Cache nodes for determining free allocatable capacity, stripping all but allocatable to reduce memory.
+		nodeByObject := cache.ByObject{Transform: kube.TransformNodeForCache}
+		if controllerConfig.NodeName != "" {
+			nodeByObject.Field = fields.OneTermEqualSelector("metadata.name", controllerConfig.NodeName)
+		}
+		cacheByObject[&v1.Node{}] = nodeByObject
+	}
+
 	options := manager.Options{
 		Cache: cache.Options{
-			SyncPeriod: &cacheSyncPeriod,
-			ByObject: map[client.Object]cache.ByObject{
-				&v1.Pod{}: {
-					// Restrict caching to pods that have enabled label to avoid caching everything.
-					Label: labels.SelectorFromSet(labels.Set{kubecommon.LabelEnabled: "true"}),
-				},
-			},
+			SyncPeriod:        &cacheSyncPeriod,
+			DefaultNamespaces: defaultNamespaces,
+			ByObject:          cacheByObject,
 		},
 		GracefulShutdownTimeout: &gracefulShutdownTimeout,
 		Logger:                  logging.Logger,
@@ -87,7 +162,7 @@ func run(_ *cobra.Command, _ []string) {
 		PprofBindAddress:        controllerConfig.BindAddressPprof,
 		LeaderElection:          controllerConfig.LeaderElectionEnabled,
 		LeaderElectionNamespace: controllerConfig.LeaderElectionResourceNamespace,
-		LeaderElectionID:        "csa-expediagroup-com",
+		LeaderElectionID:        controllerConfig.LeaderElectionID(),
 	}
 
 	// Uses KUBECONFIG env var if set, otherwise tries in-cluster config.
@@ -96,6 +171,22 @@ func run(_ *cobra.Command, _ []string) {
 		logging.Fatalf(nil, err, "unable to get rest config")
 	}
 
+	// Client-side rate limiting is disabled by default in favour of Kube API priority and fairness.
+	if controllerConfig.KubeApiQPS > 0 {
+		restConfig.QPS = float32(controllerConfig.KubeApiQPS)
+		restConfig.Burst = controllerConfig.KubeApiBurst
+	}
+
+	// Resizes are limited separately, so that a wave of pod startups can't starve other Kube API requests.
+	if controllerConfig.KubeApiResizeQPS > 0 {
+		restConfig.Wrap(kube.NewResizeRateLimitWrapper(
+			controllerConfig.KubeApiResizeQPS,
+			controllerConfig.KubeApiResizeBurst,
+		))
+	}
+
+	kube.RegisterClientMetrics()
+
 	runtimeManager, err := manager.New(restConfig, options)
 	if err != nil {
 		logging.Fatalf(nil, err, "unable to create controller-runtime manager")
@@ -105,7 +196,34 @@ func run(_ *cobra.Command, _ []string) {
 		logging.Fatalf(nil, err, "unable to add healthz check")
 	}
 
-	csaController := controller.NewController(controllerConfig, runtimeManager)
+	if err = runtimeManager.AddReadyzCheck("readiness", healthz.Ping); err != nil {
+		logging.Fatalf(nil, err, "unable to add readyz check")
+	}
+
+	// All pods scheduled on a node are cached separately (the manager's cache only includes pods that CSA is enabled
+	// for) when checking node capacity.
+	var nodePodReader client.Reader
+	if controllerConfig.NodeCapacityStrategy != controllercommon.NodeCapacityStrategyDisabled {
+		nodePodCache, err := kube.NewNodePodCache(
+			restConfig,
+			cache.Options{
+				Scheme:     runtimeManager.GetScheme(),
+				Mapper:     runtimeManager.GetRESTMapper(),
+				SyncPeriod: &cacheSyncPeriod,
+			},
+			controllerConfig.NodeName,
+		)
+		if err != nil {
+			logging.Fatalf(nil, err, "unable to create node pod cache")
+		}
+
+		if err = runtimeManager.Add(nodePodCache); err != nil {
+			logging.Fatalf(nil, err, "unable to add node pod cache")
+		}
+		nodePodReader = nodePodCache
+	}
+
+	csaController := controller.NewController(controllerConfig, runtimeManager, nodePodReader)
 
 	if err = csaController.Initialize(); err != nil {
 		logging.Fatalf(nil, err, "unable to initialize controller")
@@ -17,9 +17,13 @@ limitations under the License.
 package context
 
 const (
-	KeyStandardRetryAttempts  = "rattempts"
-	KeyStandardRetryDelaySecs = "rdelaysecs"
-	KeyTargetContainerName    = "cname"
-	KeyTargetContainerStates  = "cstates"
-	KeyTimeoutOverride        = "toverride"
+	KeyDryRun                    = "dryrun"
+	KeyStandardRetryAttempts     = "rattempts"
+	KeyStandardRetryDelaySecs    = "rdelaysecs"
+	KeyStandardRetryMaxDelaySecs = "rmaxdelaysecs"
+	KeyStandardRetryStrategy     = "rstrategy"
+	KeySuppression               = "suppression"
+	KeyTargetContainerName       = "cname"
+	KeyTargetContainerStates     = "cstates"
+	KeyTimeoutOverride           = "toverride"
 )
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	context2 "github.com/ExpediaGroup/container-startup-autoscaler/internal/context"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/control/controlcommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
@@ -51,11 +52,31 @@ func (b *CtxBuilder) StandardRetryDelaySecs(standardRetryDelaySecs int) *CtxBuil
 	return b
 }
 
+func (b *CtxBuilder) StandardRetryMaxDelaySecs(standardRetryMaxDelaySecs int) *CtxBuilder {
+	b.config.standardRetryMaxDelaySecs = standardRetryMaxDelaySecs
+	return b
+}
+
+func (b *CtxBuilder) StandardRetryStrategy(standardRetryStrategy string) *CtxBuilder {
+	b.config.standardRetryStrategy = standardRetryStrategy
+	return b
+}
+
 func (b *CtxBuilder) TimeoutOverride(timeoutOverride time.Duration) *CtxBuilder {
 	b.config.timeoutOverride = timeoutOverride
 	return b
 }
 
+func (b *CtxBuilder) Suppression(suppression controlcommon.Suppression) *CtxBuilder {
+	b.config.suppression = suppression
+	return b
+}
+
+func (b *CtxBuilder) DryRun(dryRun bool) *CtxBuilder {
+	b.config.dryRun = dryRun
+	return b
+}
+
 func (b *CtxBuilder) Build() context.Context {
 	var c context.Context
 
@@ -72,8 +93,16 @@ func (b *CtxBuilder) Build() context.Context {
 	}
 	c = context.WithValue(c, context2.KeyStandardRetryAttempts, b.config.standardRetryAttempts)
 	c = context.WithValue(c, context2.KeyStandardRetryDelaySecs, b.config.standardRetryDelaySecs)
+	c = context.WithValue(c, context2.KeyStandardRetryMaxDelaySecs, b.config.standardRetryMaxDelaySecs)
+	c = context.WithValue(c, context2.KeyStandardRetryStrategy, b.config.standardRetryStrategy)
 	if b.config.timeoutOverride != 0 {
 		c = context.WithValue(c, context2.KeyTimeoutOverride, b.config.timeoutOverride)
 	}
+	if b.config.suppression != (controlcommon.Suppression{}) {
+		c = context.WithValue(c, context2.KeySuppression, b.config.suppression)
+	}
+	if b.config.dryRun {
+		c = context.WithValue(c, context2.KeyDryRun, b.config.dryRun)
+	}
 	return c
 }
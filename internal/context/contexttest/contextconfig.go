@@ -19,15 +19,21 @@ package contexttest
 import (
 	"bytes"
 	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/control/controlcommon"
 )
 
 const KeyUuid = "uuid"
 
 type CtxConfig struct {
-	logBuffer              *bytes.Buffer
-	standardRetryAttempts  int
-	standardRetryDelaySecs int
-	timeoutOverride        time.Duration
+	logBuffer                 *bytes.Buffer
+	standardRetryAttempts     int
+	standardRetryDelaySecs    int
+	standardRetryMaxDelaySecs int
+	standardRetryStrategy     string
+	timeoutOverride           time.Duration
+	suppression               controlcommon.Suppression
+	dryRun                    bool
 }
 
 func NewCtxConfig() CtxConfig {
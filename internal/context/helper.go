@@ -21,9 +21,25 @@ import (
 	"errors"
 	"time"
 
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/control/controlcommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
 )
 
+// WithDryRun adds or replaces KeyDryRun to/in ctx.
+func WithDryRun(ctx context.Context, dryRun bool) context.Context {
+	return context.WithValue(ctx, KeyDryRun, dryRun)
+}
+
+// DryRun retrieves KeyDryRun from ctx.
+func DryRun(ctx context.Context) bool {
+	value := ctx.Value(KeyDryRun)
+	if value == nil {
+		return false
+	}
+
+	return ctx.Value(KeyDryRun).(bool)
+}
+
 // WithStandardRetryAttempts adds or replaces KeyStandardRetryAttempts to/in ctx.
 func WithStandardRetryAttempts(ctx context.Context, attempts int) context.Context {
 	return context.WithValue(ctx, KeyStandardRetryAttempts, attempts)
@@ -54,6 +70,51 @@ func StandardRetryDelaySecs(ctx context.Context) int {
 	return ctx.Value(KeyStandardRetryDelaySecs).(int)
 }
 
+// WithStandardRetryStrategy adds or replaces KeyStandardRetryStrategy to/in ctx.
+func WithStandardRetryStrategy(ctx context.Context, strategy string) context.Context {
+	return context.WithValue(ctx, KeyStandardRetryStrategy, strategy)
+}
+
+// StandardRetryStrategy retrieves KeyStandardRetryStrategy from ctx.
+func StandardRetryStrategy(ctx context.Context) string {
+	value := ctx.Value(KeyStandardRetryStrategy)
+	if value == nil {
+		panic(errors.New("standard retry strategy should have been previously set"))
+	}
+
+	return ctx.Value(KeyStandardRetryStrategy).(string)
+}
+
+// WithStandardRetryMaxDelaySecs adds or replaces KeyStandardRetryMaxDelaySecs to/in ctx.
+func WithStandardRetryMaxDelaySecs(ctx context.Context, secs int) context.Context {
+	return context.WithValue(ctx, KeyStandardRetryMaxDelaySecs, secs)
+}
+
+// StandardRetryMaxDelaySecs retrieves KeyStandardRetryMaxDelaySecs from ctx.
+func StandardRetryMaxDelaySecs(ctx context.Context) int {
+	value := ctx.Value(KeyStandardRetryMaxDelaySecs)
+	if value == nil {
+		panic(errors.New("standard retry max delay secs should have been previously set"))
+	}
+
+	return ctx.Value(KeyStandardRetryMaxDelaySecs).(int)
+}
+
+// WithSuppression adds or replaces KeySuppression to/in ctx.
+func WithSuppression(ctx context.Context, suppression controlcommon.Suppression) context.Context {
+	return context.WithValue(ctx, KeySuppression, suppression)
+}
+
+// Suppression retrieves KeySuppression from ctx.
+func Suppression(ctx context.Context) controlcommon.Suppression {
+	value := ctx.Value(KeySuppression)
+	if value == nil {
+		return controlcommon.Suppression{}
+	}
+
+	return ctx.Value(KeySuppression).(controlcommon.Suppression)
+}
+
 // WithTargetContainerName adds or replaces KeyTargetContainerName to/in ctx.
 func WithTargetContainerName(ctx context.Context, name string) context.Context {
 	return context.WithValue(ctx, KeyTargetContainerName, name)
@@ -21,10 +21,27 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/control/controlcommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
 	"github.com/stretchr/testify/assert"
 )
 
+func TestWithDryRun(t *testing.T) {
+	got := WithDryRun(context.TODO(), true)
+	assert.Equal(t, true, got.Value(KeyDryRun).(bool))
+}
+
+func TestDryRun(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		assert.False(t, DryRun(context.TODO()))
+	})
+
+	t.Run("NotNil", func(t *testing.T) {
+		ctx := context.WithValue(context.TODO(), KeyDryRun, true)
+		assert.True(t, DryRun(ctx))
+	})
+}
+
 func TestWithStandardRetryAttempts(t *testing.T) {
 	got := WithStandardRetryAttempts(context.TODO(), 1000)
 	assert.Equal(t, 1000, got.Value(KeyStandardRetryAttempts).(int))
@@ -66,6 +83,64 @@ func TestStandardRetryDelaySecs(t *testing.T) {
 	})
 }
 
+func TestWithStandardRetryStrategy(t *testing.T) {
+	got := WithStandardRetryStrategy(context.TODO(), "strategy")
+	assert.Equal(t, "strategy", got.Value(KeyStandardRetryStrategy).(string))
+}
+
+func TestStandardRetryStrategy(t *testing.T) {
+	t.Run("NotSetPanic", func(t *testing.T) {
+		assert.PanicsWithError(
+			t,
+			"standard retry strategy should have been previously set",
+			func() { StandardRetryStrategy(context.TODO()) },
+		)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		ctx := context.WithValue(context.TODO(), KeyStandardRetryStrategy, "strategy")
+		assert.Equal(t, "strategy", StandardRetryStrategy(ctx))
+	})
+}
+
+func TestWithStandardRetryMaxDelaySecs(t *testing.T) {
+	got := WithStandardRetryMaxDelaySecs(context.TODO(), 3000)
+	assert.Equal(t, 3000, got.Value(KeyStandardRetryMaxDelaySecs).(int))
+}
+
+func TestStandardRetryMaxDelaySecs(t *testing.T) {
+	t.Run("NotSetPanic", func(t *testing.T) {
+		assert.PanicsWithError(
+			t,
+			"standard retry max delay secs should have been previously set",
+			func() { StandardRetryMaxDelaySecs(context.TODO()) },
+		)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		ctx := context.WithValue(context.TODO(), KeyStandardRetryMaxDelaySecs, 3000)
+		assert.Equal(t, 3000, StandardRetryMaxDelaySecs(ctx))
+	})
+}
+
+func TestWithSuppression(t *testing.T) {
+	suppression := controlcommon.NewSuppression(true, false, false)
+	got := WithSuppression(context.TODO(), suppression)
+	assert.Equal(t, suppression, got.Value(KeySuppression).(controlcommon.Suppression))
+}
+
+func TestSuppression(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		assert.Equal(t, controlcommon.Suppression{}, Suppression(context.TODO()))
+	})
+
+	t.Run("NotNil", func(t *testing.T) {
+		suppression := controlcommon.NewSuppression(true, false, false)
+		ctx := context.WithValue(context.TODO(), KeySuppression, suppression)
+		assert.Equal(t, suppression, Suppression(ctx))
+	})
+}
+
 func TestWithTargetContainerName(t *testing.T) {
 	got := WithTargetContainerName(context.TODO(), "test")
 	assert.Equal(t, "test", got.Value(KeyTargetContainerName).(string))
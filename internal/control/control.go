@@ -0,0 +1,250 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/control/controlcommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	"github.com/robfig/cron/v3"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ConfigMapKeyKillSwitch is the control ConfigMap key that indicates whether the kill switch is active ('true' or
+	// 'false').
+	ConfigMapKeyKillSwitch = "killSwitch"
+
+	// ConfigMapKeyBlackoutWindows is the control ConfigMap key that holds a YAML list of blackout windows.
+	ConfigMapKeyBlackoutWindows = "blackoutWindows"
+)
+
+const (
+	blackoutWindowSuppressUp   = "up"
+	blackoutWindowSuppressDown = "down"
+	blackoutWindowSuppressAll  = "all"
+
+	blackoutWindowMinDuration = 1 * time.Minute
+	blackoutWindowMaxDuration = 7 * 24 * time.Hour
+)
+
+// blackoutWindowScheduleParser parses blackout window schedules in standard 5-field cron syntax. Descriptors (e.g.
+// '@every') aren't supported as they don't map to a window start.
+var blackoutWindowScheduleParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+// blackoutWindow represents a single blackout window, as defined within the control ConfigMap.
+type blackoutWindow struct {
+	Schedule string `json:"schedule"`
+	Duration string `json:"duration"`
+	Suppress string `json:"suppress"`
+}
+
+// parsedBlackoutWindow represents a validated and parsed blackoutWindow.
+type parsedBlackoutWindow struct {
+	schedule cron.Schedule
+	duration time.Duration
+	suppress string
+}
+
+// parsedConfigMap holds the parsed contents of a specific resource version of the control ConfigMap. err is
+// populated if the contents are invalid, so that invalid contents are also only parsed once.
+type parsedConfigMap struct {
+	resourceVersion string
+	killSwitch      bool
+	windows         []parsedBlackoutWindow
+	err             error
+}
+
+// control is the default implementation of controlcommon.Control.
+type control struct {
+	client client.Reader
+	name   types.NamespacedName
+	now    func() time.Time
+
+	parsedMutex sync.Mutex
+	parsed      *parsedConfigMap
+}
+
+func NewControl(client client.Reader, namespace string, name string) controlcommon.Control {
+	return &control{
+		client: client,
+		name:   types.NamespacedName{Namespace: namespace, Name: name},
+		now:    time.Now,
+	}
+}
+
+// Suppression returns which scales are currently suppressed, as determined from the control ConfigMap. Nothing is
+// suppressed if no control ConfigMap is configured or it doesn't exist. An error is returned if the control ConfigMap
+// can't be retrieved or contains invalid values, so that callers don't inadvertently scale when they shouldn't.
+func (c *control) Suppression(ctx context.Context) (controlcommon.Suppression, error) {
+	if c.name.Name == "" {
+		return controlcommon.Suppression{}, nil
+	}
+
+	configMap := &v1.ConfigMap{}
+	if err := c.client.Get(ctx, c.name, configMap); err != nil {
+		if kerrors.IsNotFound(err) {
+			logging.Infof(ctx, logging.VDebug, "control configmap not found (nothing suppressed)")
+			return controlcommon.Suppression{}, nil
+		}
+
+		return controlcommon.Suppression{}, common.WrapErrorf(err, "unable to get control configmap")
+	}
+
+	parsed := c.parsedFor(configMap)
+	if parsed.err != nil {
+		return controlcommon.Suppression{}, parsed.err
+	}
+
+	now := c.now().UTC()
+	var windowUp, windowDown bool
+	for _, window := range parsed.windows {
+		if !window.isActive(now) {
+			continue
+		}
+
+		switch window.suppress {
+		case blackoutWindowSuppressUp:
+			windowUp = true
+		case blackoutWindowSuppressDown:
+			windowDown = true
+		case blackoutWindowSuppressAll:
+			windowUp, windowDown = true, true
+		}
+	}
+
+	return controlcommon.NewSuppression(parsed.killSwitch, windowUp, windowDown), nil
+}
+
+// parsedFor returns the parsed contents of the supplied ConfigMap. Contents are only parsed when the ConfigMap's
+// resource version differs from that last parsed.
+func (c *control) parsedFor(configMap *v1.ConfigMap) *parsedConfigMap {
+	c.parsedMutex.Lock()
+	defer c.parsedMutex.Unlock()
+
+	if c.parsed != nil && configMap.ResourceVersion != "" && c.parsed.resourceVersion == configMap.ResourceVersion {
+		return c.parsed
+	}
+
+	c.parsed = parseConfigMap(configMap)
+	return c.parsed
+}
+
+// parseConfigMap parses the supplied ConfigMap.
+func parseConfigMap(configMap *v1.ConfigMap) *parsedConfigMap {
+	ret := &parsedConfigMap{resourceVersion: configMap.ResourceVersion}
+
+	ret.killSwitch, ret.err = killSwitchFrom(configMap)
+	if ret.err != nil {
+		return ret
+	}
+
+	ret.windows, ret.err = blackoutWindowsFrom(configMap)
+	return ret
+}
+
+// killSwitchFrom returns whether the kill switch is active within the supplied ConfigMap.
+func killSwitchFrom(configMap *v1.ConfigMap) (bool, error) {
+	value, present := configMap.Data[ConfigMapKeyKillSwitch]
+	if !present {
+		return false, nil
+	}
+
+	ret, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, common.WrapErrorf(err, "unable to parse '%s' value '%s'", ConfigMapKeyKillSwitch, value)
+	}
+
+	return ret, nil
+}
+
+// blackoutWindowsFrom returns the parsed blackout windows within the supplied ConfigMap.
+func blackoutWindowsFrom(configMap *v1.ConfigMap) ([]parsedBlackoutWindow, error) {
+	value, present := configMap.Data[ConfigMapKeyBlackoutWindows]
+	if !present {
+		return nil, nil
+	}
+
+	var windows []blackoutWindow
+	if err := yaml.UnmarshalStrict([]byte(value), &windows); err != nil {
+		return nil, common.WrapErrorf(err, "unable to parse '%s'", ConfigMapKeyBlackoutWindows)
+	}
+
+	var ret []parsedBlackoutWindow
+	for i, window := range windows {
+		parsed, err := window.parse()
+		if err != nil {
+			return nil, common.WrapErrorf(err, "invalid '%s' entry %d", ConfigMapKeyBlackoutWindows, i)
+		}
+
+		ret = append(ret, parsed)
+	}
+
+	return ret, nil
+}
+
+// parse validates and parses the blackout window.
+func (w blackoutWindow) parse() (parsedBlackoutWindow, error) {
+	if w.Suppress != blackoutWindowSuppressUp &&
+		w.Suppress != blackoutWindowSuppressDown &&
+		w.Suppress != blackoutWindowSuppressAll {
+		return parsedBlackoutWindow{}, fmt.Errorf(
+			"suppress must be '%s', '%s' or '%s' ('%s')",
+			blackoutWindowSuppressUp, blackoutWindowSuppressDown, blackoutWindowSuppressAll, w.Suppress,
+		)
+	}
+
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return parsedBlackoutWindow{}, common.WrapErrorf(err, "unable to parse duration")
+	}
+	if duration < blackoutWindowMinDuration || duration > blackoutWindowMaxDuration {
+		return parsedBlackoutWindow{}, fmt.Errorf(
+			"duration must be between %s and %s ('%s')",
+			blackoutWindowMinDuration, blackoutWindowMaxDuration, w.Duration,
+		)
+	}
+
+	if w.Schedule == "" {
+		return parsedBlackoutWindow{}, errors.New("schedule must be supplied")
+	}
+	schedule, err := blackoutWindowScheduleParser.Parse(w.Schedule)
+	if err != nil {
+		return parsedBlackoutWindow{}, common.WrapErrorf(err, "unable to parse schedule")
+	}
+
+	return parsedBlackoutWindow{schedule: schedule, duration: duration, suppress: w.Suppress}, nil
+}
+
+// isActive returns whether the blackout window is active as of now i.e. the schedule's first activation after the
+// start of the preceding duration isn't after now. A window that started exactly one duration ago has ended. A
+// schedule that never activates (e.g. February 30th) is never active.
+func (w parsedBlackoutWindow) isActive(now time.Time) bool {
+	next := w.schedule.Next(now.Add(-w.duration))
+	return !next.IsZero() && !next.After(now)
+}
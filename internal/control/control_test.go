@@ -0,0 +1,291 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/context/contexttest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/control/controlcommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const (
+	testNamespace = "namespace"
+	testName      = "name"
+)
+
+func TestNewControl(t *testing.T) {
+	c := fake.NewClientBuilder().Build()
+	got := NewControl(c, testNamespace, testName).(*control)
+	assert.Equal(t, c, got.client)
+	assert.Equal(t, testNamespace, got.name.Namespace)
+	assert.Equal(t, testName, got.name.Name)
+	assert.NotNil(t, got.now)
+}
+
+func TestControlSuppression(t *testing.T) {
+	// 2025-01-06 is a Monday.
+	now := time.Date(2025, 1, 6, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		client     client.Reader
+		configName string
+		wantErrMsg string
+		want       controlcommon.Suppression
+	}{
+		{
+			"NotConfigured",
+			fake.NewClientBuilder().Build(),
+			"",
+			"",
+			controlcommon.Suppression{},
+		},
+		{
+			"UnableToGetConfigMap",
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset() },
+				func() interceptor.Funcs { return interceptor.Funcs{Get: kubetest.InterceptorFuncGetFail()} },
+			),
+			testName,
+			"unable to get control configmap",
+			controlcommon.Suppression{},
+		},
+		{
+			"NotFound",
+			fake.NewClientBuilder().Build(),
+			testName,
+			"",
+			controlcommon.Suppression{},
+		},
+		{
+			"InvalidKillSwitch",
+			fakeClientWithConfigMap(map[string]string{ConfigMapKeyKillSwitch: "test"}),
+			testName,
+			"unable to parse 'killSwitch' value 'test'",
+			controlcommon.Suppression{},
+		},
+		{
+			"InvalidBlackoutWindows",
+			fakeClientWithConfigMap(map[string]string{ConfigMapKeyBlackoutWindows: "test"}),
+			testName,
+			"unable to parse 'blackoutWindows'",
+			controlcommon.Suppression{},
+		},
+		{
+			"InvalidBlackoutWindowsEntry",
+			fakeClientWithConfigMap(map[string]string{
+				ConfigMapKeyBlackoutWindows: "- {schedule: '* * * * *', duration: 1h, suppress: test}",
+			}),
+			testName,
+			"invalid 'blackoutWindows' entry 0: suppress must be 'up', 'down' or 'all' ('test')",
+			controlcommon.Suppression{},
+		},
+		{
+			"Empty",
+			fakeClientWithConfigMap(map[string]string{}),
+			testName,
+			"",
+			controlcommon.Suppression{},
+		},
+		{
+			"KillSwitch",
+			fakeClientWithConfigMap(map[string]string{ConfigMapKeyKillSwitch: "true"}),
+			testName,
+			"",
+			controlcommon.NewSuppression(true, false, false),
+		},
+		{
+			"BlackoutWindows",
+			fakeClientWithConfigMap(map[string]string{
+				ConfigMapKeyKillSwitch: "false",
+				ConfigMapKeyBlackoutWindows: "" +
+					"- {schedule: '0 9 * * 1-5', duration: 1h, suppress: up}\n" +
+					"- {schedule: '0 22 * * *', duration: 2h, suppress: down}\n",
+			}),
+			testName,
+			"",
+			controlcommon.NewSuppression(false, true, false),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &control{
+				client: tt.client,
+				name:   types.NamespacedName{Namespace: testNamespace, Name: tt.configName},
+				now:    func() time.Time { return now },
+			}
+			got, err := c.Suppression(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build())
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestControlSuppressionParsesOncePerResourceVersion(t *testing.T) {
+	ctx := contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build()
+	configMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testName},
+		Data:       map[string]string{ConfigMapKeyKillSwitch: "false"},
+	}
+	fakeClient := fake.NewClientBuilder().WithObjects(configMap).Build()
+	c := NewControl(fakeClient, testNamespace, testName).(*control)
+
+	_, err := c.Suppression(ctx)
+	assert.NoError(t, err)
+	parsed := c.parsed
+
+	_, err = c.Suppression(ctx)
+	assert.NoError(t, err)
+	assert.Same(t, parsed, c.parsed)
+
+	configMap.Data[ConfigMapKeyKillSwitch] = "true"
+	assert.NoError(t, fakeClient.Update(ctx, configMap))
+	got, err := c.Suppression(ctx)
+	assert.NoError(t, err)
+	assert.NotSame(t, parsed, c.parsed)
+	assert.Equal(t, controlcommon.NewSuppression(true, false, false), got)
+}
+
+func TestBlackoutWindowParseAndIsActive(t *testing.T) {
+	// 2025-01-06 is a Monday.
+	now := time.Date(2025, 1, 6, 9, 30, 15, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		window     blackoutWindow
+		wantErrMsg string
+		want       bool
+	}{
+		{
+			"InvalidSuppress",
+			blackoutWindow{Schedule: "* * * * *", Duration: "1h", Suppress: "test"},
+			"suppress must be 'up', 'down' or 'all' ('test')",
+			false,
+		},
+		{
+			"InvalidDuration",
+			blackoutWindow{Schedule: "* * * * *", Duration: "test", Suppress: blackoutWindowSuppressUp},
+			"unable to parse duration",
+			false,
+		},
+		{
+			"DurationTooShort",
+			blackoutWindow{Schedule: "* * * * *", Duration: "30s", Suppress: blackoutWindowSuppressUp},
+			"duration must be between 1m0s and 168h0m0s ('30s')",
+			false,
+		},
+		{
+			"DurationTooLong",
+			blackoutWindow{Schedule: "* * * * *", Duration: "169h", Suppress: blackoutWindowSuppressUp},
+			"duration must be between 1m0s and 168h0m0s ('169h')",
+			false,
+		},
+		{
+			"NoSchedule",
+			blackoutWindow{Duration: "1h", Suppress: blackoutWindowSuppressUp},
+			"schedule must be supplied",
+			false,
+		},
+		{
+			"InvalidSchedule",
+			blackoutWindow{Schedule: "test", Duration: "1h", Suppress: blackoutWindowSuppressUp},
+			"unable to parse schedule",
+			false,
+		},
+		{
+			"DescriptorNotSupported",
+			blackoutWindow{Schedule: "@every 1m", Duration: "1h", Suppress: blackoutWindowSuppressUp},
+			"unable to parse schedule",
+			false,
+		},
+		{
+			"StartedNow",
+			blackoutWindow{Schedule: "30 9 * * *", Duration: "1m", Suppress: blackoutWindowSuppressUp},
+			"",
+			true,
+		},
+		{
+			"StartedWithinDuration",
+			blackoutWindow{Schedule: "0 9 * * *", Duration: "1h", Suppress: blackoutWindowSuppressUp},
+			"",
+			true,
+		},
+		{
+			"StartedBeforeDuration",
+			blackoutWindow{Schedule: "0 9 * * *", Duration: "30m", Suppress: blackoutWindowSuppressUp},
+			"",
+			false,
+		},
+		{
+			"StartedPreviousDay",
+			blackoutWindow{Schedule: "0 22 * * 0", Duration: "12h", Suppress: blackoutWindowSuppressUp},
+			"",
+			true,
+		},
+		{
+			"Ended",
+			blackoutWindow{Schedule: "30 8 * * *", Duration: "1h", Suppress: blackoutWindowSuppressUp},
+			"",
+			false,
+		},
+		{
+			"NeverStarts",
+			blackoutWindow{Schedule: "0 0 30 2 *", Duration: "1h", Suppress: blackoutWindowSuppressUp},
+			"",
+			false,
+		},
+		{
+			"NotStarted",
+			blackoutWindow{Schedule: "0 10 * * *", Duration: "1h", Suppress: blackoutWindowSuppressUp},
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := tt.window.parse()
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, parsed.isActive(now))
+		})
+	}
+}
+
+func fakeClientWithConfigMap(data map[string]string) client.Reader {
+	return fake.NewClientBuilder().WithObjects(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testName},
+		Data:       data,
+	}).Build()
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlcommon
+
+import (
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/metricscommon"
+)
+
+// SuppressionReason indicates the reason that scales are suppressed.
+type SuppressionReason string
+
+const (
+	SuppressionReasonNone           SuppressionReason = ""
+	SuppressionReasonKillSwitch     SuppressionReason = "kill_switch"
+	SuppressionReasonBlackoutWindow SuppressionReason = "blackout_window"
+)
+
+// HumanReadable returns a string suitable to include within human-readable messages.
+func (r SuppressionReason) HumanReadable() string {
+	switch r {
+	case SuppressionReasonKillSwitch:
+		return "kill switch active"
+	case SuppressionReasonBlackoutWindow:
+		return "within blackout window"
+	default:
+		return string(r)
+	}
+}
+
+// Suppression indicates which scales are currently suppressed cluster-wide.
+type Suppression struct {
+	KillSwitch         bool
+	BlackoutWindowUp   bool
+	BlackoutWindowDown bool
+}
+
+func NewSuppression(killSwitch bool, blackoutWindowUp bool, blackoutWindowDown bool) Suppression {
+	return Suppression{
+		KillSwitch:         killSwitch,
+		BlackoutWindowUp:   blackoutWindowUp,
+		BlackoutWindowDown: blackoutWindowDown,
+	}
+}
+
+// Reason returns the reason that scales in the supplied direction are suppressed, or SuppressionReasonNone if they're
+// not suppressed. The kill switch takes precedence over blackout windows.
+func (s Suppression) Reason(direction metricscommon.Direction) SuppressionReason {
+	if s.KillSwitch {
+		return SuppressionReasonKillSwitch
+	}
+
+	if (direction == metricscommon.DirectionUp && s.BlackoutWindowUp) ||
+		(direction == metricscommon.DirectionDown && s.BlackoutWindowDown) {
+		return SuppressionReasonBlackoutWindow
+	}
+
+	return SuppressionReasonNone
+}
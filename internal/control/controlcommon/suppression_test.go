@@ -0,0 +1,59 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlcommon
+
+import (
+	"testing"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/metricscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuppressionReasonHumanReadable(t *testing.T) {
+	assert.Equal(t, "kill switch active", SuppressionReasonKillSwitch.HumanReadable())
+	assert.Equal(t, "within blackout window", SuppressionReasonBlackoutWindow.HumanReadable())
+	assert.Equal(t, "", SuppressionReasonNone.HumanReadable())
+}
+
+func TestNewSuppression(t *testing.T) {
+	assert.Equal(
+		t,
+		Suppression{KillSwitch: true, BlackoutWindowUp: true, BlackoutWindowDown: true},
+		NewSuppression(true, true, true),
+	)
+}
+
+func TestSuppressionReason(t *testing.T) {
+	tests := []struct {
+		name        string
+		suppression Suppression
+		direction   metricscommon.Direction
+		want        SuppressionReason
+	}{
+		{"None", NewSuppression(false, false, false), metricscommon.DirectionUp, SuppressionReasonNone},
+		{"KillSwitchTakesPrecedence", NewSuppression(true, true, true), metricscommon.DirectionUp, SuppressionReasonKillSwitch},
+		{"BlackoutWindowUp", NewSuppression(false, true, false), metricscommon.DirectionUp, SuppressionReasonBlackoutWindow},
+		{"BlackoutWindowUpOtherDirection", NewSuppression(false, true, false), metricscommon.DirectionDown, SuppressionReasonNone},
+		{"BlackoutWindowDown", NewSuppression(false, false, true), metricscommon.DirectionDown, SuppressionReasonBlackoutWindow},
+		{"BlackoutWindowDownOtherDirection", NewSuppression(false, false, true), metricscommon.DirectionUp, SuppressionReasonNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.suppression.Reason(tt.direction))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controltest
+
+import (
+	"context"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/control/controlcommon"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockControl struct {
+	mock.Mock
+}
+
+func NewMockControl(configFunc func(*MockControl)) *MockControl {
+	m := &MockControl{}
+	if configFunc != nil {
+		configFunc(m)
+	} else {
+		m.AllDefaults()
+	}
+
+	return m
+}
+
+func (m *MockControl) Suppression(ctx context.Context) (controlcommon.Suppression, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(controlcommon.Suppression), args.Error(1)
+}
+
+func (m *MockControl) SuppressionDefault() {
+	m.On("Suppression", mock.Anything).Return(controlcommon.Suppression{}, nil)
+}
+
+func (m *MockControl) AllDefaults() {
+	m.SuppressionDefault()
+}
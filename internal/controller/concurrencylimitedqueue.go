@@ -0,0 +1,132 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// concurrencyLimitedQueue wraps a controller-runtime workqueue so that items are only dequeued once the number of
+// active reconciles is within the limit of the supplied concurrencyLimiter. Items are considered active from when
+// they're dequeued until they're marked as done.
+type concurrencyLimitedQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+	priorityQueue priorityqueue.PriorityQueue[reconcile.Request] // nil if the wrapped queue isn't a priority queue.
+	limiter       *concurrencyLimiter
+}
+
+func newConcurrencyLimitedQueue(
+	queue workqueue.TypedRateLimitingInterface[reconcile.Request],
+	limiter *concurrencyLimiter,
+) *concurrencyLimitedQueue {
+	priorityQueue, _ := queue.(priorityqueue.PriorityQueue[reconcile.Request])
+
+	return &concurrencyLimitedQueue{
+		TypedRateLimitingInterface: queue,
+		priorityQueue:              priorityQueue,
+		limiter:                    limiter,
+	}
+}
+
+// newQueue returns the workqueue that controller-runtime otherwise creates by default: a priority queue if enabled,
+// otherwise a rate limiting queue.
+func newQueue(
+	controllerName string,
+	rateLimiter workqueue.TypedRateLimiter[reconcile.Request],
+	controllerConfig controllercommon.ControllerConfig,
+) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	if controllerConfig.PriorityQueueEnabled {
+		return priorityqueue.New(controllerName, func(o *priorityqueue.Opts[reconcile.Request]) {
+			o.Log = logging.Logger.WithValues("controller", controllerName)
+			o.RateLimiter = rateLimiter
+		})
+	}
+
+	return workqueue.NewTypedRateLimitingQueueWithConfig(
+		rateLimiter,
+		workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{Name: controllerName},
+	)
+}
+
+// AddWithOpts implements priorityqueue.PriorityQueue. If the wrapped queue isn't a priority queue, items are added
+// without priority.
+func (q *concurrencyLimitedQueue) AddWithOpts(opts priorityqueue.AddOpts, items ...reconcile.Request) {
+	if q.priorityQueue != nil {
+		q.priorityQueue.AddWithOpts(opts, items...)
+		return
+	}
+
+	for _, item := range items {
+		switch {
+		case opts.RateLimited:
+			q.TypedRateLimitingInterface.AddRateLimited(item)
+		case opts.After > 0:
+			q.TypedRateLimitingInterface.AddAfter(item, opts.After)
+		default:
+			q.TypedRateLimitingInterface.Add(item)
+		}
+	}
+}
+
+// Get implements workqueue.TypedInterface, blocking until within the concurrency limit before dequeuing.
+func (q *concurrencyLimitedQueue) Get() (reconcile.Request, bool) {
+	item, _, shutdown := q.GetWithPriority()
+	return item, shutdown
+}
+
+// GetWithPriority implements priorityqueue.PriorityQueue, blocking until within the concurrency limit before
+// dequeuing. If the wrapped queue isn't a priority queue, the returned priority is always 0.
+func (q *concurrencyLimitedQueue) GetWithPriority() (reconcile.Request, int, bool) {
+	q.limiter.acquire()
+
+	var item reconcile.Request
+	var priority int
+	var shutdown bool
+	if q.priorityQueue != nil {
+		item, priority, shutdown = q.priorityQueue.GetWithPriority()
+	} else {
+		item, shutdown = q.TypedRateLimitingInterface.Get()
+	}
+
+	if shutdown {
+		q.limiter.release()
+	}
+
+	return item, priority, shutdown
+}
+
+// Done implements workqueue.TypedInterface, marking the item's reconcile as no longer active.
+func (q *concurrencyLimitedQueue) Done(item reconcile.Request) {
+	q.TypedRateLimitingInterface.Done(item)
+	q.limiter.release()
+}
+
+// ShutDown implements workqueue.TypedInterface, also unblocking any workers waiting to dequeue.
+func (q *concurrencyLimitedQueue) ShutDown() {
+	q.TypedRateLimitingInterface.ShutDown()
+	q.limiter.shutdown()
+}
+
+// ShutDownWithDrain implements workqueue.TypedInterface, also unblocking any workers waiting to dequeue.
+func (q *concurrencyLimitedQueue) ShutDownWithDrain() {
+	q.limiter.shutdown()
+	q.TypedRateLimitingInterface.ShutDownWithDrain()
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestNewQueue(t *testing.T) {
+	t.Run("PriorityQueueEnabled", func(t *testing.T) {
+		q := newQueue("test", workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](),
+			controllercommon.ControllerConfig{PriorityQueueEnabled: true})
+		defer q.ShutDown()
+		assert.Implements(t, (*priorityqueue.PriorityQueue[reconcile.Request])(nil), q)
+	})
+
+	t.Run("PriorityQueueDisabled", func(t *testing.T) {
+		q := newQueue("test", workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](),
+			controllercommon.ControllerConfig{})
+		defer q.ShutDown()
+		_, isPriorityQueue := q.(priorityqueue.PriorityQueue[reconcile.Request])
+		assert.False(t, isPriorityQueue)
+	})
+}
+
+func TestNewConcurrencyLimitedQueue(t *testing.T) {
+	t.Run("PriorityQueue", func(t *testing.T) {
+		pq := priorityqueue.New[reconcile.Request]("test")
+		defer pq.ShutDown()
+		limiter := newConcurrencyLimiter(func() int { return 1 })
+		q := newConcurrencyLimitedQueue(pq, limiter)
+		assert.Equal(t, pq, q.TypedRateLimitingInterface)
+		assert.Equal(t, pq, q.priorityQueue)
+		assert.Equal(t, limiter, q.limiter)
+	})
+
+	t.Run("NotPriorityQueue", func(t *testing.T) {
+		q := newConcurrencyLimitedQueue(testRateLimitingQueue(), newConcurrencyLimiter(func() int { return 1 }))
+		defer q.ShutDown()
+		assert.Nil(t, q.priorityQueue)
+	})
+}
+
+func TestConcurrencyLimitedQueueAddWithOpts(t *testing.T) {
+	t.Run("PriorityQueue", func(t *testing.T) {
+		q := newConcurrencyLimitedQueue(
+			priorityqueue.New[reconcile.Request]("test"),
+			newConcurrencyLimiter(func() int { return 0 }),
+		)
+		defer q.ShutDown()
+		q.AddWithOpts(priorityqueue.AddOpts{Priority: 1}, testRequest("low"))
+		q.AddWithOpts(priorityqueue.AddOpts{Priority: 2}, testRequest("high"))
+
+		assert.Eventually(t, func() bool { return q.Len() == 2 }, time.Second, 10*time.Millisecond)
+		item, priority, shutdown := q.GetWithPriority()
+		assert.Equal(t, testRequest("high"), item)
+		assert.Equal(t, 2, priority)
+		assert.False(t, shutdown)
+	})
+
+	t.Run("NotPriorityQueue", func(t *testing.T) {
+		q := newConcurrencyLimitedQueue(testRateLimitingQueue(), newConcurrencyLimiter(func() int { return 0 }))
+		defer q.ShutDown()
+		q.AddWithOpts(priorityqueue.AddOpts{}, testRequest("1"))
+		q.AddWithOpts(priorityqueue.AddOpts{After: time.Millisecond}, testRequest("2"))
+		q.AddWithOpts(priorityqueue.AddOpts{RateLimited: true}, testRequest("3"))
+
+		assert.Eventually(t, func() bool { return q.Len() == 3 }, time.Second, 10*time.Millisecond)
+		_, priority, _ := q.GetWithPriority()
+		assert.Equal(t, 0, priority)
+	})
+}
+
+func TestConcurrencyLimitedQueueGetDone(t *testing.T) {
+	q := newConcurrencyLimitedQueue(testRateLimitingQueue(), newConcurrencyLimiter(func() int { return 1 }))
+	defer q.ShutDown()
+	q.Add(testRequest("1"))
+	q.Add(testRequest("2"))
+
+	item, shutdown := q.Get()
+	assert.Equal(t, testRequest("1"), item)
+	assert.False(t, shutdown)
+
+	got := make(chan reconcile.Request)
+	go func() {
+		item, _ := q.Get()
+		got <- item
+	}()
+
+	select {
+	case <-got:
+		assert.Fail(t, "dequeued unexpectedly")
+	case <-time.After(50 * time.Millisecond):
+	}
+	// Not dequeued while blocked on the limit.
+	assert.Equal(t, 1, q.Len())
+
+	q.Done(testRequest("1"))
+	select {
+	case item = <-got:
+		assert.Equal(t, testRequest("2"), item)
+	case <-time.After(time.Second):
+		assert.Fail(t, "not dequeued")
+	}
+}
+
+func TestConcurrencyLimitedQueueShutDown(t *testing.T) {
+	for _, drain := range []bool{false, true} {
+		q := newConcurrencyLimitedQueue(testRateLimitingQueue(), newConcurrencyLimiter(func() int { return 1 }))
+		q.Add(testRequest("1"))
+		item, _ := q.Get()
+		q.Done(item)
+		q.limiter.acquire() // Simulate a reconcile at the limit.
+
+		shutdowns := make(chan bool)
+		go func() {
+			_, shutdown := q.Get()
+			shutdowns <- shutdown
+		}()
+
+		if drain {
+			q.ShutDownWithDrain()
+		} else {
+			q.ShutDown()
+		}
+
+		select {
+		case shutdown := <-shutdowns:
+			assert.True(t, shutdown)
+		case <-time.After(time.Second):
+			assert.Fail(t, "not unblocked")
+		}
+		// Limit not consumed by the shut down get.
+		assert.Equal(t, 1, q.limiter.active)
+	}
+}
+
+func testRateLimitingQueue() workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	return workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+}
+
+func testRequest(name string) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "namespace", Name: name}}
+}
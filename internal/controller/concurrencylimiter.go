@@ -0,0 +1,78 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+)
+
+// concurrencyLimiter limits the number of concurrent reconciles to a limit that may change at runtime, allowing
+// max-concurrent-reconciles to be reloaded without recreating controller-runtime workers. A limit less than 1 indicates
+// no limit. Reconciles are limited before they're dequeued (see concurrencyLimitedQueue) so that, where the priority
+// queue is enabled, the highest priority reconcile is dequeued once within the limit.
+type concurrencyLimiter struct {
+	limit    func() int
+	active   int
+	shutDown bool
+	mutex    sync.Mutex
+	cond     *sync.Cond
+}
+
+func newConcurrencyLimiter(limit func() int) *concurrencyLimiter {
+	l := &concurrencyLimiter{limit: limit}
+	l.cond = sync.NewCond(&l.mutex)
+	return l
+}
+
+// acquire blocks until the number of active reconciles is within the current limit, then marks a reconcile as active.
+// Doesn't block once shut down.
+func (l *concurrencyLimiter) acquire() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	for limit := l.limit(); !l.shutDown && limit > 0 && l.active >= limit; limit = l.limit() {
+		l.cond.Wait()
+	}
+	l.active++
+}
+
+// release marks a reconcile as no longer active.
+func (l *concurrencyLimiter) release() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.active--
+	l.cond.Broadcast()
+}
+
+// limitChanged wakes any blocked acquirers so that an increased limit takes effect immediately.
+func (l *concurrencyLimiter) limitChanged() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.cond.Broadcast()
+}
+
+// shutdown wakes any blocked acquirers and prevents further blocking, so that workers aren't left blocked upon
+// shutdown.
+func (l *concurrencyLimiter) shutdown() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.shutDown = true
+	l.cond.Broadcast()
+}
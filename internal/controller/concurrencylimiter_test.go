@@ -0,0 +1,113 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConcurrencyLimiter(t *testing.T) {
+	l := newConcurrencyLimiter(func() int { return 1 })
+	assert.NotNil(t, l.limit)
+	assert.NotNil(t, l.cond)
+}
+
+func TestConcurrencyLimiterAcquireRelease(t *testing.T) {
+	t.Run("NoLimit", func(t *testing.T) {
+		l := newConcurrencyLimiter(func() int { return 0 })
+		l.acquire()
+		l.acquire()
+		assert.Equal(t, 2, l.active)
+		l.release()
+		l.release()
+		assert.Equal(t, 0, l.active)
+	})
+
+	t.Run("BlocksUntilReleased", func(t *testing.T) {
+		l := newConcurrencyLimiter(func() int { return 1 })
+		l.acquire()
+
+		acquired := make(chan struct{})
+		go func() {
+			l.acquire()
+			close(acquired)
+		}()
+
+		select {
+		case <-acquired:
+			assert.Fail(t, "acquired unexpectedly")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		l.release()
+		select {
+		case <-acquired:
+		case <-time.After(time.Second):
+			assert.Fail(t, "not acquired")
+		}
+	})
+
+	t.Run("BlocksUntilLimitIncreased", func(t *testing.T) {
+		limit := atomic.Int64{}
+		limit.Store(1)
+		l := newConcurrencyLimiter(func() int { return int(limit.Load()) })
+		l.acquire()
+
+		acquired := make(chan struct{})
+		go func() {
+			l.acquire()
+			close(acquired)
+		}()
+
+		select {
+		case <-acquired:
+			assert.Fail(t, "acquired unexpectedly")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		limit.Store(2)
+		l.limitChanged()
+		select {
+		case <-acquired:
+		case <-time.After(time.Second):
+			assert.Fail(t, "not acquired")
+		}
+	})
+}
+
+func TestConcurrencyLimiterShutdown(t *testing.T) {
+	l := newConcurrencyLimiter(func() int { return 1 })
+	l.acquire()
+
+	acquired := make(chan struct{})
+	go func() {
+		l.acquire()
+		close(acquired)
+	}()
+
+	l.shutdown()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		assert.Fail(t, "not acquired")
+	}
+	assert.True(t, l.shutDown)
+}
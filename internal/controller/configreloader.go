@@ -0,0 +1,112 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	metricsconfig "github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/config"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/metricscommon"
+)
+
+// configReloadPollInterval is how frequently the configuration file is checked for changes.
+const configReloadPollInterval = 10 * time.Second
+
+// configReloader is a manager.Runnable that reloads configuration when the contents of the configuration file change.
+// The file is polled rather than watched for file system notifications so that updates to mounted ConfigMaps (which
+// are enacted via symlink swaps) are reliably detected.
+type configReloader struct {
+	controllerConfig controllercommon.ControllerConfig
+	limiter          *concurrencyLimiter
+	pollInterval     time.Duration
+	lastContents     []byte
+}
+
+func newConfigReloader(
+	controllerConfig controllercommon.ControllerConfig,
+	limiter *concurrencyLimiter,
+) *configReloader {
+	// Configuration was already loaded from the file upon start.
+	lastContents, _ := os.ReadFile(controllerConfig.ConfigFile)
+
+	return &configReloader{
+		controllerConfig: controllerConfig,
+		limiter:          limiter,
+		pollInterval:     configReloadPollInterval,
+		lastContents:     lastContents,
+	}
+}
+
+// Start implements manager.Runnable to poll the configuration file until ctx is done.
+func (r *configReloader) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			r.reload()
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable so that configuration is reloaded regardless of
+// leadership.
+func (r *configReloader) NeedLeaderElection() bool {
+	return false
+}
+
+// reload reloads configuration if the contents of the configuration file have changed, and propagates reloaded values
+// that aren't otherwise read upon use.
+func (r *configReloader) reload() {
+	contents, err := os.ReadFile(r.controllerConfig.ConfigFile)
+	if err != nil {
+		logging.Errorf(nil, err, "unable to read config file for reload (current configuration retained)")
+		metricsconfig.Reload(metricscommon.OutcomeFailure).Inc()
+		return
+	}
+
+	if bytes.Equal(contents, r.lastContents) {
+		return
+	}
+	r.lastContents = contents
+
+	changes, err := r.controllerConfig.Reload(contents)
+	if err != nil {
+		logging.Errorf(nil, err, "unable to reload configuration (current configuration retained)")
+		metricsconfig.Reload(metricscommon.OutcomeFailure).Inc()
+		return
+	}
+
+	logging.SetV(logging.V(r.controllerConfig.CurrentLogV()))
+	r.limiter.limitChanged()
+
+	if len(changes) == 0 {
+		logging.Infof(nil, logging.VInfo, "(config) reloaded with no changes to reloadable values")
+	}
+	for _, change := range changes {
+		logging.Infof(nil, logging.VInfo, "(config) reloaded %s", change)
+	}
+	metricsconfig.Reload(metricscommon.OutcomeSuccess).Inc()
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	metricsconfig "github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/config"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/metricscommon"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/component-base/metrics/testutil"
+)
+
+func TestNewConfigReloader(t *testing.T) {
+	config := controllercommon.ControllerConfig{ConfigFile: "test"}
+	limiter := newConcurrencyLimiter(func() int { return 0 })
+	r := newConfigReloader(config, limiter)
+	assert.Equal(t, config, r.controllerConfig)
+	assert.Equal(t, limiter, r.limiter)
+	assert.Equal(t, configReloadPollInterval, r.pollInterval)
+}
+
+func TestConfigReloaderStart(t *testing.T) {
+	metricsconfig.ResetMetrics()
+	path, config := loadedControllerConfig(t, "")
+	r := newConfigReloader(config, newConcurrencyLimiter(config.CurrentMaxConcurrentReconciles))
+	r.pollInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- r.Start(ctx)
+	}()
+
+	assert.NoError(t, os.WriteFile(path, []byte("scale-when-unknown-resources: true\n"), 0600))
+	assert.Eventually(t, config.CurrentScaleWhenUnknownResources, time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestConfigReloaderNeedLeaderElection(t *testing.T) {
+	assert.False(t, (&configReloader{}).NeedLeaderElection())
+}
+
+func TestConfigReloaderReload(t *testing.T) {
+	tests := []struct {
+		name              string
+		reloadContents    string
+		removeFile        bool
+		wantSuccessMetric float64
+		wantFailureMetric float64
+		wantLogV          logging.V
+		wantLogMsg        string
+	}{
+		{
+			name:              "UnableToRead",
+			removeFile:        true,
+			wantFailureMetric: 1,
+			wantLogV:          logging.VTrace,
+			wantLogMsg:        "unable to read config file for reload (current configuration retained)",
+		},
+		{
+			name:     "Unchanged",
+			wantLogV: logging.VTrace,
+		},
+		{
+			name:              "UnableToReload",
+			reloadContents:    "log-v: test\n",
+			wantFailureMetric: 1,
+			wantLogV:          logging.VTrace,
+			wantLogMsg:        "unable to reload configuration (current configuration retained)",
+		},
+		{
+			name:              "NoChanges",
+			reloadContents:    "log-v: 2\n# comment\n",
+			wantSuccessMetric: 1,
+			wantLogV:          logging.VTrace,
+			wantLogMsg:        "(config) reloaded with no changes to reloadable values",
+		},
+		{
+			name:              "Changes",
+			reloadContents:    "log-v: 1\n",
+			wantSuccessMetric: 1,
+			wantLogV:          logging.VDebug,
+			wantLogMsg:        "(config) reloaded log-v: 2 -> 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metricsconfig.ResetMetrics()
+			initialContents := "log-v: 2\n"
+			path, config := loadedControllerConfig(t, initialContents)
+			r := newConfigReloader(config, newConcurrencyLimiter(config.CurrentMaxConcurrentReconciles))
+			assert.Equal(t, []byte(initialContents), r.lastContents)
+
+			if tt.removeFile {
+				assert.NoError(t, os.Remove(path))
+			} else if tt.reloadContents != "" {
+				assert.NoError(t, os.WriteFile(path, []byte(tt.reloadContents), 0600))
+			}
+
+			buffer := &bytes.Buffer{}
+			logging.Init(buffer, logging.VTrace, false)
+			r.reload()
+
+			successValue, _ := testutil.GetCounterMetricValue(metricsconfig.Reload(metricscommon.OutcomeSuccess))
+			assert.Equal(t, tt.wantSuccessMetric, successValue)
+			failureValue, _ := testutil.GetCounterMetricValue(metricsconfig.Reload(metricscommon.OutcomeFailure))
+			assert.Equal(t, tt.wantFailureMetric, failureValue)
+			assert.Equal(t, tt.wantLogV, logging.CurrentV())
+			if tt.wantLogMsg != "" {
+				assert.Contains(t, buffer.String(), tt.wantLogMsg)
+			} else {
+				assert.Empty(t, buffer.String())
+			}
+		})
+	}
+}
+
+// loadedControllerConfig returns the path of a configuration file with the supplied contents, along with a
+// ControllerConfig loaded from it.
+func loadedControllerConfig(t *testing.T, fileContents string) (string, controllercommon.ControllerConfig) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(fileContents), 0600))
+
+	config := controllercommon.ControllerConfig{}
+	cmd := &cobra.Command{
+		Run: func(cmd *cobra.Command, _ []string) {
+			assert.NoError(t, config.Load(cmd))
+		},
+	}
+	config.InitFlags(cmd)
+	cmd.SetArgs([]string{fmt.Sprintf("--config=%s", path)})
+	assert.NoError(t, cmd.Execute())
+	return path, config
+}
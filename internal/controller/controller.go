@@ -17,16 +17,27 @@ limitations under the License.
 package controller
 
 import (
+	"context"
+	"os"
 	"sync"
 
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/control"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
 	csametrics "github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/shard"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/shard/shardcommon"
 	"github.com/go-logr/logr"
 	"k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	runtimecontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
@@ -44,18 +55,23 @@ var instance *Controller
 type Controller struct {
 	controllerConfig controllercommon.ControllerConfig
 	runtimeManager   manager.Manager
+	nodePodReader    client.Reader
 
 	onceInit sync.Once
 }
 
+// NewController returns the controller singleton. nodePodReader is used to list all pods scheduled on a node to
+// determine free node capacity (see kube.NodePodCache), and may be nil if the node capacity pre-check is disabled.
 func NewController(
 	controllerConfig controllercommon.ControllerConfig,
 	runtimeManager manager.Manager,
+	nodePodReader client.Reader,
 ) *Controller {
 	onceInstance.Do(func() {
 		instance = &Controller{
 			controllerConfig: controllerConfig,
 			runtimeManager:   runtimeManager,
+			nodePodReader:    nodePodReader,
 		}
 	})
 
@@ -68,21 +84,101 @@ func (c *Controller) Initialize(runtimeController ...runtimecontroller.Controlle
 	var retErr error
 
 	c.onceInit.Do(func() {
+		var podShard shardcommon.Shard = shard.NewUnsharded()
+		var resync *shardResync
+
+		if c.controllerConfig.ShardingEnabled {
+			identity, err := os.Hostname()
+			if err != nil {
+				retErr = common.WrapErrorf(err, "unable to determine shard identity")
+				return
+			}
+
+			resync = newShardResync(c.runtimeManager.GetClient())
+			podShard = shard.NewShard(
+				c.runtimeManager.GetClient(),
+				c.runtimeManager.GetAPIReader(),
+				c.controllerConfig.ShardingLeaseNamespace,
+				c.controllerConfig.LeaderElectionID(),
+				identity,
+				resync.trigger,
+			)
+			resync.shard = podShard
+		}
+
+		var breaker kubecommon.CircuitBreaker = kube.NewDisabledCircuitBreaker()
+		if c.controllerConfig.CircuitBreakerEnabled {
+			breaker = kube.NewCircuitBreaker(
+				c.controllerConfig.CircuitBreakerFailureRatePercent,
+				c.controllerConfig.CircuitBreakerMinRequests,
+				c.controllerConfig.CircuitBreakerWindowDuration(),
+				c.controllerConfig.CircuitBreakerOpenDuration(),
+			)
+
+			// Report not ready while the circuit breaker is open.
+			if err := c.runtimeManager.AddReadyzCheck("circuit-breaker", breaker.ReadyzCheck); err != nil {
+				retErr = common.WrapErrorf(err, "unable to add circuit breaker readyz check")
+				return
+			}
+		}
+
+		// Startup surplus commanded on a node is determined from cached pods scheduled on it.
+		if len(c.controllerConfig.NodeStartupSurplusBudget()) > 0 {
+			if err := c.runtimeManager.GetFieldIndexer().IndexField(
+				context.Background(),
+				&v1.Pod{},
+				kube.PodNodeNameField,
+				kube.PodNodeNameIndexFunc,
+			); err != nil {
+				retErr = common.WrapErrorf(err, "unable to index pods by node name")
+				return
+			}
+		}
+
 		reconciler := newContainerStartupAutoscalerReconciler(
-			pod.NewPod(c.controllerConfig, c.runtimeManager.GetClient(), c.runtimeManager.GetEventRecorderFor(Name)),
+			pod.NewPod(
+				c.controllerConfig,
+				c.runtimeManager.GetClient(),
+				c.runtimeManager.GetAPIReader(),
+				c.nodePodReader,
+				c.runtimeManager.GetEventRecorderFor(Name),
+				breaker,
+			),
+			control.NewControl(
+				c.runtimeManager.GetClient(),
+				c.controllerConfig.ControlConfigMapNamespace,
+				c.controllerConfig.ControlConfigMapName,
+			),
+			podShard,
 			c.controllerConfig,
 		)
 
 		var actualRuntimeController runtimecontroller.Controller
 
+		// Concurrency is limited to the current (possibly reloaded) max-concurrent-reconciles before reconciles are
+		// dequeued, as more workers than this may be created.
+		limiter := newConcurrencyLimiter(c.controllerConfig.CurrentMaxConcurrentReconciles)
+
+		// Reconciles that fail with an error are subject to the same (but a separate instance of) rate limiter as those
+		// that are requeued, if exponential. Otherwise, controller-runtime's default rate limiter is used.
+		workqueueRateLimiter := newWorkqueueRateLimiter(c.controllerConfig)
+
 		if len(runtimeController) == 0 {
 			var err error
 			actualRuntimeController, err = runtimecontroller.New(
 				Name,
 				c.runtimeManager,
 				runtimecontroller.Options{
-					MaxConcurrentReconciles: c.controllerConfig.MaxConcurrentReconciles,
+					MaxConcurrentReconciles: c.controllerConfig.MaxConcurrentReconcilesWorkers(),
 					Reconciler:              reconciler,
+					RateLimiter:             workqueueRateLimiter,
+					UsePriorityQueue:        ptr.To(c.controllerConfig.PriorityQueueEnabled),
+					NewQueue: func(
+						controllerName string,
+						rateLimiter workqueue.TypedRateLimiter[reconcile.Request],
+					) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+						return newConcurrencyLimitedQueue(newQueue(controllerName, rateLimiter, c.controllerConfig), limiter)
+					},
 					LogConstructor: func(req *reconcile.Request) logr.Logger {
 						log := logging.Logger
 						log = log.WithValues("controller", Name)
@@ -106,24 +202,94 @@ func (c *Controller) Initialize(runtimeController ...runtimecontroller.Controlle
 			actualRuntimeController = runtimeController[0]
 		}
 
-		// Predicates are employed to filter out pod changes that are not necessary to reconcile.
-		if err := actualRuntimeController.Watch(
+		nsFilter, err := newNamespaceFilter(c.controllerConfig, c.runtimeManager.GetClient())
+		if err != nil {
+			retErr = common.WrapErrorf(err, "unable to create namespace filter")
+			return
+		}
+
+		shardPredicate := predicate.NewTypedPredicateFuncs[*v1.Pod](func(pod *v1.Pod) bool {
+			return podShard.Owns(pod.Namespace, pod.Name)
+		})
+
+		// Predicates are employed to filter out pod changes that are not necessary to reconcile. Reconciles expected to
+		// command startup resources are prioritized if the priority queue is enabled.
+		if err = actualRuntimeController.Watch(
 			source.Kind(
 				c.runtimeManager.GetCache(),
 				&v1.Pod{},
-				&handler.TypedEnqueueRequestForObject[*v1.Pod]{},
+				priorityEventHandler{},
 				predicate.TypedFuncs[*v1.Pod]{
 					CreateFunc:  predicateCreateFunc,
 					DeleteFunc:  predicateDeleteFunc,
 					UpdateFunc:  predicateUpdateFunc,
 					GenericFunc: predicateGenericFunc,
 				},
+				predicate.NewTypedPredicateFuncs[*v1.Pod](nsFilter.isPodAllowed),
+				shardPredicate,
 			),
 		); err != nil {
 			retErr = common.WrapErrorf(err, "unable to watch pods")
 			return
 		}
 
+		if c.controllerConfig.NamespaceLabelSelector != "" {
+			// Pods are requeued when their namespace's labels change, as they may now match the namespace label
+			// selector. This also registers the namespace informer upon start, rather than upon first evaluation of
+			// the namespace label selector.
+			if err = actualRuntimeController.Watch(
+				source.Kind(
+					c.runtimeManager.GetCache(),
+					&v1.Namespace{},
+					handler.TypedEnqueueRequestsFromMapFunc(
+						func(ctx context.Context, namespace *v1.Namespace) []reconcile.Request {
+							return nsFilter.podRequestsForNamespace(ctx, namespace, func(pod *v1.Pod) bool {
+								return podShard.Owns(pod.Namespace, pod.Name)
+							})
+						},
+					),
+					predicate.TypedFuncs[*v1.Namespace]{
+						CreateFunc:  func(event.TypedCreateEvent[*v1.Namespace]) bool { return false },
+						DeleteFunc:  func(event.TypedDeleteEvent[*v1.Namespace]) bool { return false },
+						UpdateFunc:  namespaceLabelsChanged,
+						GenericFunc: func(event.TypedGenericEvent[*v1.Namespace]) bool { return false },
+					},
+				),
+			); err != nil {
+				retErr = common.WrapErrorf(err, "unable to watch namespaces")
+				return
+			}
+		}
+
+		if c.controllerConfig.ShardingEnabled {
+			// Owned pods are enqueued upon shard rebalance.
+			if err = actualRuntimeController.Watch(
+				source.Channel(
+					resync.events,
+					priorityEventHandler{},
+					source.WithPredicates[*v1.Pod, reconcile.Request](
+						predicate.NewTypedPredicateFuncs[*v1.Pod](nsFilter.isPodAllowed),
+						shardPredicate,
+					),
+				),
+			); err != nil {
+				retErr = common.WrapErrorf(err, "unable to watch shard rebalances")
+				return
+			}
+
+			if err = c.runtimeManager.Add(podShard); err != nil {
+				retErr = common.WrapErrorf(err, "unable to add shard")
+				return
+			}
+		}
+
+		if c.controllerConfig.IsReloadable() {
+			if err := c.runtimeManager.Add(newConfigReloader(c.controllerConfig, limiter)); err != nil {
+				retErr = common.WrapErrorf(err, "unable to add config reloader")
+				return
+			}
+		}
+
 		csametrics.RegisterAllMetrics(metrics.Registry)
 	})
 
@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube"
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -32,6 +33,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -69,6 +71,17 @@ func (m *mockController) GetLogger() logr.Logger {
 
 // ---------------------------------------------------------------------------------------------------------------------
 
+type mockFieldIndexer struct {
+	mock.Mock
+}
+
+func (m *mockFieldIndexer) IndexField(ctx context.Context, obj client.Object, field string, _ client.IndexerFunc) error {
+	args := m.Called(ctx, obj, field)
+	return args.Error(0)
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
 type mockRuntimeManager struct {
 	mock.Mock
 }
@@ -108,7 +121,8 @@ func (m *mockRuntimeManager) GetClient() client.Client {
 }
 
 func (m *mockRuntimeManager) GetFieldIndexer() client.FieldIndexer {
-	panic(errors.New("not supported"))
+	args := m.Called()
+	return args.Get(0).(client.FieldIndexer)
 }
 
 func (m *mockRuntimeManager) GetEventRecorderFor(name string) record.EventRecorder {
@@ -124,7 +138,11 @@ func (m *mockRuntimeManager) GetRESTMapper() meta.RESTMapper {
 }
 
 func (m *mockRuntimeManager) GetAPIReader() client.Reader {
-	panic(errors.New("not supported"))
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(client.Reader)
 }
 
 func (m *mockRuntimeManager) Start(ctx context.Context) error {
@@ -132,8 +150,9 @@ func (m *mockRuntimeManager) Start(ctx context.Context) error {
 	return args.Error(0)
 }
 
-func (m *mockRuntimeManager) Add(_ manager.Runnable) error {
-	panic(errors.New("not supported"))
+func (m *mockRuntimeManager) Add(runnable manager.Runnable) error {
+	args := m.Called(runnable)
+	return args.Error(0)
 }
 
 func (m *mockRuntimeManager) Elected() <-chan struct{} {
@@ -148,8 +167,9 @@ func (m *mockRuntimeManager) AddHealthzCheck(_ string, _ healthz.Checker) error
 	panic(errors.New("not supported"))
 }
 
-func (m *mockRuntimeManager) AddReadyzCheck(_ string, _ healthz.Checker) error {
-	panic(errors.New("not supported"))
+func (m *mockRuntimeManager) AddReadyzCheck(name string, check healthz.Checker) error {
+	args := m.Called(name, check)
+	return args.Error(0)
 }
 func (m *mockRuntimeManager) GetWebhookServer() webhook.Server {
 	panic(errors.New("not supported"))
@@ -169,15 +189,17 @@ func TestNewController(t *testing.T) {
 	t.Run("Ok", func(t *testing.T) {
 		conf := controllercommon.ControllerConfig{KubeConfig: "test1"}
 		runtimeManager := newMockRuntimeManager(func(*mockRuntimeManager) {})
-		cont := NewController(conf, runtimeManager)
+		nodePodReader := fake.NewClientBuilder().Build()
+		cont := NewController(conf, runtimeManager, nodePodReader)
 		expected := &Controller{
 			controllerConfig: conf,
 			runtimeManager:   runtimeManager,
+			nodePodReader:    nodePodReader,
 		}
 		assert.Equal(t, expected, cont)
 
 		conf = controllercommon.ControllerConfig{KubeConfig: "test2"}
-		cont = NewController(conf, runtimeManager)
+		cont = NewController(conf, runtimeManager, nil)
 		assert.Equal(t, "test1", cont.controllerConfig.KubeConfig)
 	})
 }
@@ -185,14 +207,58 @@ func TestNewController(t *testing.T) {
 func TestControllerInitialize(t *testing.T) {
 	tests := []struct {
 		name                     string
+		configFunc               func(*testing.T) controllercommon.ControllerConfig
 		configManagerMockFunc    func(*mockRuntimeManager)
 		configControllerMockFunc func(*mockController)
 		wantErrMsg               string
+		wantAdd                  bool
 	}{
+		{
+			"UnableToCreateNamespaceFilter",
+			func(*testing.T) controllercommon.ControllerConfig {
+				return controllercommon.ControllerConfig{NamespaceLabelSelector: "!!"}
+			},
+			func(runtimeManager *mockRuntimeManager) {
+				runtimeManager.On("GetClient").Return(nil)
+				runtimeManager.On("GetAPIReader").Return(nil)
+				runtimeManager.On("GetEventRecorderFor", mock.Anything).Return(nil)
+			},
+			func(*mockController) {},
+			"unable to create namespace filter",
+			false,
+		},
+		{
+			"UnableToAddCircuitBreakerReadyzCheck",
+			func(*testing.T) controllercommon.ControllerConfig {
+				return controllercommon.ControllerConfig{CircuitBreakerEnabled: true}
+			},
+			func(runtimeManager *mockRuntimeManager) {
+				runtimeManager.On("AddReadyzCheck", "circuit-breaker", mock.Anything).Return(errors.New(""))
+			},
+			func(*mockController) {},
+			"unable to add circuit breaker readyz check",
+			false,
+		},
+		{
+			"UnableToIndexPodsByNodeName",
+			func(*testing.T) controllercommon.ControllerConfig {
+				return controllercommon.ControllerConfig{NodeStartupSurplusBudgetCpu: "1"}
+			},
+			func(runtimeManager *mockRuntimeManager) {
+				indexer := &mockFieldIndexer{}
+				indexer.On("IndexField", mock.Anything, mock.Anything, kube.PodNodeNameField).Return(errors.New(""))
+				runtimeManager.On("GetFieldIndexer").Return(indexer)
+			},
+			func(*mockController) {},
+			"unable to index pods by node name",
+			false,
+		},
 		{
 			"UnableToWatchPods",
+			nil,
 			func(runtimeManager *mockRuntimeManager) {
 				runtimeManager.On("GetClient").Return(nil)
+				runtimeManager.On("GetAPIReader").Return(nil)
 				runtimeManager.On("GetEventRecorderFor", mock.Anything).Return(nil)
 				runtimeManager.On("GetCache").Return(nil)
 			},
@@ -200,11 +266,87 @@ func TestControllerInitialize(t *testing.T) {
 				controller.On("Watch", mock.Anything, mock.Anything, mock.Anything).Return(errors.New(""))
 			},
 			"unable to watch pods",
+			false,
+		},
+		{
+			"UnableToWatchNamespaces",
+			func(*testing.T) controllercommon.ControllerConfig {
+				return controllercommon.ControllerConfig{NamespaceLabelSelector: "key=value"}
+			},
+			func(runtimeManager *mockRuntimeManager) {
+				runtimeManager.On("GetClient").Return(nil)
+				runtimeManager.On("GetAPIReader").Return(nil)
+				runtimeManager.On("GetEventRecorderFor", mock.Anything).Return(nil)
+				runtimeManager.On("GetCache").Return(nil)
+			},
+			func(controller *mockController) {
+				controller.On("Watch", mock.Anything).Return(nil).Once()
+				controller.On("Watch", mock.Anything).Return(errors.New(""))
+			},
+			"unable to watch namespaces",
+			false,
+		},
+		{
+			"UnableToAddConfigReloader",
+			func(t *testing.T) controllercommon.ControllerConfig {
+				_, config := loadedControllerConfig(t, "")
+				return config
+			},
+			func(runtimeManager *mockRuntimeManager) {
+				runtimeManager.On("GetClient").Return(nil)
+				runtimeManager.On("GetAPIReader").Return(nil)
+				runtimeManager.On("GetEventRecorderFor", mock.Anything).Return(nil)
+				runtimeManager.On("GetCache").Return(nil)
+				runtimeManager.On("Add", mock.Anything).Return(errors.New(""))
+			},
+			func(controller *mockController) {
+				controller.On("Watch", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			"unable to add config reloader",
+			true,
+		},
+		{
+			"UnableToWatchShardRebalances",
+			func(*testing.T) controllercommon.ControllerConfig {
+				return controllercommon.ControllerConfig{ShardingEnabled: true, ShardingLeaseNamespace: "namespace"}
+			},
+			func(runtimeManager *mockRuntimeManager) {
+				runtimeManager.On("GetClient").Return(nil)
+				runtimeManager.On("GetAPIReader").Return(nil)
+				runtimeManager.On("GetEventRecorderFor", mock.Anything).Return(nil)
+				runtimeManager.On("GetCache").Return(nil)
+			},
+			func(controller *mockController) {
+				controller.On("Watch", mock.Anything).Return(nil).Once()
+				controller.On("Watch", mock.Anything).Return(errors.New(""))
+			},
+			"unable to watch shard rebalances",
+			false,
+		},
+		{
+			"UnableToAddShard",
+			func(*testing.T) controllercommon.ControllerConfig {
+				return controllercommon.ControllerConfig{ShardingEnabled: true, ShardingLeaseNamespace: "namespace"}
+			},
+			func(runtimeManager *mockRuntimeManager) {
+				runtimeManager.On("GetClient").Return(nil)
+				runtimeManager.On("GetAPIReader").Return(nil)
+				runtimeManager.On("GetEventRecorderFor", mock.Anything).Return(nil)
+				runtimeManager.On("GetCache").Return(nil)
+				runtimeManager.On("Add", mock.Anything).Return(errors.New(""))
+			},
+			func(controller *mockController) {
+				controller.On("Watch", mock.Anything).Return(nil)
+			},
+			"unable to add shard",
+			true,
 		},
 		{
 			"Ok",
+			nil,
 			func(runtimeManager *mockRuntimeManager) {
 				runtimeManager.On("GetClient").Return(nil)
+				runtimeManager.On("GetAPIReader").Return(nil)
 				runtimeManager.On("GetEventRecorderFor", mock.Anything).Return(nil)
 				runtimeManager.On("GetCache").Return(nil)
 				runtimeManager.On("Start", mock.Anything).Return(nil)
@@ -213,13 +355,19 @@ func TestControllerInitialize(t *testing.T) {
 				controller.On("Watch", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			"",
+			false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			config := controllercommon.ControllerConfig{}
+			if tt.configFunc != nil {
+				config = tt.configFunc(t)
+			}
+			runtimeManager := newMockRuntimeManager(tt.configManagerMockFunc)
 			c := &Controller{
-				controllerConfig: controllercommon.ControllerConfig{},
-				runtimeManager:   newMockRuntimeManager(tt.configManagerMockFunc),
+				controllerConfig: config,
+				runtimeManager:   runtimeManager,
 			}
 
 			err := c.Initialize(newMockController(tt.configControllerMockFunc))
@@ -228,6 +376,11 @@ func TestControllerInitialize(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 			}
+			if tt.wantAdd {
+				runtimeManager.AssertCalled(t, "Add", mock.Anything)
+			} else {
+				runtimeManager.AssertNotCalled(t, "Add", mock.Anything)
+			}
 		})
 	}
 }
@@ -17,13 +17,25 @@ limitations under the License.
 package controllercommon
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/retry"
 	"github.com/spf13/cobra"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 const (
+	flagConfigName    = "config"
+	flagConfigDesc    = "absolute path to a yaml or json configuration file keyed by flag name (not used if not supplied)"
+	flagConfigDefault = ""
+
 	flagKubeConfigName    = "kubeconfig"
 	flagKubeConfigDesc    = "absolute path to the cluster kubeconfig file (uses in-cluster configuration if not supplied)"
 	flagKubeConfigDefault = ""
@@ -36,6 +48,32 @@ const (
 	flagLeaderElectionResourceNamespaceDesc    = "the namespace to create resources in if leader election is enabled (uses current namespace if not supplied)"
 	flagLeaderElectionResourceNamespaceDefault = ""
 
+	flagShardingEnabledName    = "sharding-enabled"
+	flagShardingEnabledDesc    = "whether to enable active-active sharding of pods between replicas (cannot be enabled with leader election)"
+	flagShardingEnabledDefault = false
+
+	flagShardingLeaseNamespaceName    = "sharding-lease-namespace"
+	flagShardingLeaseNamespaceDesc    = "the namespace to create shard membership leases in (required if sharding is enabled)"
+	flagShardingLeaseNamespaceDefault = ""
+
+	flagNodeNameName    = "node-name"
+	flagNodeNameDesc    = "the name of the node to exclusively watch pods scheduled on, for node-local (daemonset) mode (cannot be supplied with leader election or sharding; all nodes watched if not supplied)"
+	flagNodeNameDefault = ""
+
+	flagClassName    = "class"
+	flagClassDesc    = "the class of this csa installation, matched against the class label of pods (only pods without the label are matched if not supplied)"
+	flagClassDefault = ""
+
+	flagWatchNamespacesName = "watch-namespaces"
+	flagWatchNamespacesDesc = "comma-separated namespaces to exclusively watch pods within (all namespaces watched if not supplied)"
+
+	flagExcludeNamespacesName = "exclude-namespaces"
+	flagExcludeNamespacesDesc = "comma-separated namespaces to not watch pods within (cannot be supplied with watch-namespaces)"
+
+	flagNamespaceLabelSelectorName    = "namespace-label-selector"
+	flagNamespaceLabelSelectorDesc    = "label selector that namespaces must match for pods within to be watched (not used if not supplied)"
+	flagNamespaceLabelSelectorDefault = ""
+
 	flagCacheSyncPeriodMinsName    = "cache-sync-period-mins"
 	flagCacheSyncPeriodMinsDesc    = "how frequently the informer should re-sync"
 	flagCacheSyncPeriodMinsDefault = 60
@@ -48,10 +86,54 @@ const (
 	flagRequeueDurationSecsDesc    = "how long to wait before requeuing a reconcile"
 	flagRequeueDurationSecsDefault = 1
 
+	flagRequeueRateLimiterName    = "requeue-rate-limiter"
+	flagRequeueRateLimiterDesc    = "how requeued reconciles are delayed ('fixed' or 'exponential')"
+	flagRequeueRateLimiterDefault = RequeueRateLimiterFixed
+
+	flagRequeueBaseDelayMillisName    = "requeue-base-delay-millis"
+	flagRequeueBaseDelayMillisDesc    = "the initial per-pod backoff delay if requeue-rate-limiter is 'exponential'"
+	flagRequeueBaseDelayMillisDefault = 500
+
+	flagRequeueMaxDelaySecsName    = "requeue-max-delay-secs"
+	flagRequeueMaxDelaySecsDesc    = "the maximum per-pod backoff delay if requeue-rate-limiter is 'exponential'"
+	flagRequeueMaxDelaySecsDefault = 300
+
+	flagRequeueJitterPercentName    = "requeue-jitter-percent"
+	flagRequeueJitterPercentDesc    = "the maximum random percentage added to per-pod backoff delays if requeue-rate-limiter is 'exponential'"
+	flagRequeueJitterPercentDefault = 10
+
+	flagRequeueQPSName    = "requeue-qps"
+	flagRequeueQPSDesc    = "the overall rate of requeues permitted across all pods if requeue-rate-limiter is 'exponential'"
+	flagRequeueQPSDefault = 10
+
+	flagRequeueBurstName    = "requeue-burst"
+	flagRequeueBurstDesc    = "the overall burst of requeues permitted across all pods if requeue-rate-limiter is 'exponential'"
+	flagRequeueBurstDefault = 100
+
 	flagMaxConcurrentReconcilesName    = "max-concurrent-reconciles"
 	flagMaxConcurrentReconcilesDesc    = "the maximum number of concurrent reconciles"
 	flagMaxConcurrentReconcilesDefault = 10
 
+	flagPriorityQueueEnabledName    = "priority-queue-enabled"
+	flagPriorityQueueEnabledDesc    = "whether to dequeue reconciles expected to command startup resources before others"
+	flagPriorityQueueEnabledDefault = false
+
+	flagKubeApiQPSName    = "kube-api-qps"
+	flagKubeApiQPSDesc    = "the sustained rate of kube api requests permitted by the client (0 disables client-side rate limiting)"
+	flagKubeApiQPSDefault = 0
+
+	flagKubeApiBurstName    = "kube-api-burst"
+	flagKubeApiBurstDesc    = "the burst of kube api requests permitted by the client if kube-api-qps is greater than 0"
+	flagKubeApiBurstDefault = 30
+
+	flagKubeApiResizeQPSName    = "kube-api-resize-qps"
+	flagKubeApiResizeQPSDesc    = "the sustained rate of pod resize patches permitted by the client (0 applies no separate limit)"
+	flagKubeApiResizeQPSDefault = 0
+
+	flagKubeApiResizeBurstName    = "kube-api-resize-burst"
+	flagKubeApiResizeBurstDesc    = "the burst of pod resize patches permitted by the client if kube-api-resize-qps is greater than 0"
+	flagKubeApiResizeBurstDefault = 10
+
 	flagStandardRetryAttemptsName    = "standard-retry-attempts"
 	flagStandardRetryAttemptsDesc    = "the maximum number of attempts for a standard retry"
 	flagStandardRetryAttemptsDefault = 3
@@ -60,10 +142,90 @@ const (
 	flagStandardRetryDelaySecsDesc    = "the number of seconds to wait between standard retry attempts"
 	flagStandardRetryDelaySecsDefault = 1
 
+	flagStandardRetryStrategyName    = "standard-retry-strategy"
+	flagStandardRetryStrategyDesc    = "how the delay between standard retry attempts is determined ('fixed', 'exponential' or 'exponential-jitter')"
+	flagStandardRetryStrategyDefault = retry.StrategyFixed
+
+	flagStandardRetryMaxDelaySecsName    = "standard-retry-max-delay-secs"
+	flagStandardRetryMaxDelaySecsDesc    = "the maximum number of seconds to wait between standard retry attempts if standard-retry-strategy is 'exponential' or 'exponential-jitter'"
+	flagStandardRetryMaxDelaySecsDefault = 30
+
+	flagCircuitBreakerEnabledName    = "circuit-breaker-enabled"
+	flagCircuitBreakerEnabledDesc    = "whether to fail kube api calls fast while the kube api is degraded"
+	flagCircuitBreakerEnabledDefault = false
+
+	flagCircuitBreakerFailureRatePercentName    = "circuit-breaker-failure-rate-percent"
+	flagCircuitBreakerFailureRatePercentDesc    = "the percentage of kube api calls that must fail within a window for the circuit breaker to open"
+	flagCircuitBreakerFailureRatePercentDefault = 50
+
+	flagCircuitBreakerMinRequestsName    = "circuit-breaker-min-requests"
+	flagCircuitBreakerMinRequestsDesc    = "the minimum number of kube api calls within a window before the circuit breaker may open"
+	flagCircuitBreakerMinRequestsDefault = 10
+
+	flagCircuitBreakerWindowSecsName    = "circuit-breaker-window-secs"
+	flagCircuitBreakerWindowSecsDesc    = "the length of the window in which kube api call failures are counted"
+	flagCircuitBreakerWindowSecsDefault = 30
+
+	flagCircuitBreakerOpenSecsName    = "circuit-breaker-open-secs"
+	flagCircuitBreakerOpenSecsDesc    = "how long the circuit breaker stays open before permitting a kube api call to probe for recovery"
+	flagCircuitBreakerOpenSecsDefault = 30
+
 	flagScaleWhenUnknownResourcesName    = "scale-when-unknown-resources"
 	flagScaleWhenUnknownResourcesDesc    = "whether to scale when unknown resources (i.e. other than those specified within annotations) are encountered"
 	flagScaleWhenUnknownResourcesDefault = false
 
+	flagNodeCapacityStrategyName    = "node-capacity-strategy"
+	flagNodeCapacityStrategyDesc    = "what to do if the node has insufficient free capacity for startup resources ('disabled', 'skip' or 'requeue')"
+	flagNodeCapacityStrategyDefault = NodeCapacityStrategyDisabled
+
+	flagNodeStartupSurplusBudgetCpuName    = "node-startup-surplus-budget-cpu"
+	flagNodeStartupSurplusBudgetCpuDesc    = "the maximum total cpu startup surplus (startup less post-startup requests) commanded per node at any moment (not used if not supplied, can't be supplied if sharding enabled)"
+	flagNodeStartupSurplusBudgetCpuDefault = ""
+
+	flagNodeStartupSurplusBudgetMemoryName    = "node-startup-surplus-budget-memory"
+	flagNodeStartupSurplusBudgetMemoryDesc    = "the maximum total memory startup surplus (startup less post-startup requests) commanded per node at any moment (not used if not supplied, can't be supplied if sharding enabled)"
+	flagNodeStartupSurplusBudgetMemoryDefault = ""
+
+	flagNodeUpscalePriorityOrderingName    = "node-upscale-priority-ordering"
+	flagNodeUpscalePriorityOrderingDesc    = "whether pods waiting for startup resources on the same constrained node are upscaled in order of pod priority"
+	flagNodeUpscalePriorityOrderingDefault = false
+
+	flagNodeUpscaleTieBreakName    = "node-upscale-tie-break"
+	flagNodeUpscaleTieBreakDesc    = "how waiting pods of equal priority are ordered when node-upscale-priority-ordering is enabled ('longest-waiting' or 'oldest-pod')"
+	flagNodeUpscaleTieBreakDefault = NodeUpscaleTieBreakLongestWaiting
+
+	flagEvictionFallbackDeferredTimeoutSecsName    = "eviction-fallback-deferred-timeout-secs"
+	flagEvictionFallbackDeferredTimeoutSecsDesc    = "the number of seconds startup resources may remain deferred before a pod that opts into eviction fallback is evicted"
+	flagEvictionFallbackDeferredTimeoutSecsDefault = 300
+
+	flagEvictionFallbackCooldownSecsName    = "eviction-fallback-cooldown-secs"
+	flagEvictionFallbackCooldownSecsDesc    = "the minimum number of seconds between eviction fallback evictions of pods of the same workload"
+	flagEvictionFallbackCooldownSecsDefault = 900
+
+	flagStartupEvictionProtectionName    = "startup-eviction-protection"
+	flagStartupEvictionProtectionDesc    = "whether to annotate pods to protect them from eviction by consolidation tools (e.g. cluster-autoscaler) while they hold startup resources"
+	flagStartupEvictionProtectionDefault = false
+
+	flagStartupEvictionProtectionAnnotationsName    = "startup-eviction-protection-annotations"
+	flagStartupEvictionProtectionAnnotationsDesc    = "comma-separated key=value annotations applied to pods while they hold startup resources (if startup-eviction-protection is enabled)"
+	flagStartupEvictionProtectionAnnotationsDefault = "cluster-autoscaler.kubernetes.io/safe-to-evict=false"
+
+	flagDisabledFinalResourcesName    = "disabled-final-resources"
+	flagDisabledFinalResourcesDesc    = "the resources to apply to the target container when csa is disabled for a pod ('post-startup' or 'admitted')"
+	flagDisabledFinalResourcesDefault = DisabledFinalResourcesPostStartup
+
+	flagDryRunName    = "dry-run"
+	flagDryRunDesc    = "whether to only log and report upon scales rather than command them"
+	flagDryRunDefault = false
+
+	flagControlConfigMapNamespaceName    = "control-config-map-namespace"
+	flagControlConfigMapNamespaceDesc    = "the namespace of the control configmap (required if control-config-map-name is supplied)"
+	flagControlConfigMapNamespaceDefault = ""
+
+	flagControlConfigMapNameName    = "control-config-map-name"
+	flagControlConfigMapNameDesc    = "the name of the control configmap that holds the kill switch and blackout windows (not used if not supplied)"
+	flagControlConfigMapNameDefault = ""
+
 	flagLogVName    = "log-v"
 	flagLogVDesc    = "log verbosity level (0: info, 1: debug, 2: trace) - 2 used if invalid"
 	flagLogVDefault = 0
@@ -73,25 +235,111 @@ const (
 	flagLogAddCallerDefault = false
 )
 
+// leaderElectionIDBase is the leader election ID used if no class is supplied, and the base of the leader election ID
+// otherwise.
+const leaderElectionIDBase = "csa-expediagroup-com"
+
+const (
+	// DisabledFinalResourcesPostStartup indicates that post-startup resources are applied when CSA is disabled for a pod.
+	DisabledFinalResourcesPostStartup = "post-startup"
+
+	// DisabledFinalResourcesAdmitted indicates that the resources the pod was admitted with are applied when CSA is
+	// disabled for a pod.
+	DisabledFinalResourcesAdmitted = "admitted"
+)
+
+const (
+	// NodeCapacityStrategyDisabled indicates that startup resources are commanded without checking node capacity.
+	NodeCapacityStrategyDisabled = "disabled"
+
+	// NodeCapacityStrategySkip indicates that startup resources aren't commanded if the node has insufficient free
+	// capacity, leaving post-startup resources applied.
+	NodeCapacityStrategySkip = "skip"
+
+	// NodeCapacityStrategyRequeue indicates that startup resources aren't commanded if the node has insufficient free
+	// capacity, and the reconcile is requeued to check again later.
+	NodeCapacityStrategyRequeue = "requeue"
+)
+
+const (
+	// NodeUpscaleTieBreakLongestWaiting indicates that the pod that has been waiting for startup resources the longest
+	// is upscaled first.
+	NodeUpscaleTieBreakLongestWaiting = "longest-waiting"
+
+	// NodeUpscaleTieBreakOldestPod indicates that the pod that was created first is upscaled first.
+	NodeUpscaleTieBreakOldestPod = "oldest-pod"
+)
+
+const (
+	// RequeueRateLimiterFixed indicates that reconciles that fail are requeued after requeue-duration-secs.
+	RequeueRateLimiterFixed = "fixed"
+
+	// RequeueRateLimiterExponential indicates that reconciles that fail are requeued using per-pod exponential backoff
+	// with jitter, subject to an overall token bucket.
+	RequeueRateLimiterExponential = "exponential"
+)
+
 // ControllerConfig represents the configuration of the CSA controller.
 type ControllerConfig struct {
+	ConfigFile                      string
 	KubeConfig                      string
 	LeaderElectionEnabled           bool
 	LeaderElectionResourceNamespace string
-
-	CacheSyncPeriodMins         int
-	GracefulShutdownTimeoutSecs int
-	RequeueDurationSecs         int
-	MaxConcurrentReconciles     int
-	StandardRetryAttempts       int
-	StandardRetryDelaySecs      int
-	ScaleWhenUnknownResources   bool
-	LogV                        int
-	LogAddCaller                bool
+	ShardingEnabled                 bool
+	ShardingLeaseNamespace          string
+	NodeName                        string
+	Class                           string
+	WatchNamespaces                 []string
+	ExcludeNamespaces               []string
+	NamespaceLabelSelector          string
+
+	CacheSyncPeriodMins                  int
+	GracefulShutdownTimeoutSecs          int
+	RequeueDurationSecs                  int
+	RequeueRateLimiter                   string
+	RequeueBaseDelayMillis               int
+	RequeueMaxDelaySecs                  int
+	RequeueJitterPercent                 int
+	RequeueQPS                           int
+	RequeueBurst                         int
+	MaxConcurrentReconciles              int
+	PriorityQueueEnabled                 bool
+	KubeApiQPS                           int
+	KubeApiBurst                         int
+	KubeApiResizeQPS                     int
+	KubeApiResizeBurst                   int
+	StandardRetryAttempts                int
+	StandardRetryDelaySecs               int
+	StandardRetryStrategy                string
+	StandardRetryMaxDelaySecs            int
+	CircuitBreakerEnabled                bool
+	CircuitBreakerFailureRatePercent     int
+	CircuitBreakerMinRequests            int
+	CircuitBreakerWindowSecs             int
+	CircuitBreakerOpenSecs               int
+	ScaleWhenUnknownResources            bool
+	NodeCapacityStrategy                 string
+	NodeStartupSurplusBudgetCpu          string
+	NodeStartupSurplusBudgetMemory       string
+	NodeUpscalePriorityOrdering          bool
+	NodeUpscaleTieBreak                  string
+	EvictionFallbackDeferredTimeoutSecs  int
+	EvictionFallbackCooldownSecs         int
+	StartupEvictionProtection            bool
+	StartupEvictionProtectionAnnotations []string
+	DisabledFinalResources               string
+	DryRun                               bool
+	ControlConfigMapNamespace            string
+	ControlConfigMapName                 string
+	LogV                                 int
+	LogAddCaller                         bool
 
 	BindAddressMetrics string
 	BindAddressProbes  string
 	BindAddressPprof   string
+
+	sources    map[string]configSource
+	reloadable *reloadable
 }
 
 func NewControllerConfig() ControllerConfig {
@@ -104,6 +352,11 @@ func NewControllerConfig() ControllerConfig {
 
 // InitFlags defines Cobra flags for the CSA controller.
 func (c *ControllerConfig) InitFlags(command *cobra.Command) {
+	command.Flags().StringVar(
+		&c.ConfigFile,
+		flagConfigName, flagConfigDefault, flagConfigDesc,
+	)
+
 	command.Flags().StringVar(
 		&c.KubeConfig,
 		flagKubeConfigName, flagKubeConfigDefault, flagKubeConfigDesc,
@@ -119,6 +372,41 @@ func (c *ControllerConfig) InitFlags(command *cobra.Command) {
 		flagLeaderElectionResourceNamespaceName, flagLeaderElectionResourceNamespaceDefault, flagLeaderElectionResourceNamespaceDesc,
 	)
 
+	command.Flags().BoolVar(
+		&c.ShardingEnabled,
+		flagShardingEnabledName, flagShardingEnabledDefault, flagShardingEnabledDesc,
+	)
+
+	command.Flags().StringVar(
+		&c.ShardingLeaseNamespace,
+		flagShardingLeaseNamespaceName, flagShardingLeaseNamespaceDefault, flagShardingLeaseNamespaceDesc,
+	)
+
+	command.Flags().StringVar(
+		&c.NodeName,
+		flagNodeNameName, flagNodeNameDefault, flagNodeNameDesc,
+	)
+
+	command.Flags().StringVar(
+		&c.Class,
+		flagClassName, flagClassDefault, flagClassDesc,
+	)
+
+	command.Flags().StringSliceVar(
+		&c.WatchNamespaces,
+		flagWatchNamespacesName, nil, flagWatchNamespacesDesc,
+	)
+
+	command.Flags().StringSliceVar(
+		&c.ExcludeNamespaces,
+		flagExcludeNamespacesName, nil, flagExcludeNamespacesDesc,
+	)
+
+	command.Flags().StringVar(
+		&c.NamespaceLabelSelector,
+		flagNamespaceLabelSelectorName, flagNamespaceLabelSelectorDefault, flagNamespaceLabelSelectorDesc,
+	)
+
 	command.Flags().IntVar(
 		&c.CacheSyncPeriodMins,
 		flagCacheSyncPeriodMinsName, flagCacheSyncPeriodMinsDefault, flagCacheSyncPeriodMinsDesc,
@@ -134,11 +422,66 @@ func (c *ControllerConfig) InitFlags(command *cobra.Command) {
 		flagRequeueDurationSecsName, flagRequeueDurationSecsDefault, flagRequeueDurationSecsDesc,
 	)
 
+	command.Flags().StringVar(
+		&c.RequeueRateLimiter,
+		flagRequeueRateLimiterName, flagRequeueRateLimiterDefault, flagRequeueRateLimiterDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.RequeueBaseDelayMillis,
+		flagRequeueBaseDelayMillisName, flagRequeueBaseDelayMillisDefault, flagRequeueBaseDelayMillisDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.RequeueMaxDelaySecs,
+		flagRequeueMaxDelaySecsName, flagRequeueMaxDelaySecsDefault, flagRequeueMaxDelaySecsDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.RequeueJitterPercent,
+		flagRequeueJitterPercentName, flagRequeueJitterPercentDefault, flagRequeueJitterPercentDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.RequeueQPS,
+		flagRequeueQPSName, flagRequeueQPSDefault, flagRequeueQPSDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.RequeueBurst,
+		flagRequeueBurstName, flagRequeueBurstDefault, flagRequeueBurstDesc,
+	)
+
 	command.Flags().IntVar(
 		&c.MaxConcurrentReconciles,
 		flagMaxConcurrentReconcilesName, flagMaxConcurrentReconcilesDefault, flagMaxConcurrentReconcilesDesc,
 	)
 
+	command.Flags().BoolVar(
+		&c.PriorityQueueEnabled,
+		flagPriorityQueueEnabledName, flagPriorityQueueEnabledDefault, flagPriorityQueueEnabledDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.KubeApiQPS,
+		flagKubeApiQPSName, flagKubeApiQPSDefault, flagKubeApiQPSDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.KubeApiBurst,
+		flagKubeApiBurstName, flagKubeApiBurstDefault, flagKubeApiBurstDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.KubeApiResizeQPS,
+		flagKubeApiResizeQPSName, flagKubeApiResizeQPSDefault, flagKubeApiResizeQPSDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.KubeApiResizeBurst,
+		flagKubeApiResizeBurstName, flagKubeApiResizeBurstDefault, flagKubeApiResizeBurstDesc,
+	)
+
 	command.Flags().IntVar(
 		&c.StandardRetryAttempts,
 		flagStandardRetryAttemptsName, flagStandardRetryAttemptsDefault, flagStandardRetryAttemptsDesc,
@@ -149,11 +492,115 @@ func (c *ControllerConfig) InitFlags(command *cobra.Command) {
 		flagStandardRetryDelaySecsName, flagStandardRetryDelaySecsDefault, flagStandardRetryDelaySecsDesc,
 	)
 
+	command.Flags().StringVar(
+		&c.StandardRetryStrategy,
+		flagStandardRetryStrategyName, flagStandardRetryStrategyDefault, flagStandardRetryStrategyDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.StandardRetryMaxDelaySecs,
+		flagStandardRetryMaxDelaySecsName, flagStandardRetryMaxDelaySecsDefault, flagStandardRetryMaxDelaySecsDesc,
+	)
+
+	command.Flags().BoolVar(
+		&c.CircuitBreakerEnabled,
+		flagCircuitBreakerEnabledName, flagCircuitBreakerEnabledDefault, flagCircuitBreakerEnabledDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.CircuitBreakerFailureRatePercent,
+		flagCircuitBreakerFailureRatePercentName,
+		flagCircuitBreakerFailureRatePercentDefault,
+		flagCircuitBreakerFailureRatePercentDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.CircuitBreakerMinRequests,
+		flagCircuitBreakerMinRequestsName, flagCircuitBreakerMinRequestsDefault, flagCircuitBreakerMinRequestsDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.CircuitBreakerWindowSecs,
+		flagCircuitBreakerWindowSecsName, flagCircuitBreakerWindowSecsDefault, flagCircuitBreakerWindowSecsDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.CircuitBreakerOpenSecs,
+		flagCircuitBreakerOpenSecsName, flagCircuitBreakerOpenSecsDefault, flagCircuitBreakerOpenSecsDesc,
+	)
+
 	command.Flags().BoolVar(
 		&c.ScaleWhenUnknownResources,
 		flagScaleWhenUnknownResourcesName, flagScaleWhenUnknownResourcesDefault, flagScaleWhenUnknownResourcesDesc,
 	)
 
+	command.Flags().StringVar(
+		&c.NodeCapacityStrategy,
+		flagNodeCapacityStrategyName, flagNodeCapacityStrategyDefault, flagNodeCapacityStrategyDesc,
+	)
+
+	command.Flags().StringVar(
+		&c.NodeStartupSurplusBudgetCpu,
+		flagNodeStartupSurplusBudgetCpuName, flagNodeStartupSurplusBudgetCpuDefault, flagNodeStartupSurplusBudgetCpuDesc,
+	)
+
+	command.Flags().StringVar(
+		&c.NodeStartupSurplusBudgetMemory,
+		flagNodeStartupSurplusBudgetMemoryName, flagNodeStartupSurplusBudgetMemoryDefault, flagNodeStartupSurplusBudgetMemoryDesc,
+	)
+
+	command.Flags().BoolVar(
+		&c.NodeUpscalePriorityOrdering,
+		flagNodeUpscalePriorityOrderingName, flagNodeUpscalePriorityOrderingDefault, flagNodeUpscalePriorityOrderingDesc,
+	)
+
+	command.Flags().StringVar(
+		&c.NodeUpscaleTieBreak,
+		flagNodeUpscaleTieBreakName, flagNodeUpscaleTieBreakDefault, flagNodeUpscaleTieBreakDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.EvictionFallbackDeferredTimeoutSecs,
+		flagEvictionFallbackDeferredTimeoutSecsName, flagEvictionFallbackDeferredTimeoutSecsDefault, flagEvictionFallbackDeferredTimeoutSecsDesc,
+	)
+
+	command.Flags().IntVar(
+		&c.EvictionFallbackCooldownSecs,
+		flagEvictionFallbackCooldownSecsName, flagEvictionFallbackCooldownSecsDefault, flagEvictionFallbackCooldownSecsDesc,
+	)
+
+	command.Flags().BoolVar(
+		&c.StartupEvictionProtection,
+		flagStartupEvictionProtectionName, flagStartupEvictionProtectionDefault, flagStartupEvictionProtectionDesc,
+	)
+
+	command.Flags().StringSliceVar(
+		&c.StartupEvictionProtectionAnnotations,
+		flagStartupEvictionProtectionAnnotationsName,
+		[]string{flagStartupEvictionProtectionAnnotationsDefault},
+		flagStartupEvictionProtectionAnnotationsDesc,
+	)
+
+	command.Flags().StringVar(
+		&c.DisabledFinalResources,
+		flagDisabledFinalResourcesName, flagDisabledFinalResourcesDefault, flagDisabledFinalResourcesDesc,
+	)
+
+	command.Flags().BoolVar(
+		&c.DryRun,
+		flagDryRunName, flagDryRunDefault, flagDryRunDesc,
+	)
+
+	command.Flags().StringVar(
+		&c.ControlConfigMapNamespace,
+		flagControlConfigMapNamespaceName, flagControlConfigMapNamespaceDefault, flagControlConfigMapNamespaceDesc,
+	)
+
+	command.Flags().StringVar(
+		&c.ControlConfigMapName,
+		flagControlConfigMapNameName, flagControlConfigMapNameDefault, flagControlConfigMapNameDesc,
+	)
+
 	command.Flags().IntVar(
 		&c.LogV,
 		flagLogVName, flagLogVDefault, flagLogVDesc,
@@ -165,20 +612,330 @@ func (c *ControllerConfig) InitFlags(command *cobra.Command) {
 	)
 }
 
-// Log logs the configuration of the CSA controller.
+// Log logs the configuration of the CSA controller, including the source of each value.
 func (c *ControllerConfig) Log() {
-	logging.Infof(nil, logging.VInfo, "(config) %s: %s", flagKubeConfigName, c.KubeConfig)
-	logging.Infof(nil, logging.VInfo, "(config) %s: %t", flagLeaderElectionEnabledName, c.LeaderElectionEnabled)
-	logging.Infof(nil, logging.VInfo, "(config) %s: %s", flagLeaderElectionResourceNamespaceName, c.LeaderElectionResourceNamespace)
-	logging.Infof(nil, logging.VInfo, "(config) %s: %d", flagCacheSyncPeriodMinsName, c.CacheSyncPeriodMins)
-	logging.Infof(nil, logging.VInfo, "(config) %s: %d", flagGracefulShutdownTimeoutSecsName, c.GracefulShutdownTimeoutSecs)
-	logging.Infof(nil, logging.VInfo, "(config) %s: %d", flagRequeueDurationSecsName, c.RequeueDurationSecs)
-	logging.Infof(nil, logging.VInfo, "(config) %s: %d", flagMaxConcurrentReconcilesName, c.MaxConcurrentReconciles)
-	logging.Infof(nil, logging.VInfo, "(config) %s: %d", flagStandardRetryAttemptsName, c.StandardRetryAttempts)
-	logging.Infof(nil, logging.VInfo, "(config) %s: %d", flagStandardRetryDelaySecsName, c.StandardRetryDelaySecs)
-	logging.Infof(nil, logging.VInfo, "(config) %s: %t", flagScaleWhenUnknownResourcesName, c.ScaleWhenUnknownResources)
-	logging.Infof(nil, logging.VInfo, "(config) %s: %d", flagLogVName, c.LogV)
-	logging.Infof(nil, logging.VInfo, "(config) %s: %t", flagLogAddCallerName, c.LogAddCaller)
+	c.logValue(flagConfigName, "%s", c.ConfigFile)
+	c.logValue(flagKubeConfigName, "%s", c.KubeConfig)
+	c.logValue(flagLeaderElectionEnabledName, "%t", c.LeaderElectionEnabled)
+	c.logValue(flagLeaderElectionResourceNamespaceName, "%s", c.LeaderElectionResourceNamespace)
+	c.logValue(flagShardingEnabledName, "%t", c.ShardingEnabled)
+	c.logValue(flagShardingLeaseNamespaceName, "%s", c.ShardingLeaseNamespace)
+	c.logValue(flagNodeNameName, "%s", c.NodeName)
+	c.logValue(flagClassName, "%s", c.Class)
+	c.logValue(flagWatchNamespacesName, "%s", strings.Join(c.WatchNamespaces, ","))
+	c.logValue(flagExcludeNamespacesName, "%s", strings.Join(c.ExcludeNamespaces, ","))
+	c.logValue(flagNamespaceLabelSelectorName, "%s", c.NamespaceLabelSelector)
+	c.logValue(flagCacheSyncPeriodMinsName, "%d", c.CacheSyncPeriodMins)
+	c.logValue(flagGracefulShutdownTimeoutSecsName, "%d", c.GracefulShutdownTimeoutSecs)
+	c.logValue(flagRequeueDurationSecsName, "%d", c.RequeueDurationSecs)
+	c.logValue(flagRequeueRateLimiterName, "%s", c.RequeueRateLimiter)
+	c.logValue(flagRequeueBaseDelayMillisName, "%d", c.RequeueBaseDelayMillis)
+	c.logValue(flagRequeueMaxDelaySecsName, "%d", c.RequeueMaxDelaySecs)
+	c.logValue(flagRequeueJitterPercentName, "%d", c.RequeueJitterPercent)
+	c.logValue(flagRequeueQPSName, "%d", c.RequeueQPS)
+	c.logValue(flagRequeueBurstName, "%d", c.RequeueBurst)
+	c.logValue(flagMaxConcurrentReconcilesName, "%d", c.MaxConcurrentReconciles)
+	c.logValue(flagPriorityQueueEnabledName, "%t", c.PriorityQueueEnabled)
+	c.logValue(flagKubeApiQPSName, "%d", c.KubeApiQPS)
+	c.logValue(flagKubeApiBurstName, "%d", c.KubeApiBurst)
+	c.logValue(flagKubeApiResizeQPSName, "%d", c.KubeApiResizeQPS)
+	c.logValue(flagKubeApiResizeBurstName, "%d", c.KubeApiResizeBurst)
+	c.logValue(flagStandardRetryAttemptsName, "%d", c.StandardRetryAttempts)
+	c.logValue(flagStandardRetryDelaySecsName, "%d", c.StandardRetryDelaySecs)
+	c.logValue(flagStandardRetryStrategyName, "%s", c.StandardRetryStrategy)
+	c.logValue(flagStandardRetryMaxDelaySecsName, "%d", c.StandardRetryMaxDelaySecs)
+	c.logValue(flagCircuitBreakerEnabledName, "%t", c.CircuitBreakerEnabled)
+	c.logValue(flagCircuitBreakerFailureRatePercentName, "%d", c.CircuitBreakerFailureRatePercent)
+	c.logValue(flagCircuitBreakerMinRequestsName, "%d", c.CircuitBreakerMinRequests)
+	c.logValue(flagCircuitBreakerWindowSecsName, "%d", c.CircuitBreakerWindowSecs)
+	c.logValue(flagCircuitBreakerOpenSecsName, "%d", c.CircuitBreakerOpenSecs)
+	c.logValue(flagScaleWhenUnknownResourcesName, "%t", c.ScaleWhenUnknownResources)
+	c.logValue(flagNodeCapacityStrategyName, "%s", c.NodeCapacityStrategy)
+	c.logValue(flagNodeStartupSurplusBudgetCpuName, "%s", c.NodeStartupSurplusBudgetCpu)
+	c.logValue(flagNodeStartupSurplusBudgetMemoryName, "%s", c.NodeStartupSurplusBudgetMemory)
+	c.logValue(flagNodeUpscalePriorityOrderingName, "%t", c.NodeUpscalePriorityOrdering)
+	c.logValue(flagNodeUpscaleTieBreakName, "%s", c.NodeUpscaleTieBreak)
+	c.logValue(flagEvictionFallbackDeferredTimeoutSecsName, "%d", c.EvictionFallbackDeferredTimeoutSecs)
+	c.logValue(flagEvictionFallbackCooldownSecsName, "%d", c.EvictionFallbackCooldownSecs)
+	c.logValue(flagStartupEvictionProtectionName, "%t", c.StartupEvictionProtection)
+	c.logValue(flagStartupEvictionProtectionAnnotationsName, "%s", strings.Join(c.StartupEvictionProtectionAnnotations, ","))
+	c.logValue(flagDisabledFinalResourcesName, "%s", c.DisabledFinalResources)
+	c.logValue(flagDryRunName, "%t", c.DryRun)
+	c.logValue(flagControlConfigMapNamespaceName, "%s", c.ControlConfigMapNamespace)
+	c.logValue(flagControlConfigMapNameName, "%s", c.ControlConfigMapName)
+	c.logValue(flagLogVName, "%d", c.LogV)
+	c.logValue(flagLogAddCallerName, "%t", c.LogAddCaller)
+}
+
+// logValue logs the value of the flag with the supplied name, formatted with verb, along with its source.
+func (c *ControllerConfig) logValue(name string, verb string, value any) {
+	logging.Infof(nil, logging.VInfo, "(config) %s: "+verb+" (source: %s)", name, value, c.source(name))
+}
+
+// Validate validates the configuration of the CSA controller.
+func (c *ControllerConfig) Validate() error {
+	if c.DisabledFinalResources != DisabledFinalResourcesPostStartup &&
+		c.DisabledFinalResources != DisabledFinalResourcesAdmitted {
+		return fmt.Errorf(
+			"%s must be '%s' or '%s' ('%s')",
+			flagDisabledFinalResourcesName,
+			DisabledFinalResourcesPostStartup,
+			DisabledFinalResourcesAdmitted,
+			c.DisabledFinalResources,
+		)
+	}
+
+	if c.ShardingEnabled && c.LeaderElectionEnabled {
+		return fmt.Errorf("%s and %s cannot both be enabled", flagShardingEnabledName, flagLeaderElectionEnabledName)
+	}
+
+	if c.ShardingEnabled && c.ShardingLeaseNamespace == "" {
+		return fmt.Errorf(
+			"%s must be supplied if %s is enabled",
+			flagShardingLeaseNamespaceName,
+			flagShardingEnabledName,
+		)
+	}
+
+	if c.NodeName != "" && c.LeaderElectionEnabled {
+		return fmt.Errorf("%s cannot be supplied if %s is enabled", flagNodeNameName, flagLeaderElectionEnabledName)
+	}
+
+	if c.NodeName != "" && c.ShardingEnabled {
+		return fmt.Errorf("%s cannot be supplied if %s is enabled", flagNodeNameName, flagShardingEnabledName)
+	}
+
+	if c.Class != "" {
+		if errs := validation.IsDNS1123Label(c.Class); len(errs) > 0 {
+			return fmt.Errorf("%s is invalid ('%s'): %s", flagClassName, c.Class, strings.Join(errs, ", "))
+		}
+	}
+
+	if len(c.WatchNamespaces) > 0 && len(c.ExcludeNamespaces) > 0 {
+		return fmt.Errorf("%s and %s cannot both be supplied", flagWatchNamespacesName, flagExcludeNamespacesName)
+	}
+
+	if _, err := labels.Parse(c.NamespaceLabelSelector); err != nil {
+		return common.WrapErrorf(err, "%s is invalid ('%s')", flagNamespaceLabelSelectorName, c.NamespaceLabelSelector)
+	}
+
+	if c.RequeueRateLimiter != RequeueRateLimiterFixed && c.RequeueRateLimiter != RequeueRateLimiterExponential {
+		return fmt.Errorf(
+			"%s must be '%s' or '%s' ('%s')",
+			flagRequeueRateLimiterName,
+			RequeueRateLimiterFixed,
+			RequeueRateLimiterExponential,
+			c.RequeueRateLimiter,
+		)
+	}
+
+	if c.RequeueRateLimiter == RequeueRateLimiterExponential {
+		if c.RequeueBaseDelayMillis < 1 {
+			return fmt.Errorf("%s must be greater than 0 (%d)", flagRequeueBaseDelayMillisName, c.RequeueBaseDelayMillis)
+		}
+
+		if c.RequeueMaxDelayDuration() < c.RequeueBaseDelayDuration() {
+			return fmt.Errorf(
+				"%s must not be less than %s",
+				flagRequeueMaxDelaySecsName,
+				flagRequeueBaseDelayMillisName,
+			)
+		}
+
+		if c.RequeueJitterPercent < 0 || c.RequeueJitterPercent > 100 {
+			return fmt.Errorf("%s must be between 0 and 100 (%d)", flagRequeueJitterPercentName, c.RequeueJitterPercent)
+		}
+
+		if c.RequeueQPS < 1 {
+			return fmt.Errorf("%s must be greater than 0 (%d)", flagRequeueQPSName, c.RequeueQPS)
+		}
+
+		if c.RequeueBurst < 1 {
+			return fmt.Errorf("%s must be greater than 0 (%d)", flagRequeueBurstName, c.RequeueBurst)
+		}
+	}
+
+	if c.KubeApiQPS < 0 {
+		return fmt.Errorf("%s must not be negative (%d)", flagKubeApiQPSName, c.KubeApiQPS)
+	}
+
+	if c.KubeApiQPS > 0 && c.KubeApiBurst < 1 {
+		return fmt.Errorf("%s must be greater than 0 (%d)", flagKubeApiBurstName, c.KubeApiBurst)
+	}
+
+	if c.KubeApiResizeQPS < 0 {
+		return fmt.Errorf("%s must not be negative (%d)", flagKubeApiResizeQPSName, c.KubeApiResizeQPS)
+	}
+
+	if c.KubeApiResizeQPS > 0 && c.KubeApiResizeBurst < 1 {
+		return fmt.Errorf("%s must be greater than 0 (%d)", flagKubeApiResizeBurstName, c.KubeApiResizeBurst)
+	}
+
+	switch c.StandardRetryStrategy {
+	case retry.StrategyFixed:
+	case retry.StrategyExponential, retry.StrategyExponentialJitter:
+		if c.StandardRetryMaxDelaySecs < c.StandardRetryDelaySecs {
+			return fmt.Errorf(
+				"%s must not be less than %s",
+				flagStandardRetryMaxDelaySecsName,
+				flagStandardRetryDelaySecsName,
+			)
+		}
+	default:
+		return fmt.Errorf(
+			"%s must be '%s', '%s' or '%s' ('%s')",
+			flagStandardRetryStrategyName,
+			retry.StrategyFixed,
+			retry.StrategyExponential,
+			retry.StrategyExponentialJitter,
+			c.StandardRetryStrategy,
+		)
+	}
+
+	if c.CircuitBreakerEnabled {
+		if c.CircuitBreakerFailureRatePercent < 1 || c.CircuitBreakerFailureRatePercent > 100 {
+			return fmt.Errorf(
+				"%s must be between 1 and 100 (%d)",
+				flagCircuitBreakerFailureRatePercentName,
+				c.CircuitBreakerFailureRatePercent,
+			)
+		}
+
+		if c.CircuitBreakerMinRequests < 1 {
+			return fmt.Errorf(
+				"%s must be greater than 0 (%d)",
+				flagCircuitBreakerMinRequestsName,
+				c.CircuitBreakerMinRequests,
+			)
+		}
+
+		if c.CircuitBreakerWindowSecs < 1 {
+			return fmt.Errorf("%s must be greater than 0 (%d)", flagCircuitBreakerWindowSecsName, c.CircuitBreakerWindowSecs)
+		}
+
+		if c.CircuitBreakerOpenSecs < 1 {
+			return fmt.Errorf("%s must be greater than 0 (%d)", flagCircuitBreakerOpenSecsName, c.CircuitBreakerOpenSecs)
+		}
+	}
+
+	switch c.NodeCapacityStrategy {
+	case NodeCapacityStrategyDisabled, NodeCapacityStrategySkip, NodeCapacityStrategyRequeue:
+	default:
+		return fmt.Errorf(
+			"%s must be '%s', '%s' or '%s' ('%s')",
+			flagNodeCapacityStrategyName,
+			NodeCapacityStrategyDisabled,
+			NodeCapacityStrategySkip,
+			NodeCapacityStrategyRequeue,
+			c.NodeCapacityStrategy,
+		)
+	}
+
+	for _, budget := range []struct{ name, value string }{
+		{flagNodeStartupSurplusBudgetCpuName, c.NodeStartupSurplusBudgetCpu},
+		{flagNodeStartupSurplusBudgetMemoryName, c.NodeStartupSurplusBudgetMemory},
+	} {
+		name, value := budget.name, budget.value
+		if value == "" {
+			continue
+		}
+
+		// Reservations are held in memory by each replica, so aren't shared between shards with pods on the same node.
+		if c.ShardingEnabled {
+			return fmt.Errorf("%s cannot be supplied if %s is enabled", name, flagShardingEnabledName)
+		}
+
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return fmt.Errorf("%s must be a valid resource quantity ('%s')", name, value)
+		}
+
+		if quantity.Sign() <= 0 {
+			return fmt.Errorf("%s must be greater than 0 ('%s')", name, value)
+		}
+	}
+
+	if c.NodeUpscaleTieBreak != NodeUpscaleTieBreakLongestWaiting && c.NodeUpscaleTieBreak != NodeUpscaleTieBreakOldestPod {
+		return fmt.Errorf(
+			"%s must be '%s' or '%s' ('%s')",
+			flagNodeUpscaleTieBreakName,
+			NodeUpscaleTieBreakLongestWaiting,
+			NodeUpscaleTieBreakOldestPod,
+			c.NodeUpscaleTieBreak,
+		)
+	}
+
+	if c.NodeUpscalePriorityOrdering &&
+		c.NodeCapacityStrategy != NodeCapacityStrategyRequeue && len(c.NodeStartupSurplusBudget()) == 0 {
+		return fmt.Errorf(
+			"%s requires %s to be '%s' or a node startup surplus budget to be supplied",
+			flagNodeUpscalePriorityOrderingName,
+			flagNodeCapacityStrategyName,
+			NodeCapacityStrategyRequeue,
+		)
+	}
+
+	if c.EvictionFallbackDeferredTimeoutSecs < 0 {
+		return fmt.Errorf(
+			"%s must not be negative (%d)",
+			flagEvictionFallbackDeferredTimeoutSecsName,
+			c.EvictionFallbackDeferredTimeoutSecs,
+		)
+	}
+
+	if c.EvictionFallbackCooldownSecs < 0 {
+		return fmt.Errorf("%s must not be negative (%d)", flagEvictionFallbackCooldownSecsName, c.EvictionFallbackCooldownSecs)
+	}
+
+	if c.StartupEvictionProtection {
+		if len(c.StartupEvictionProtectionAnnotations) == 0 {
+			return fmt.Errorf(
+				"%s must be supplied if %s is enabled",
+				flagStartupEvictionProtectionAnnotationsName,
+				flagStartupEvictionProtectionName,
+			)
+		}
+
+		for _, annotation := range c.StartupEvictionProtectionAnnotations {
+			key, _, found := strings.Cut(annotation, "=")
+			if !found {
+				return fmt.Errorf(
+					"%s items must be in key=value form ('%s')",
+					flagStartupEvictionProtectionAnnotationsName,
+					annotation,
+				)
+			}
+
+			if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+				return fmt.Errorf(
+					"%s item key is invalid ('%s'): %s",
+					flagStartupEvictionProtectionAnnotationsName,
+					key,
+					strings.Join(errs, ", "),
+				)
+			}
+		}
+	}
+
+	if c.ControlConfigMapName != "" && c.ControlConfigMapNamespace == "" {
+		return fmt.Errorf(
+			"%s must be supplied if %s is supplied",
+			flagControlConfigMapNamespaceName,
+			flagControlConfigMapNameName,
+		)
+	}
+
+	return nil
+}
+
+// LeaderElectionID returns the leader election ID, which includes any class so that each CSA installation elects its
+// own leader.
+func (c *ControllerConfig) LeaderElectionID() string {
+	if c.Class == "" {
+		return leaderElectionIDBase
+	}
+
+	return fmt.Sprintf("%s-%s", leaderElectionIDBase, c.Class)
 }
 
 // CacheSyncPeriodMinsDuration returns the cache sync period in minutes as a time.Duration.
@@ -195,3 +952,65 @@ func (c *ControllerConfig) GracefulShutdownTimeoutSecsDuration() time.Duration {
 func (c *ControllerConfig) RequeueDurationSecsDuration() time.Duration {
 	return time.Duration(c.RequeueDurationSecs) * time.Second
 }
+
+// RequeueBaseDelayDuration returns the initial per-pod requeue backoff delay as a time.Duration.
+func (c *ControllerConfig) RequeueBaseDelayDuration() time.Duration {
+	return time.Duration(c.RequeueBaseDelayMillis) * time.Millisecond
+}
+
+// RequeueMaxDelayDuration returns the maximum per-pod requeue backoff delay as a time.Duration.
+func (c *ControllerConfig) RequeueMaxDelayDuration() time.Duration {
+	return time.Duration(c.RequeueMaxDelaySecs) * time.Second
+}
+
+// CircuitBreakerWindowDuration returns CircuitBreakerWindowSecs as a time.Duration.
+func (c *ControllerConfig) CircuitBreakerWindowDuration() time.Duration {
+	return time.Duration(c.CircuitBreakerWindowSecs) * time.Second
+}
+
+// CircuitBreakerOpenDuration returns CircuitBreakerOpenSecs as a time.Duration.
+func (c *ControllerConfig) CircuitBreakerOpenDuration() time.Duration {
+	return time.Duration(c.CircuitBreakerOpenSecs) * time.Second
+}
+
+// EvictionFallbackDeferredTimeoutDuration returns EvictionFallbackDeferredTimeoutSecs as a time.Duration.
+func (c *ControllerConfig) EvictionFallbackDeferredTimeoutDuration() time.Duration {
+	return time.Duration(c.EvictionFallbackDeferredTimeoutSecs) * time.Second
+}
+
+// EvictionFallbackCooldownDuration returns EvictionFallbackCooldownSecs as a time.Duration.
+func (c *ControllerConfig) EvictionFallbackCooldownDuration() time.Duration {
+	return time.Duration(c.EvictionFallbackCooldownSecs) * time.Second
+}
+
+// NodeStartupSurplusBudget returns the supplied node startup surplus budgets by resource. Resources without a budget
+// aren't present. Must only be invoked once validated.
+func (c *ControllerConfig) NodeStartupSurplusBudget() v1.ResourceList {
+	ret := v1.ResourceList{}
+
+	if c.NodeStartupSurplusBudgetCpu != "" {
+		ret[v1.ResourceCPU] = resource.MustParse(c.NodeStartupSurplusBudgetCpu)
+	}
+
+	if c.NodeStartupSurplusBudgetMemory != "" {
+		ret[v1.ResourceMemory] = resource.MustParse(c.NodeStartupSurplusBudgetMemory)
+	}
+
+	return ret
+}
+
+// StartupEvictionProtectionAnnotationsMap returns the startup eviction protection annotations by key, or nil if startup
+// eviction protection isn't enabled. Must only be invoked once validated.
+func (c *ControllerConfig) StartupEvictionProtectionAnnotationsMap() map[string]string {
+	if !c.StartupEvictionProtection {
+		return nil
+	}
+
+	ret := map[string]string{}
+	for _, annotation := range c.StartupEvictionProtectionAnnotations {
+		key, value, _ := strings.Cut(annotation, "=")
+		ret[key] = value
+	}
+
+	return ret
+}
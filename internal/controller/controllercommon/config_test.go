@@ -24,8 +24,11 @@ import (
 	"time"
 
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/retry"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 func TestNewControllerConfig(t *testing.T) {
@@ -40,15 +43,58 @@ func TestControllerConfigInitFlags(t *testing.T) {
 		config := ControllerConfig{}
 		cmd := &cobra.Command{
 			Run: func(_ *cobra.Command, _ []string) {
+				assert.Equal(t, flagConfigDefault, config.ConfigFile)
 				assert.Equal(t, flagKubeConfigDefault, config.KubeConfig)
 				assert.Equal(t, flagLeaderElectionEnabledDefault, config.LeaderElectionEnabled)
 				assert.Equal(t, flagLeaderElectionResourceNamespaceDefault, config.LeaderElectionResourceNamespace)
+				assert.Equal(t, flagShardingEnabledDefault, config.ShardingEnabled)
+				assert.Equal(t, flagShardingLeaseNamespaceDefault, config.ShardingLeaseNamespace)
+				assert.Equal(t, flagNodeNameDefault, config.NodeName)
+				assert.Equal(t, flagClassDefault, config.Class)
+				assert.Empty(t, config.WatchNamespaces)
+				assert.Empty(t, config.ExcludeNamespaces)
+				assert.Equal(t, flagNamespaceLabelSelectorDefault, config.NamespaceLabelSelector)
 				assert.Equal(t, flagCacheSyncPeriodMinsDefault, config.CacheSyncPeriodMins)
 				assert.Equal(t, flagGracefulShutdownTimeoutSecsDefault, config.GracefulShutdownTimeoutSecs)
 				assert.Equal(t, flagRequeueDurationSecsDefault, config.RequeueDurationSecs)
+				assert.Equal(t, flagRequeueRateLimiterDefault, config.RequeueRateLimiter)
+				assert.Equal(t, flagRequeueBaseDelayMillisDefault, config.RequeueBaseDelayMillis)
+				assert.Equal(t, flagRequeueMaxDelaySecsDefault, config.RequeueMaxDelaySecs)
+				assert.Equal(t, flagRequeueJitterPercentDefault, config.RequeueJitterPercent)
+				assert.Equal(t, flagRequeueQPSDefault, config.RequeueQPS)
+				assert.Equal(t, flagRequeueBurstDefault, config.RequeueBurst)
 				assert.Equal(t, flagMaxConcurrentReconcilesDefault, config.MaxConcurrentReconciles)
+				assert.Equal(t, flagPriorityQueueEnabledDefault, config.PriorityQueueEnabled)
+				assert.Equal(t, flagKubeApiQPSDefault, config.KubeApiQPS)
+				assert.Equal(t, flagKubeApiBurstDefault, config.KubeApiBurst)
+				assert.Equal(t, flagKubeApiResizeQPSDefault, config.KubeApiResizeQPS)
+				assert.Equal(t, flagKubeApiResizeBurstDefault, config.KubeApiResizeBurst)
 				assert.Equal(t, flagStandardRetryAttemptsDefault, config.StandardRetryAttempts)
 				assert.Equal(t, flagStandardRetryDelaySecsDefault, config.StandardRetryDelaySecs)
+				assert.Equal(t, flagStandardRetryStrategyDefault, config.StandardRetryStrategy)
+				assert.Equal(t, flagStandardRetryMaxDelaySecsDefault, config.StandardRetryMaxDelaySecs)
+				assert.Equal(t, flagCircuitBreakerEnabledDefault, config.CircuitBreakerEnabled)
+				assert.Equal(t, flagCircuitBreakerFailureRatePercentDefault, config.CircuitBreakerFailureRatePercent)
+				assert.Equal(t, flagCircuitBreakerMinRequestsDefault, config.CircuitBreakerMinRequests)
+				assert.Equal(t, flagCircuitBreakerWindowSecsDefault, config.CircuitBreakerWindowSecs)
+				assert.Equal(t, flagCircuitBreakerOpenSecsDefault, config.CircuitBreakerOpenSecs)
+				assert.Equal(t, flagNodeCapacityStrategyDefault, config.NodeCapacityStrategy)
+				assert.Equal(t, flagNodeStartupSurplusBudgetCpuDefault, config.NodeStartupSurplusBudgetCpu)
+				assert.Equal(t, flagNodeStartupSurplusBudgetMemoryDefault, config.NodeStartupSurplusBudgetMemory)
+				assert.Equal(t, flagNodeUpscalePriorityOrderingDefault, config.NodeUpscalePriorityOrdering)
+				assert.Equal(t, flagNodeUpscaleTieBreakDefault, config.NodeUpscaleTieBreak)
+				assert.Equal(t, flagEvictionFallbackDeferredTimeoutSecsDefault, config.EvictionFallbackDeferredTimeoutSecs)
+				assert.Equal(t, flagEvictionFallbackCooldownSecsDefault, config.EvictionFallbackCooldownSecs)
+				assert.Equal(t, flagStartupEvictionProtectionDefault, config.StartupEvictionProtection)
+				assert.Equal(
+					t,
+					[]string{flagStartupEvictionProtectionAnnotationsDefault},
+					config.StartupEvictionProtectionAnnotations,
+				)
+				assert.Equal(t, flagDisabledFinalResourcesDefault, config.DisabledFinalResources)
+				assert.Equal(t, flagDryRunDefault, config.DryRun)
+				assert.Equal(t, flagControlConfigMapNamespaceDefault, config.ControlConfigMapNamespace)
+				assert.Equal(t, flagControlConfigMapNameDefault, config.ControlConfigMapName)
 				assert.Equal(t, flagLogVDefault, config.LogV)
 				assert.Equal(t, flagLogAddCallerDefault, config.LogAddCaller)
 			},
@@ -78,11 +124,631 @@ func TestControllerConfigLog(t *testing.T) {
 	config := ControllerConfig{}
 	cmd := &cobra.Command{
 		Run: func(_ *cobra.Command, _ []string) {
-			assert.Equal(t, 12, strings.Count(buffer.String(), "\n"))
+			assert.Equal(t, 51, strings.Count(buffer.String(), "\n"))
 		},
 	}
 	config.Log()
 	_ = cmd.Execute()
+	assert.Contains(t, buffer.String(), "(config) kubeconfig:  (source: default)")
+}
+
+func TestControllerConfigValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     ControllerConfig
+		wantErrMsg string
+	}{
+		{
+			"DisabledFinalResourcesInvalid",
+			ControllerConfig{DisabledFinalResources: "test"},
+			"disabled-final-resources must be 'post-startup' or 'admitted' ('test')",
+		},
+		{
+			"DisabledFinalResourcesPostStartup",
+			ControllerConfig{DisabledFinalResources: DisabledFinalResourcesPostStartup},
+			"",
+		},
+		{
+			"DisabledFinalResourcesAdmitted",
+			ControllerConfig{DisabledFinalResources: DisabledFinalResourcesAdmitted},
+			"",
+		},
+		{
+			"ShardingAndLeaderElectionEnabled",
+			ControllerConfig{
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				LeaderElectionEnabled:  true,
+				ShardingEnabled:        true,
+			},
+			"sharding-enabled and leader-election-enabled cannot both be enabled",
+		},
+		{
+			"ShardingLeaseNamespaceMissing",
+			ControllerConfig{
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				ShardingEnabled:        true,
+			},
+			"sharding-lease-namespace must be supplied if sharding-enabled is enabled",
+		},
+		{
+			"ShardingOk",
+			ControllerConfig{
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				ShardingEnabled:        true,
+				ShardingLeaseNamespace: "namespace",
+			},
+			"",
+		},
+		{
+			"NodeNameAndLeaderElectionEnabled",
+			ControllerConfig{
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				LeaderElectionEnabled:  true,
+				NodeName:               "node",
+			},
+			"node-name cannot be supplied if leader-election-enabled is enabled",
+		},
+		{
+			"NodeNameAndShardingEnabled",
+			ControllerConfig{
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				ShardingEnabled:        true,
+				ShardingLeaseNamespace: "namespace",
+				NodeName:               "node",
+			},
+			"node-name cannot be supplied if sharding-enabled is enabled",
+		},
+		{
+			"NodeNameOk",
+			ControllerConfig{
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				NodeName:               "node",
+			},
+			"",
+		},
+		{
+			"ClassInvalid",
+			ControllerConfig{
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				Class:                  "Canary_1",
+			},
+			"class is invalid ('Canary_1')",
+		},
+		{
+			"ClassOk",
+			ControllerConfig{
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				Class:                  "canary",
+			},
+			"",
+		},
+		{
+			"WatchAndExcludeNamespaces",
+			ControllerConfig{
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				WatchNamespaces:        []string{"ns1"},
+				ExcludeNamespaces:      []string{"ns2"},
+			},
+			"watch-namespaces and exclude-namespaces cannot both be supplied",
+		},
+		{
+			"NamespaceLabelSelectorInvalid",
+			ControllerConfig{
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				NamespaceLabelSelector: "!!",
+			},
+			"namespace-label-selector is invalid ('!!')",
+		},
+		{
+			"NamespaceFilteringOk",
+			ControllerConfig{
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				ExcludeNamespaces:      []string{"ns1"},
+				NamespaceLabelSelector: "csa=enabled",
+			},
+			"",
+		},
+		{
+			"ControlConfigMapNamespaceMissing",
+			ControllerConfig{
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				ControlConfigMapName:   "name",
+			},
+			"control-config-map-namespace must be supplied if control-config-map-name is supplied",
+		},
+		{
+			"ControlConfigMapOk",
+			ControllerConfig{
+				DisabledFinalResources:    DisabledFinalResourcesPostStartup,
+				ControlConfigMapNamespace: "namespace",
+				ControlConfigMapName:      "name",
+			},
+			"",
+		},
+		{
+			"KubeApiQPSNegative",
+			ControllerConfig{DisabledFinalResources: DisabledFinalResourcesPostStartup, KubeApiQPS: -1},
+			"kube-api-qps must not be negative (-1)",
+		},
+		{
+			"KubeApiBurstInvalid",
+			ControllerConfig{DisabledFinalResources: DisabledFinalResourcesPostStartup, KubeApiQPS: 1},
+			"kube-api-burst must be greater than 0 (0)",
+		},
+		{
+			"KubeApiBurstIgnoredIfQPSNotSupplied",
+			ControllerConfig{DisabledFinalResources: DisabledFinalResourcesPostStartup},
+			"",
+		},
+		{
+			"KubeApiQPSOk",
+			ControllerConfig{DisabledFinalResources: DisabledFinalResourcesPostStartup, KubeApiQPS: 1, KubeApiBurst: 1},
+			"",
+		},
+		{
+			"KubeApiResizeQPSNegative",
+			ControllerConfig{DisabledFinalResources: DisabledFinalResourcesPostStartup, KubeApiResizeQPS: -1},
+			"kube-api-resize-qps must not be negative (-1)",
+		},
+		{
+			"KubeApiResizeBurstInvalid",
+			ControllerConfig{DisabledFinalResources: DisabledFinalResourcesPostStartup, KubeApiResizeQPS: 1},
+			"kube-api-resize-burst must be greater than 0 (0)",
+		},
+		{
+			"KubeApiResizeQPSOk",
+			ControllerConfig{
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				KubeApiResizeQPS:       1,
+				KubeApiResizeBurst:     1,
+			},
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.config.RequeueRateLimiter == "" {
+				tt.config.RequeueRateLimiter = RequeueRateLimiterFixed
+			}
+			if tt.config.StandardRetryStrategy == "" {
+				tt.config.StandardRetryStrategy = retry.StrategyFixed
+			}
+			if tt.config.NodeCapacityStrategy == "" {
+				tt.config.NodeCapacityStrategy = NodeCapacityStrategyDisabled
+			}
+			if tt.config.NodeUpscaleTieBreak == "" {
+				tt.config.NodeUpscaleTieBreak = NodeUpscaleTieBreakLongestWaiting
+			}
+			err := tt.config.Validate()
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfigValidateRequeueRateLimiter(t *testing.T) {
+	exponential := func(mutateFunc func(*ControllerConfig)) ControllerConfig {
+		config := ControllerConfig{
+			DisabledFinalResources: DisabledFinalResourcesPostStartup,
+			RequeueRateLimiter:     RequeueRateLimiterExponential,
+			RequeueBaseDelayMillis: 500,
+			RequeueMaxDelaySecs:    300,
+			RequeueJitterPercent:   10,
+			RequeueQPS:             10,
+			RequeueBurst:           100,
+		}
+		mutateFunc(&config)
+		return config
+	}
+
+	tests := []struct {
+		name       string
+		config     ControllerConfig
+		wantErrMsg string
+	}{
+		{
+			"Invalid",
+			ControllerConfig{DisabledFinalResources: DisabledFinalResourcesPostStartup, RequeueRateLimiter: "test"},
+			"requeue-rate-limiter must be 'fixed' or 'exponential' ('test')",
+		},
+		{
+			"FixedIgnoresExponentialSettings",
+			ControllerConfig{
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				RequeueRateLimiter:     RequeueRateLimiterFixed,
+			},
+			"",
+		},
+		{
+			"ExponentialBaseDelayInvalid",
+			exponential(func(c *ControllerConfig) { c.RequeueBaseDelayMillis = 0 }),
+			"requeue-base-delay-millis must be greater than 0 (0)",
+		},
+		{
+			"ExponentialMaxDelayLessThanBaseDelay",
+			exponential(func(c *ControllerConfig) { c.RequeueBaseDelayMillis = 2000; c.RequeueMaxDelaySecs = 1 }),
+			"requeue-max-delay-secs must not be less than requeue-base-delay-millis",
+		},
+		{
+			"ExponentialJitterPercentInvalid",
+			exponential(func(c *ControllerConfig) { c.RequeueJitterPercent = 101 }),
+			"requeue-jitter-percent must be between 0 and 100 (101)",
+		},
+		{
+			"ExponentialQPSInvalid",
+			exponential(func(c *ControllerConfig) { c.RequeueQPS = 0 }),
+			"requeue-qps must be greater than 0 (0)",
+		},
+		{
+			"ExponentialBurstInvalid",
+			exponential(func(c *ControllerConfig) { c.RequeueBurst = 0 }),
+			"requeue-burst must be greater than 0 (0)",
+		},
+		{
+			"ExponentialOk",
+			exponential(func(*ControllerConfig) {}),
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.StandardRetryStrategy = retry.StrategyFixed
+			tt.config.NodeCapacityStrategy = NodeCapacityStrategyDisabled
+			tt.config.NodeUpscaleTieBreak = NodeUpscaleTieBreakLongestWaiting
+			err := tt.config.Validate()
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfigValidateStandardRetryStrategy(t *testing.T) {
+	tests := []struct {
+		name       string
+		strategy   string
+		maxDelay   int
+		wantErrMsg string
+	}{
+		{
+			"Invalid",
+			"test",
+			0,
+			"standard-retry-strategy must be 'fixed', 'exponential' or 'exponential-jitter' ('test')",
+		},
+		{"FixedIgnoresMaxDelay", retry.StrategyFixed, 0, ""},
+		{
+			"ExponentialMaxDelayLessThanDelay",
+			retry.StrategyExponential,
+			1,
+			"standard-retry-max-delay-secs must not be less than standard-retry-delay-secs",
+		},
+		{"ExponentialOk", retry.StrategyExponential, 30, ""},
+		{
+			"ExponentialJitterMaxDelayLessThanDelay",
+			retry.StrategyExponentialJitter,
+			1,
+			"standard-retry-max-delay-secs must not be less than standard-retry-delay-secs",
+		},
+		{"ExponentialJitterOk", retry.StrategyExponentialJitter, 30, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ControllerConfig{
+				RequeueRateLimiter:        RequeueRateLimiterFixed,
+				DisabledFinalResources:    DisabledFinalResourcesPostStartup,
+				StandardRetryDelaySecs:    2,
+				StandardRetryStrategy:     tt.strategy,
+				StandardRetryMaxDelaySecs: tt.maxDelay,
+				NodeCapacityStrategy:      NodeCapacityStrategyDisabled,
+				NodeUpscaleTieBreak:       NodeUpscaleTieBreakLongestWaiting,
+			}
+			err := config.Validate()
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfigValidateCircuitBreaker(t *testing.T) {
+	enabled := func(mutateFunc func(*ControllerConfig)) ControllerConfig {
+		config := ControllerConfig{
+			RequeueRateLimiter:               RequeueRateLimiterFixed,
+			StandardRetryStrategy:            retry.StrategyFixed,
+			DisabledFinalResources:           DisabledFinalResourcesPostStartup,
+			CircuitBreakerEnabled:            true,
+			CircuitBreakerFailureRatePercent: 50,
+			CircuitBreakerMinRequests:        10,
+			CircuitBreakerWindowSecs:         30,
+			CircuitBreakerOpenSecs:           30,
+			NodeCapacityStrategy:             NodeCapacityStrategyDisabled,
+			NodeUpscaleTieBreak:              NodeUpscaleTieBreakLongestWaiting,
+		}
+		mutateFunc(&config)
+		return config
+	}
+
+	tests := []struct {
+		name       string
+		config     ControllerConfig
+		wantErrMsg string
+	}{
+		{
+			"DisabledIgnoresSettings",
+			ControllerConfig{
+				RequeueRateLimiter:     RequeueRateLimiterFixed,
+				StandardRetryStrategy:  retry.StrategyFixed,
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				NodeCapacityStrategy:   NodeCapacityStrategyDisabled,
+				NodeUpscaleTieBreak:    NodeUpscaleTieBreakLongestWaiting,
+			},
+			"",
+		},
+		{
+			"FailureRatePercentTooLow",
+			enabled(func(c *ControllerConfig) { c.CircuitBreakerFailureRatePercent = 0 }),
+			"circuit-breaker-failure-rate-percent must be between 1 and 100 (0)",
+		},
+		{
+			"FailureRatePercentTooHigh",
+			enabled(func(c *ControllerConfig) { c.CircuitBreakerFailureRatePercent = 101 }),
+			"circuit-breaker-failure-rate-percent must be between 1 and 100 (101)",
+		},
+		{
+			"MinRequestsInvalid",
+			enabled(func(c *ControllerConfig) { c.CircuitBreakerMinRequests = 0 }),
+			"circuit-breaker-min-requests must be greater than 0 (0)",
+		},
+		{
+			"WindowSecsInvalid",
+			enabled(func(c *ControllerConfig) { c.CircuitBreakerWindowSecs = 0 }),
+			"circuit-breaker-window-secs must be greater than 0 (0)",
+		},
+		{
+			"OpenSecsInvalid",
+			enabled(func(c *ControllerConfig) { c.CircuitBreakerOpenSecs = 0 }),
+			"circuit-breaker-open-secs must be greater than 0 (0)",
+		},
+		{
+			"Ok",
+			enabled(func(*ControllerConfig) {}),
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfigValidateNodeCapacityStrategy(t *testing.T) {
+	tests := []struct {
+		name       string
+		strategy   string
+		wantErrMsg string
+	}{
+		{"Invalid", "test", "node-capacity-strategy must be 'disabled', 'skip' or 'requeue' ('test')"},
+		{"DisabledOk", NodeCapacityStrategyDisabled, ""},
+		{"SkipOk", NodeCapacityStrategySkip, ""},
+		{"RequeueOk", NodeCapacityStrategyRequeue, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ControllerConfig{
+				RequeueRateLimiter:     RequeueRateLimiterFixed,
+				DisabledFinalResources: DisabledFinalResourcesPostStartup,
+				StandardRetryStrategy:  retry.StrategyFixed,
+				NodeCapacityStrategy:   tt.strategy,
+				NodeUpscaleTieBreak:    NodeUpscaleTieBreakLongestWaiting,
+			}
+			err := config.Validate()
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfigValidateNodeStartupSurplusBudget(t *testing.T) {
+	tests := []struct {
+		name       string
+		cpu        string
+		memory     string
+		sharding   bool
+		wantErrMsg string
+	}{
+		{"CpuInvalid", "test", "", false, "node-startup-surplus-budget-cpu must be a valid resource quantity ('test')"},
+		{"CpuNotPositive", "0", "", false, "node-startup-surplus-budget-cpu must be greater than 0 ('0')"},
+		{"MemoryInvalid", "", "test", false, "node-startup-surplus-budget-memory must be a valid resource quantity ('test')"},
+		{"MemoryNotPositive", "", "-1Gi", false, "node-startup-surplus-budget-memory must be greater than 0 ('-1Gi')"},
+		{"NoneOk", "", "", false, ""},
+		{"BothOk", "2", "4Gi", false, ""},
+		{"CpuShardingEnabled", "2", "", true, "node-startup-surplus-budget-cpu cannot be supplied if sharding-enabled is enabled"},
+		{"MemoryShardingEnabled", "", "4Gi", true, "node-startup-surplus-budget-memory cannot be supplied if sharding-enabled is enabled"},
+		{"NoneShardingEnabledOk", "", "", true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ControllerConfig{
+				RequeueRateLimiter:             RequeueRateLimiterFixed,
+				DisabledFinalResources:         DisabledFinalResourcesPostStartup,
+				StandardRetryStrategy:          retry.StrategyFixed,
+				NodeCapacityStrategy:           NodeCapacityStrategyDisabled,
+				NodeUpscaleTieBreak:            NodeUpscaleTieBreakLongestWaiting,
+				NodeStartupSurplusBudgetCpu:    tt.cpu,
+				NodeStartupSurplusBudgetMemory: tt.memory,
+				ShardingEnabled:                tt.sharding,
+			}
+			if tt.sharding {
+				config.ShardingLeaseNamespace = "namespace"
+			}
+			err := config.Validate()
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfigValidateNodeUpscaleOrdering(t *testing.T) {
+	tests := []struct {
+		name             string
+		priorityOrdering bool
+		strategy         string
+		budgetCpu        string
+		tieBreak         string
+		wantErrMsg       string
+	}{
+		{
+			"TieBreakInvalid",
+			false,
+			NodeCapacityStrategyDisabled,
+			"",
+			"test",
+			"node-upscale-tie-break must be 'longest-waiting' or 'oldest-pod' ('test')",
+		},
+		{
+			"PriorityOrderingWithoutConstraint",
+			true,
+			NodeCapacityStrategySkip,
+			"",
+			NodeUpscaleTieBreakLongestWaiting,
+			"node-upscale-priority-ordering requires node-capacity-strategy to be 'requeue' or a node startup surplus budget to be supplied",
+		},
+		{"PriorityOrderingWithRequeueOk", true, NodeCapacityStrategyRequeue, "", NodeUpscaleTieBreakOldestPod, ""},
+		{"PriorityOrderingWithBudgetOk", true, NodeCapacityStrategyDisabled, "2", NodeUpscaleTieBreakLongestWaiting, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ControllerConfig{
+				RequeueRateLimiter:          RequeueRateLimiterFixed,
+				DisabledFinalResources:      DisabledFinalResourcesPostStartup,
+				StandardRetryStrategy:       retry.StrategyFixed,
+				NodeCapacityStrategy:        tt.strategy,
+				NodeStartupSurplusBudgetCpu: tt.budgetCpu,
+				NodeUpscalePriorityOrdering: tt.priorityOrdering,
+				NodeUpscaleTieBreak:         tt.tieBreak,
+			}
+			err := config.Validate()
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfigValidateEvictionFallback(t *testing.T) {
+	tests := []struct {
+		name            string
+		deferredTimeout int
+		cooldown        int
+		wantErrMsg      string
+	}{
+		{"DeferredTimeoutNegative", -1, 0, "eviction-fallback-deferred-timeout-secs must not be negative (-1)"},
+		{"CooldownNegative", 0, -1, "eviction-fallback-cooldown-secs must not be negative (-1)"},
+		{"ZeroOk", 0, 0, ""},
+		{"Ok", 300, 900, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ControllerConfig{
+				RequeueRateLimiter:                  RequeueRateLimiterFixed,
+				DisabledFinalResources:              DisabledFinalResourcesPostStartup,
+				StandardRetryStrategy:               retry.StrategyFixed,
+				NodeCapacityStrategy:                NodeCapacityStrategyDisabled,
+				NodeUpscaleTieBreak:                 NodeUpscaleTieBreakLongestWaiting,
+				EvictionFallbackDeferredTimeoutSecs: tt.deferredTimeout,
+				EvictionFallbackCooldownSecs:        tt.cooldown,
+			}
+			err := config.Validate()
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfigValidateStartupEvictionProtection(t *testing.T) {
+	tests := []struct {
+		name        string
+		enabled     bool
+		annotations []string
+		wantErrMsg  string
+	}{
+		{"NotEnabledInvalidIgnored", false, []string{"invalid"}, ""},
+		{
+			"NoAnnotations",
+			true,
+			nil,
+			"startup-eviction-protection-annotations must be supplied if startup-eviction-protection is enabled",
+		},
+		{
+			"NotKeyValue",
+			true,
+			[]string{"invalid"},
+			"startup-eviction-protection-annotations items must be in key=value form ('invalid')",
+		},
+		{
+			"KeyInvalid",
+			true,
+			[]string{"in valid=false"},
+			"startup-eviction-protection-annotations item key is invalid ('in valid')",
+		},
+		{"Ok", true, []string{"cluster-autoscaler.kubernetes.io/safe-to-evict=false", "karpenter.sh/do-not-disrupt=true"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ControllerConfig{
+				RequeueRateLimiter:                   RequeueRateLimiterFixed,
+				DisabledFinalResources:               DisabledFinalResourcesPostStartup,
+				StandardRetryStrategy:                retry.StrategyFixed,
+				NodeCapacityStrategy:                 NodeCapacityStrategyDisabled,
+				NodeUpscaleTieBreak:                  NodeUpscaleTieBreakLongestWaiting,
+				StartupEvictionProtection:            tt.enabled,
+				StartupEvictionProtectionAnnotations: tt.annotations,
+			}
+			err := config.Validate()
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfigLeaderElectionID(t *testing.T) {
+	t.Run("NoClass", func(t *testing.T) {
+		config := ControllerConfig{}
+		assert.Equal(t, "csa-expediagroup-com", config.LeaderElectionID())
+	})
+
+	t.Run("Class", func(t *testing.T) {
+		config := ControllerConfig{Class: "canary"}
+		assert.Equal(t, "csa-expediagroup-com-canary", config.LeaderElectionID())
+	})
 }
 
 func TestControllerConfigCacheSyncPeriodMinsDuration(t *testing.T) {
@@ -99,3 +765,69 @@ func TestControllerConfigRequeueDurationSecsDuration(t *testing.T) {
 	config := ControllerConfig{RequeueDurationSecs: 1}
 	assert.Equal(t, 1*time.Second, config.RequeueDurationSecsDuration())
 }
+
+func TestControllerConfigRequeueBaseDelayDuration(t *testing.T) {
+	config := ControllerConfig{RequeueBaseDelayMillis: 1}
+	assert.Equal(t, 1*time.Millisecond, config.RequeueBaseDelayDuration())
+}
+
+func TestControllerConfigRequeueMaxDelayDuration(t *testing.T) {
+	config := ControllerConfig{RequeueMaxDelaySecs: 1}
+	assert.Equal(t, 1*time.Second, config.RequeueMaxDelayDuration())
+}
+
+func TestControllerConfigCircuitBreakerWindowDuration(t *testing.T) {
+	config := ControllerConfig{CircuitBreakerWindowSecs: 1}
+	assert.Equal(t, 1*time.Second, config.CircuitBreakerWindowDuration())
+}
+
+func TestControllerConfigCircuitBreakerOpenDuration(t *testing.T) {
+	config := ControllerConfig{CircuitBreakerOpenSecs: 1}
+	assert.Equal(t, 1*time.Second, config.CircuitBreakerOpenDuration())
+}
+
+func TestControllerConfigEvictionFallbackDeferredTimeoutDuration(t *testing.T) {
+	config := ControllerConfig{EvictionFallbackDeferredTimeoutSecs: 1}
+	assert.Equal(t, 1*time.Second, config.EvictionFallbackDeferredTimeoutDuration())
+}
+
+func TestControllerConfigEvictionFallbackCooldownDuration(t *testing.T) {
+	config := ControllerConfig{EvictionFallbackCooldownSecs: 1}
+	assert.Equal(t, 1*time.Second, config.EvictionFallbackCooldownDuration())
+}
+
+func TestControllerConfigNodeStartupSurplusBudget(t *testing.T) {
+	t.Run("None", func(t *testing.T) {
+		config := ControllerConfig{}
+		assert.Empty(t, config.NodeStartupSurplusBudget())
+	})
+
+	t.Run("Cpu", func(t *testing.T) {
+		config := ControllerConfig{NodeStartupSurplusBudgetCpu: "2"}
+		assert.Equal(t, v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")}, config.NodeStartupSurplusBudget())
+	})
+
+	t.Run("Both", func(t *testing.T) {
+		config := ControllerConfig{NodeStartupSurplusBudgetCpu: "2", NodeStartupSurplusBudgetMemory: "4Gi"}
+		assert.Equal(
+			t,
+			v1.ResourceList{v1.ResourceCPU: resource.MustParse("2"), v1.ResourceMemory: resource.MustParse("4Gi")},
+			config.NodeStartupSurplusBudget(),
+		)
+	})
+}
+
+func TestControllerConfigStartupEvictionProtectionAnnotationsMap(t *testing.T) {
+	t.Run("NotEnabled", func(t *testing.T) {
+		config := ControllerConfig{StartupEvictionProtectionAnnotations: []string{"key=value"}}
+		assert.Nil(t, config.StartupEvictionProtectionAnnotationsMap())
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		config := ControllerConfig{
+			StartupEvictionProtection:            true,
+			StartupEvictionProtectionAnnotations: []string{"key1=value1", "key2="},
+		}
+		assert.Equal(t, map[string]string{"key1": "value1", "key2": ""}, config.StartupEvictionProtectionAnnotationsMap())
+	})
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllercommon
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+)
+
+// envVarPrefix is the prefix of environment variables that bind to flags.
+const envVarPrefix = "CSA_"
+
+// configSource indicates where a configuration value was obtained from.
+type configSource string
+
+const (
+	configSourceDefault configSource = "default"
+	configSourceFile    configSource = "file"
+	configSourceEnv     configSource = "env"
+	configSourceFlag    configSource = "flag"
+)
+
+// Load populates any configuration values that weren't explicitly supplied as flags from environment variables, then
+// from any configuration file, recording the source of each value. Precedence is therefore flags, then environment
+// variables, then configuration file, then flag defaults. Must be invoked after flags have been parsed.
+func (c *ControllerConfig) Load(command *cobra.Command) error {
+	flags := command.Flags()
+	c.sources = map[string]configSource{}
+
+	// The configuration file path itself may only be supplied as a flag or environment variable.
+	if err := c.loadFlag(flags.Lookup(flagConfigName), nil); err != nil {
+		return err
+	}
+
+	fileValues := map[string]any{}
+	if c.ConfigFile != "" {
+		contents, err := os.ReadFile(c.ConfigFile)
+		if err != nil {
+			return common.WrapErrorf(err, "unable to read %s file '%s'", flagConfigName, c.ConfigFile)
+		}
+
+		if fileValues, err = parseConfigFile(contents); err != nil {
+			return common.WrapErrorf(err, "unable to parse %s file '%s'", flagConfigName, c.ConfigFile)
+		}
+
+		for name := range fileValues {
+			if name == flagConfigName || flags.Lookup(name) == nil {
+				return fmt.Errorf("%s file '%s' contains unsupported key '%s'", flagConfigName, c.ConfigFile, name)
+			}
+		}
+	}
+
+	var err error
+	flags.VisitAll(func(flag *pflag.Flag) {
+		if err != nil || flag.Name == flagConfigName {
+			return
+		}
+		err = c.loadFlag(flag, fileValues)
+	})
+	if err != nil {
+		return err
+	}
+
+	c.reloadable = newReloadable(c)
+	return nil
+}
+
+// loadFlag populates the value of flag from its environment variable or fileValues if not explicitly supplied, and
+// records its source.
+func (c *ControllerConfig) loadFlag(flag *pflag.Flag, fileValues map[string]any) error {
+	if flag.Changed {
+		c.sources[flag.Name] = configSourceFlag
+		return nil
+	}
+
+	if value, present := os.LookupEnv(envVarName(flag.Name)); present {
+		if err := flag.Value.Set(value); err != nil {
+			return common.WrapErrorf(err, "unable to set %s from environment variable %s", flag.Name, envVarName(flag.Name))
+		}
+		c.sources[flag.Name] = configSourceEnv
+		return nil
+	}
+
+	if fileValue, present := fileValues[flag.Name]; present {
+		value, err := fileValueString(flag.Name, fileValue)
+		if err != nil {
+			return err
+		}
+
+		if err = flag.Value.Set(value); err != nil {
+			return common.WrapErrorf(err, "unable to set %s from %s file", flag.Name, flagConfigName)
+		}
+		c.sources[flag.Name] = configSourceFile
+		return nil
+	}
+
+	c.sources[flag.Name] = configSourceDefault
+	return nil
+}
+
+// source returns the source of the value for the flag with the supplied name.
+func (c *ControllerConfig) source(name string) configSource {
+	if source, found := c.sources[name]; found {
+		return source
+	}
+
+	return configSourceDefault
+}
+
+// parseConfigFile parses the supplied configuration file contents. YAML is a superset of JSON so both are supported.
+func parseConfigFile(contents []byte) (map[string]any, error) {
+	fileValues := map[string]any{}
+	if err := yaml.Unmarshal(contents, &fileValues); err != nil {
+		return nil, err
+	}
+
+	return fileValues, nil
+}
+
+// fileValueString returns the supplied configuration file value for the flag with the supplied name as a string.
+func fileValueString(name string, fileValue any) (string, error) {
+	switch v := fileValue.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case []any:
+		// Lists are supplied to flags in comma-separated form.
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			itemString, err := fileValueString(name, item)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, itemString)
+		}
+		return strings.Join(items, ","), nil
+	default:
+		return "", fmt.Errorf("%s value in %s file must be a string, boolean, number or list of these", name, flagConfigName)
+	}
+}
+
+// envVarName returns the name of the environment variable that binds to the flag with the supplied name.
+func envVarName(flagName string) string {
+	return envVarPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
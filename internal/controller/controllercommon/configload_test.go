@@ -0,0 +1,193 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllercommon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestControllerConfigLoad(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		env          map[string]string
+		fileName     string
+		fileContents string
+		wantErrMsg   string
+		assertFunc   func(*testing.T, ControllerConfig)
+	}{
+		{
+			name: "AllDefaults",
+			assertFunc: func(t *testing.T, config ControllerConfig) {
+				assert.Equal(t, flagMaxConcurrentReconcilesDefault, config.MaxConcurrentReconciles)
+				assert.Equal(t, configSourceDefault, config.source(flagMaxConcurrentReconcilesName))
+				assert.Equal(t, configSourceDefault, config.source(flagConfigName))
+			},
+		},
+		{
+			name:         "FileYaml",
+			fileName:     "config.yaml",
+			fileContents: "max-concurrent-reconciles: 20\nscale-when-unknown-resources: true\ndisabled-final-resources: admitted\n",
+			assertFunc: func(t *testing.T, config ControllerConfig) {
+				assert.Equal(t, 20, config.MaxConcurrentReconciles)
+				assert.Equal(t, true, config.ScaleWhenUnknownResources)
+				assert.Equal(t, DisabledFinalResourcesAdmitted, config.DisabledFinalResources)
+				assert.Equal(t, configSourceFile, config.source(flagMaxConcurrentReconcilesName))
+				assert.Equal(t, configSourceFile, config.source(flagScaleWhenUnknownResourcesName))
+				assert.Equal(t, configSourceFile, config.source(flagDisabledFinalResourcesName))
+				assert.Equal(t, configSourceDefault, config.source(flagLogVName))
+			},
+		},
+		{
+			name:         "FileList",
+			fileName:     "config.yaml",
+			fileContents: "watch-namespaces:\n  - ns1\n  - ns2\n",
+			assertFunc: func(t *testing.T, config ControllerConfig) {
+				assert.Equal(t, []string{"ns1", "ns2"}, config.WatchNamespaces)
+				assert.Equal(t, configSourceFile, config.source(flagWatchNamespacesName))
+			},
+		},
+		{
+			name:         "ConfigFileUnsupportedListItemType",
+			fileName:     "config.yaml",
+			fileContents: "watch-namespaces:\n  - key: ns1\n",
+			wantErrMsg:   "watch-namespaces value in config file must be a string, boolean, number or list of these",
+		},
+		{
+			name:         "FileJson",
+			fileName:     "config.json",
+			fileContents: `{"max-concurrent-reconciles": 20}`,
+			assertFunc: func(t *testing.T, config ControllerConfig) {
+				assert.Equal(t, 20, config.MaxConcurrentReconciles)
+				assert.Equal(t, configSourceFile, config.source(flagMaxConcurrentReconcilesName))
+			},
+		},
+		{
+			name:     "Env",
+			fileName: "config.yaml",
+			env: map[string]string{
+				"CSA_MAX_CONCURRENT_RECONCILES": "30",
+				"CSA_LOG_V":                     "1",
+			},
+			fileContents: "max-concurrent-reconciles: 20\n",
+			assertFunc: func(t *testing.T, config ControllerConfig) {
+				assert.Equal(t, 30, config.MaxConcurrentReconciles)
+				assert.Equal(t, 1, config.LogV)
+				assert.Equal(t, configSourceEnv, config.source(flagMaxConcurrentReconcilesName))
+				assert.Equal(t, configSourceEnv, config.source(flagLogVName))
+			},
+		},
+		{
+			name:     "Flag",
+			args:     []string{fmt.Sprintf("--%s=40", flagMaxConcurrentReconcilesName)},
+			fileName: "config.yaml",
+			env: map[string]string{
+				"CSA_MAX_CONCURRENT_RECONCILES": "30",
+			},
+			fileContents: "max-concurrent-reconciles: 20\n",
+			assertFunc: func(t *testing.T, config ControllerConfig) {
+				assert.Equal(t, 40, config.MaxConcurrentReconciles)
+				assert.Equal(t, configSourceFlag, config.source(flagMaxConcurrentReconcilesName))
+			},
+		},
+		{
+			name:       "ConfigFileNotFound",
+			args:       []string{fmt.Sprintf("--%s=/notfound.yaml", flagConfigName)},
+			wantErrMsg: "unable to read config file '/notfound.yaml'",
+		},
+		{
+			name:         "ConfigFileUnparseable",
+			fileName:     "config.yaml",
+			fileContents: "test",
+			wantErrMsg:   "unable to parse config file",
+		},
+		{
+			name:         "ConfigFileUnsupportedKey",
+			fileName:     "config.yaml",
+			fileContents: "test: test\n",
+			wantErrMsg:   "contains unsupported key 'test'",
+		},
+		{
+			name:         "ConfigFileConfigKey",
+			fileName:     "config.yaml",
+			fileContents: "config: test\n",
+			wantErrMsg:   "contains unsupported key 'config'",
+		},
+		{
+			name:         "ConfigFileUnsupportedValueType",
+			fileName:     "config.yaml",
+			fileContents: "kubeconfig:\n  key: test\n",
+			wantErrMsg:   "kubeconfig value in config file must be a string, boolean, number or list of these",
+		},
+		{
+			name:         "ConfigFileInvalidValue",
+			fileName:     "config.yaml",
+			fileContents: "max-concurrent-reconciles: test\n",
+			wantErrMsg:   "unable to set max-concurrent-reconciles from config file",
+		},
+		{
+			name:       "EnvInvalidValue",
+			env:        map[string]string{"CSA_MAX_CONCURRENT_RECONCILES": "test"},
+			wantErrMsg: "unable to set max-concurrent-reconciles from environment variable CSA_MAX_CONCURRENT_RECONCILES",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			args := tt.args
+			if tt.fileName != "" {
+				path := filepath.Join(t.TempDir(), tt.fileName)
+				assert.NoError(t, os.WriteFile(path, []byte(tt.fileContents), 0600))
+				args = append(args, fmt.Sprintf("--%s=%s", flagConfigName, path))
+			}
+
+			config := ControllerConfig{}
+			var err error
+			cmd := &cobra.Command{
+				Run: func(cmd *cobra.Command, _ []string) {
+					err = config.Load(cmd)
+				},
+			}
+			config.InitFlags(cmd)
+			cmd.SetArgs(args)
+			assert.NoError(t, cmd.Execute())
+
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if tt.assertFunc != nil {
+				tt.assertFunc(t, config)
+			}
+		})
+	}
+}
+
+func TestEnvVarName(t *testing.T) {
+	assert.Equal(t, "CSA_MAX_CONCURRENT_RECONCILES", envVarName(flagMaxConcurrentReconcilesName))
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllercommon
+
+import (
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+)
+
+// MaxConcurrentReconcilesReloadCeiling is the maximum value max-concurrent-reconciles may be reloaded to, if greater
+// than the value loaded upon start.
+const MaxConcurrentReconcilesReloadCeiling = 100
+
+// reloadable holds the current values of configuration items that may be reloaded from the configuration file without
+// a restart. It's referenced (rather than copied) by copies of ControllerConfig so that reloaded values are visible to
+// all holders.
+type reloadable struct {
+	maxConcurrentReconciles   atomic.Int64
+	scaleWhenUnknownResources atomic.Bool
+	logV                      atomic.Int64
+}
+
+func newReloadable(c *ControllerConfig) *reloadable {
+	r := &reloadable{}
+	r.maxConcurrentReconciles.Store(int64(c.MaxConcurrentReconciles))
+	r.scaleWhenUnknownResources.Store(c.ScaleWhenUnknownResources)
+	r.logV.Store(int64(c.LogV))
+	return r
+}
+
+// IsReloadable returns whether configuration may be reloaded, which requires a configuration file.
+func (c *ControllerConfig) IsReloadable() bool {
+	return c.ConfigFile != "" && c.reloadable != nil
+}
+
+// MaxConcurrentReconcilesWorkers returns the number of controller workers to create. If configuration is reloadable,
+// this is the greater of max-concurrent-reconciles and MaxConcurrentReconcilesReloadCeiling so that
+// max-concurrent-reconciles may be reloaded to a greater value (actual concurrency must be limited separately).
+func (c *ControllerConfig) MaxConcurrentReconcilesWorkers() int {
+	if !c.IsReloadable() {
+		return c.MaxConcurrentReconciles
+	}
+
+	return max(c.MaxConcurrentReconciles, MaxConcurrentReconcilesReloadCeiling)
+}
+
+// CurrentMaxConcurrentReconciles returns the current (possibly reloaded) value of max-concurrent-reconciles.
+func (c *ControllerConfig) CurrentMaxConcurrentReconciles() int {
+	if c.reloadable == nil {
+		return c.MaxConcurrentReconciles
+	}
+
+	return int(c.reloadable.maxConcurrentReconciles.Load())
+}
+
+// CurrentScaleWhenUnknownResources returns the current (possibly reloaded) value of scale-when-unknown-resources.
+func (c *ControllerConfig) CurrentScaleWhenUnknownResources() bool {
+	if c.reloadable == nil {
+		return c.ScaleWhenUnknownResources
+	}
+
+	return c.reloadable.scaleWhenUnknownResources.Load()
+}
+
+// CurrentLogV returns the current (possibly reloaded) value of log-v.
+func (c *ControllerConfig) CurrentLogV() int {
+	if c.reloadable == nil {
+		return c.LogV
+	}
+
+	return int(c.reloadable.logV.Load())
+}
+
+// Reload applies reloadable configuration items from the supplied configuration file contents, returning a description
+// of each value changed. Items that were supplied as flags or environment variables aren't reloaded since they take
+// precedence, and items removed from the file revert to their defaults. No values are changed if any are invalid.
+func (c *ControllerConfig) Reload(contents []byte) ([]string, error) {
+	if !c.IsReloadable() {
+		return nil, fmt.Errorf("configuration is not reloadable (%s not supplied)", flagConfigName)
+	}
+
+	fileValues, err := parseConfigFile(contents)
+	if err != nil {
+		return nil, common.WrapErrorf(err, "unable to parse %s file '%s'", flagConfigName, c.ConfigFile)
+	}
+
+	maxConcurrentReconciles, err := reloadValue(
+		c, fileValues, flagMaxConcurrentReconcilesName,
+		c.CurrentMaxConcurrentReconciles(), flagMaxConcurrentReconcilesDefault, strconv.Atoi,
+	)
+	if err != nil {
+		return nil, err
+	}
+	ceiling := c.MaxConcurrentReconcilesWorkers()
+	if maxConcurrentReconciles < 1 || maxConcurrentReconciles > ceiling {
+		return nil, fmt.Errorf(
+			"%s must be between 1 and %d (%d)",
+			flagMaxConcurrentReconcilesName,
+			ceiling,
+			maxConcurrentReconciles,
+		)
+	}
+
+	scaleWhenUnknownResources, err := reloadValue(
+		c, fileValues, flagScaleWhenUnknownResourcesName,
+		c.CurrentScaleWhenUnknownResources(), flagScaleWhenUnknownResourcesDefault, strconv.ParseBool,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	logV, err := reloadValue(
+		c, fileValues, flagLogVName,
+		c.CurrentLogV(), flagLogVDefault, strconv.Atoi,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []string
+	if old := c.reloadable.maxConcurrentReconciles.Swap(int64(maxConcurrentReconciles)); old != int64(maxConcurrentReconciles) {
+		changes = append(changes, fmt.Sprintf("%s: %d -> %d", flagMaxConcurrentReconcilesName, old, maxConcurrentReconciles))
+	}
+	if old := c.reloadable.scaleWhenUnknownResources.Swap(scaleWhenUnknownResources); old != scaleWhenUnknownResources {
+		changes = append(changes, fmt.Sprintf("%s: %t -> %t", flagScaleWhenUnknownResourcesName, old, scaleWhenUnknownResources))
+	}
+	if old := c.reloadable.logV.Swap(int64(logV)); old != int64(logV) {
+		changes = append(changes, fmt.Sprintf("%s: %d -> %d", flagLogVName, old, logV))
+	}
+
+	return changes, nil
+}
+
+// reloadValue returns the value for the flag with the supplied name from fileValues, parsed using parse. current is
+// returned if the flag's value was supplied via a flag or environment variable, and defaultValue is returned if it's
+// not present within fileValues.
+func reloadValue[T any](
+	c *ControllerConfig,
+	fileValues map[string]any,
+	name string,
+	current T,
+	defaultValue T,
+	parse func(string) (T, error),
+) (T, error) {
+	if source := c.source(name); source == configSourceFlag || source == configSourceEnv {
+		return current, nil
+	}
+
+	fileValue, present := fileValues[name]
+	if !present {
+		return defaultValue, nil
+	}
+
+	value, err := fileValueString(name, fileValue)
+	if err != nil {
+		return current, err
+	}
+
+	parsed, err := parse(value)
+	if err != nil {
+		return current, common.WrapErrorf(err, "unable to parse %s from %s file", name, flagConfigName)
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllercommon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestControllerConfigIsReloadable(t *testing.T) {
+	assert.False(t, (&ControllerConfig{}).IsReloadable())
+	assert.False(t, (&ControllerConfig{ConfigFile: "test"}).IsReloadable())
+	config := loadedControllerConfig(t, nil, "")
+	assert.True(t, config.IsReloadable())
+}
+
+func TestControllerConfigMaxConcurrentReconcilesWorkers(t *testing.T) {
+	t.Run("NotReloadable", func(t *testing.T) {
+		config := ControllerConfig{MaxConcurrentReconciles: 5}
+		assert.Equal(t, 5, config.MaxConcurrentReconcilesWorkers())
+	})
+
+	t.Run("ReloadableLessThanCeiling", func(t *testing.T) {
+		config := loadedControllerConfig(t, nil, "max-concurrent-reconciles: 5\n")
+		assert.Equal(t, MaxConcurrentReconcilesReloadCeiling, config.MaxConcurrentReconcilesWorkers())
+	})
+
+	t.Run("ReloadableGreaterThanCeiling", func(t *testing.T) {
+		config := loadedControllerConfig(t, nil, "max-concurrent-reconciles: 200\n")
+		assert.Equal(t, 200, config.MaxConcurrentReconcilesWorkers())
+	})
+}
+
+func TestControllerConfigCurrentValues(t *testing.T) {
+	t.Run("NotLoaded", func(t *testing.T) {
+		config := ControllerConfig{MaxConcurrentReconciles: 5, ScaleWhenUnknownResources: true, LogV: 1}
+		assert.Equal(t, 5, config.CurrentMaxConcurrentReconciles())
+		assert.Equal(t, true, config.CurrentScaleWhenUnknownResources())
+		assert.Equal(t, 1, config.CurrentLogV())
+	})
+
+	t.Run("Loaded", func(t *testing.T) {
+		config := loadedControllerConfig(
+			t,
+			nil,
+			"max-concurrent-reconciles: 5\nscale-when-unknown-resources: true\nlog-v: 1\n",
+		)
+		assert.Equal(t, 5, config.CurrentMaxConcurrentReconciles())
+		assert.Equal(t, true, config.CurrentScaleWhenUnknownResources())
+		assert.Equal(t, 1, config.CurrentLogV())
+	})
+}
+
+func TestControllerConfigReload(t *testing.T) {
+	tests := []struct {
+		name                          string
+		args                          []string
+		initialContents               string
+		reloadContents                string
+		wantErrMsg                    string
+		wantChanges                   []string
+		wantMaxConcurrentReconciles   int
+		wantScaleWhenUnknownResources bool
+		wantLogV                      int
+	}{
+		{
+			name:                        "UnableToParse",
+			initialContents:             "max-concurrent-reconciles: 5\n",
+			reloadContents:              "test",
+			wantErrMsg:                  "unable to parse config file",
+			wantMaxConcurrentReconciles: 5,
+		},
+		{
+			name:                        "InvalidValue",
+			initialContents:             "max-concurrent-reconciles: 5\n",
+			reloadContents:              "max-concurrent-reconciles: 6\nlog-v: test\n",
+			wantErrMsg:                  "unable to parse log-v from config file",
+			wantMaxConcurrentReconciles: 5,
+		},
+		{
+			name:                        "UnsupportedValueType",
+			initialContents:             "max-concurrent-reconciles: 5\n",
+			reloadContents:              "scale-when-unknown-resources:\n  key: true\n",
+			wantErrMsg:                  "scale-when-unknown-resources value in config file must be a string, boolean, number or list of these",
+			wantMaxConcurrentReconciles: 5,
+		},
+		{
+			name:                        "MaxConcurrentReconcilesTooLow",
+			initialContents:             "max-concurrent-reconciles: 5\n",
+			reloadContents:              "max-concurrent-reconciles: 0\n",
+			wantErrMsg:                  "max-concurrent-reconciles must be between 1 and 100 (0)",
+			wantMaxConcurrentReconciles: 5,
+		},
+		{
+			name:                        "MaxConcurrentReconcilesTooHigh",
+			initialContents:             "max-concurrent-reconciles: 5\n",
+			reloadContents:              "max-concurrent-reconciles: 101\n",
+			wantErrMsg:                  "max-concurrent-reconciles must be between 1 and 100 (101)",
+			wantMaxConcurrentReconciles: 5,
+		},
+		{
+			name:                          "AllChanged",
+			initialContents:               "max-concurrent-reconciles: 5\n",
+			reloadContents:                "max-concurrent-reconciles: 20\nscale-when-unknown-resources: true\nlog-v: 2\nkubeconfig: test\n",
+			wantChanges:                   []string{"max-concurrent-reconciles: 5 -> 20", "scale-when-unknown-resources: false -> true", "log-v: 0 -> 2"},
+			wantMaxConcurrentReconciles:   20,
+			wantScaleWhenUnknownResources: true,
+			wantLogV:                      2,
+		},
+		{
+			name:                        "NoneChanged",
+			initialContents:             "max-concurrent-reconciles: 5\n",
+			reloadContents:              "max-concurrent-reconciles: 5\n",
+			wantMaxConcurrentReconciles: 5,
+		},
+		{
+			name:                        "RemovedRevertsToDefault",
+			initialContents:             "max-concurrent-reconciles: 5\n",
+			reloadContents:              "",
+			wantChanges:                 []string{"max-concurrent-reconciles: 5 -> 10"},
+			wantMaxConcurrentReconciles: flagMaxConcurrentReconcilesDefault,
+		},
+		{
+			name:                        "FlagNotReloaded",
+			args:                        []string{fmt.Sprintf("--%s=5", flagMaxConcurrentReconcilesName)},
+			reloadContents:              "max-concurrent-reconciles: 20\n",
+			wantMaxConcurrentReconciles: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := loadedControllerConfig(t, tt.args, tt.initialContents)
+			changes, err := config.Reload([]byte(tt.reloadContents))
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantChanges, changes)
+			assert.Equal(t, tt.wantMaxConcurrentReconciles, config.CurrentMaxConcurrentReconciles())
+			assert.Equal(t, tt.wantScaleWhenUnknownResources, config.CurrentScaleWhenUnknownResources())
+			assert.Equal(t, tt.wantLogV, config.CurrentLogV())
+		})
+	}
+
+	t.Run("NotReloadable", func(t *testing.T) {
+		config := ControllerConfig{}
+		_, err := config.Reload(nil)
+		assert.ErrorContains(t, err, "configuration is not reloadable (config not supplied)")
+	})
+
+	t.Run("SharedBetweenCopies", func(t *testing.T) {
+		config := loadedControllerConfig(t, nil, "")
+		configCopy := config
+		_, err := config.Reload([]byte("scale-when-unknown-resources: true\n"))
+		assert.NoError(t, err)
+		assert.True(t, configCopy.CurrentScaleWhenUnknownResources())
+	})
+}
+
+// loadedControllerConfig returns a ControllerConfig loaded with the supplied args and configuration file contents.
+func loadedControllerConfig(t *testing.T, args []string, fileContents string) ControllerConfig {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(fileContents), 0600))
+
+	config := ControllerConfig{}
+	cmd := &cobra.Command{
+		Run: func(cmd *cobra.Command, _ []string) {
+			assert.NoError(t, config.Load(cmd))
+		},
+	}
+	config.InitFlags(cmd)
+	cmd.SetArgs(append(args, fmt.Sprintf("--%s=%s", flagConfigName, path)))
+	assert.NoError(t, cmd.Execute())
+	return config
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// namespaceGetTimeout bounds how long to wait to get a namespace from the cache, for example while the namespace
+// informer is still syncing upon startup.
+const namespaceGetTimeout = 10 * time.Second
+
+// namespaceFilter determines whether pods are eligible to be reconciled according to the namespaces they reside
+// within, per configured watched namespaces, excluded namespaces and namespace label selector.
+type namespaceFilter struct {
+	watched  map[string]bool
+	excluded map[string]bool
+	selector labels.Selector
+	client   client.Reader
+}
+
+func newNamespaceFilter(
+	controllerConfig controllercommon.ControllerConfig,
+	client client.Reader,
+) (*namespaceFilter, error) {
+	f := &namespaceFilter{
+		watched:  map[string]bool{},
+		excluded: map[string]bool{},
+		client:   client,
+	}
+
+	for _, namespace := range controllerConfig.WatchNamespaces {
+		f.watched[namespace] = true
+	}
+
+	for _, namespace := range controllerConfig.ExcludeNamespaces {
+		f.excluded[namespace] = true
+	}
+
+	if controllerConfig.NamespaceLabelSelector != "" {
+		selector, err := labels.Parse(controllerConfig.NamespaceLabelSelector)
+		if err != nil {
+			return nil, common.WrapErrorf(err, "unable to parse namespace label selector")
+		}
+		f.selector = selector
+	}
+
+	return f, nil
+}
+
+// isPodAllowed returns whether pod resides within a namespace that's eligible to be reconciled. Pods are not allowed
+// if the namespace can't be retrieved to evaluate the namespace label selector.
+func (f *namespaceFilter) isPodAllowed(pod *v1.Pod) bool {
+	if len(f.watched) > 0 && !f.watched[pod.Namespace] {
+		return false
+	}
+
+	if f.excluded[pod.Namespace] {
+		return false
+	}
+
+	if f.selector == nil {
+		return true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), namespaceGetTimeout)
+	defer cancel()
+
+	namespace := &v1.Namespace{}
+	if err := f.client.Get(ctx, client.ObjectKey{Name: pod.Namespace}, namespace); err != nil {
+		logging.Errorf(
+			nil, err,
+			"unable to get namespace '%s' to evaluate namespace label selector (pod '%s' won't be reconciled)",
+			pod.Namespace, pod.Name,
+		)
+		return false
+	}
+
+	return f.selector.Matches(labels.Set(namespace.Labels))
+}
+
+// namespaceLabelsChanged is a predicate that only allows namespace updates that change labels, so that pods are
+// requeued when their namespace starts matching the namespace label selector.
+func namespaceLabelsChanged(event event.TypedUpdateEvent[*v1.Namespace]) bool {
+	return !maps.Equal(event.ObjectOld.Labels, event.ObjectNew.Labels)
+}
+
+// podRequestsForNamespace returns reconcile requests for the cached pods within the supplied namespace that are
+// allowed and owned per isOwned.
+func (f *namespaceFilter) podRequestsForNamespace(
+	ctx context.Context,
+	namespace *v1.Namespace,
+	isOwned func(pod *v1.Pod) bool,
+) []reconcile.Request {
+	pods := &v1.PodList{}
+	if err := f.client.List(ctx, pods, client.InNamespace(namespace.Name)); err != nil {
+		logging.Errorf(
+			nil, err,
+			"unable to list pods within namespace '%s' upon label change (pods won't be requeued)", namespace.Name,
+		)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if f.isPodAllowed(pod) && isOwned(pod) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(pod)})
+		}
+	}
+
+	return requests
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestNewNamespaceFilter(t *testing.T) {
+	t.Run("UnableToParseNamespaceLabelSelector", func(t *testing.T) {
+		f, err := newNamespaceFilter(controllercommon.ControllerConfig{NamespaceLabelSelector: "!!"}, nil)
+		assert.ErrorContains(t, err, "unable to parse namespace label selector")
+		assert.Nil(t, f)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		c := fake.NewClientBuilder().Build()
+		f, err := newNamespaceFilter(
+			controllercommon.ControllerConfig{
+				WatchNamespaces:        []string{"ns1"},
+				ExcludeNamespaces:      []string{"ns2"},
+				NamespaceLabelSelector: "key=value",
+			},
+			c,
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]bool{"ns1": true}, f.watched)
+		assert.Equal(t, map[string]bool{"ns2": true}, f.excluded)
+		assert.Equal(t, "key=value", f.selector.String())
+		assert.Equal(t, c, f.client)
+	})
+}
+
+func TestNamespaceFilterIsPodAllowed(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     controllercommon.ControllerConfig
+		namespace  string
+		want       bool
+		wantLogMsg string
+	}{
+		{
+			name:      "NoFiltering",
+			namespace: "ns1",
+			want:      true,
+		},
+		{
+			name:      "Watched",
+			config:    controllercommon.ControllerConfig{WatchNamespaces: []string{"ns1"}},
+			namespace: "ns1",
+			want:      true,
+		},
+		{
+			name:      "NotWatched",
+			config:    controllercommon.ControllerConfig{WatchNamespaces: []string{"ns1"}},
+			namespace: "ns2",
+			want:      false,
+		},
+		{
+			name:      "Excluded",
+			config:    controllercommon.ControllerConfig{ExcludeNamespaces: []string{"ns1"}},
+			namespace: "ns1",
+			want:      false,
+		},
+		{
+			name:      "NotExcluded",
+			config:    controllercommon.ControllerConfig{ExcludeNamespaces: []string{"ns1"}},
+			namespace: "ns2",
+			want:      true,
+		},
+		{
+			name:       "UnableToGetNamespace",
+			config:     controllercommon.ControllerConfig{NamespaceLabelSelector: "key=value"},
+			namespace:  "notfound",
+			want:       false,
+			wantLogMsg: "unable to get namespace 'notfound' to evaluate namespace label selector (pod 'pod' won't be reconciled)",
+		},
+		{
+			name:      "SelectorMatches",
+			config:    controllercommon.ControllerConfig{NamespaceLabelSelector: "key=value"},
+			namespace: "matching",
+			want:      true,
+		},
+		{
+			name:      "SelectorDoesNotMatch",
+			config:    controllercommon.ControllerConfig{NamespaceLabelSelector: "key=value"},
+			namespace: "notmatching",
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithObjects(
+				&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "matching", Labels: map[string]string{"key": "value"}}},
+				&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "notmatching", Labels: map[string]string{"key": "other"}}},
+			).Build()
+			f, _ := newNamespaceFilter(tt.config, c)
+
+			buffer := &bytes.Buffer{}
+			logging.Init(buffer, logging.VTrace, false)
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: tt.namespace, Name: "pod"}}
+			assert.Equal(t, tt.want, f.isPodAllowed(pod))
+			if tt.wantLogMsg != "" {
+				assert.Contains(t, buffer.String(), tt.wantLogMsg)
+			}
+		})
+	}
+}
+
+func TestNamespaceLabelsChanged(t *testing.T) {
+	namespace := func(labels map[string]string) *v1.Namespace {
+		return &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "namespace", Labels: labels}}
+	}
+
+	assert.False(t, namespaceLabelsChanged(event.TypedUpdateEvent[*v1.Namespace]{
+		ObjectOld: namespace(map[string]string{"key": "value"}),
+		ObjectNew: namespace(map[string]string{"key": "value"}),
+	}))
+	assert.True(t, namespaceLabelsChanged(event.TypedUpdateEvent[*v1.Namespace]{
+		ObjectOld: namespace(map[string]string{"key": "other"}),
+		ObjectNew: namespace(map[string]string{"key": "value"}),
+	}))
+}
+
+func TestNamespaceFilterPodRequestsForNamespace(t *testing.T) {
+	matching := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "matching", Labels: map[string]string{"key": "value"}}}
+	pod := func(namespace string, name string) *v1.Pod {
+		return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+	config := controllercommon.ControllerConfig{NamespaceLabelSelector: "key=value"}
+
+	t.Run("UnableToListPods", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+			List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+				return errors.New("")
+			},
+		}).Build()
+		f, _ := newNamespaceFilter(config, c)
+
+		buffer := &bytes.Buffer{}
+		logging.Init(buffer, logging.VTrace, false)
+		got := f.podRequestsForNamespace(context.Background(), matching, func(*v1.Pod) bool { return true })
+		assert.Nil(t, got)
+		assert.Contains(t, buffer.String(), "unable to list pods within namespace 'matching' upon label change")
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithObjects(
+			matching,
+			pod("matching", "owned"),
+			pod("matching", "notowned"),
+			pod("other", "owned"),
+		).Build()
+		f, _ := newNamespaceFilter(config, c)
+
+		got := f.podRequestsForNamespace(context.Background(), matching, func(pod *v1.Pod) bool {
+			return pod.Name == "owned"
+		})
+		assert.Equal(
+			t,
+			[]reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "matching", Name: "owned"}}},
+			got,
+		)
+	})
+
+	t.Run("NotAllowed", func(t *testing.T) {
+		notMatching := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "notmatching"}}
+		c := fake.NewClientBuilder().WithObjects(notMatching, pod("notmatching", "owned")).Build()
+		f, _ := newNamespaceFilter(config, c)
+
+		got := f.podRequestsForNamespace(context.Background(), notMatching, func(*v1.Pod) bool { return true })
+		assert.Nil(t, got)
+	})
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/scale/scalecommon"
+	"k8s.io/api/core/v1"
+)
+
+// hasRelevantChange returns whether newPod differs from oldPod in any field that reconciliation depends on, other
+// than the CSA status annotation: labels, annotations, QoS class, resize conditions and the target container's
+// resources, resize policy, probe presence and status. Fields are compared directly (rather than via reflection-based
+// deep equality) as this is invoked for every pod update.
+func hasRelevantChange(oldPod *v1.Pod, newPod *v1.Pod) bool {
+	if !stringMapsEqual(oldPod.Labels, newPod.Labels, "") {
+		return true
+	}
+
+	if !stringMapsEqual(oldPod.Annotations, newPod.Annotations, kubecommon.AnnotationStatus) {
+		return true
+	}
+
+	if oldPod.Status.QOSClass != newPod.Status.QOSClass {
+		return true
+	}
+
+	if !resizeConditionsEqual(oldPod.Status.Conditions, newPod.Status.Conditions) {
+		return true
+	}
+
+	// The target container name annotation is the same in both pods at this point.
+	targetContainerName := newPod.Annotations[scalecommon.AnnotationTargetContainerName]
+
+	if !containersEqual(
+		findContainer(oldPod.Spec.Containers, targetContainerName),
+		findContainer(newPod.Spec.Containers, targetContainerName),
+	) {
+		return true
+	}
+
+	return !containerStatusesEqual(
+		findContainerStatus(oldPod.Status.ContainerStatuses, targetContainerName),
+		findContainerStatus(newPod.Status.ContainerStatuses, targetContainerName),
+	)
+}
+
+// stringMapsEqual returns whether m1 and m2 contain the same entries, ignoring any entry with key ignoreKey.
+func stringMapsEqual(m1 map[string]string, m2 map[string]string, ignoreKey string) bool {
+	len1, len2 := len(m1), len(m2)
+	if _, ok := m1[ignoreKey]; ok {
+		len1--
+	}
+	if _, ok := m2[ignoreKey]; ok {
+		len2--
+	}
+	if len1 != len2 {
+		return false
+	}
+
+	for key, value1 := range m1 {
+		if key == ignoreKey {
+			continue
+		}
+
+		if value2, ok := m2[key]; !ok || value1 != value2 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resizeConditionsEqual returns whether the resize-related conditions within c1 and c2 are the same.
+func resizeConditionsEqual(c1 []v1.PodCondition, c2 []v1.PodCondition) bool {
+	for _, conditionType := range []v1.PodConditionType{v1.PodResizePending, v1.PodResizeInProgress} {
+		condition1, condition2 := findCondition(c1, conditionType), findCondition(c2, conditionType)
+		if (condition1 == nil) != (condition2 == nil) {
+			return false
+		}
+
+		if condition1 != nil &&
+			(condition1.Status != condition2.Status ||
+				condition1.Reason != condition2.Reason ||
+				condition1.Message != condition2.Message) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// containersEqual returns whether the fields of c1 and c2 that reconciliation depends on are the same.
+func containersEqual(c1 *v1.Container, c2 *v1.Container) bool {
+	if c1 == nil || c2 == nil {
+		return c1 == c2
+	}
+
+	if (c1.StartupProbe == nil) != (c2.StartupProbe == nil) || (c1.ReadinessProbe == nil) != (c2.ReadinessProbe == nil) {
+		return false
+	}
+
+	if len(c1.ResizePolicy) != len(c2.ResizePolicy) {
+		return false
+	}
+	for i := range c1.ResizePolicy {
+		if c1.ResizePolicy[i] != c2.ResizePolicy[i] {
+			return false
+		}
+	}
+
+	return resourceListsEqual(c1.Resources.Requests, c2.Resources.Requests) &&
+		resourceListsEqual(c1.Resources.Limits, c2.Resources.Limits)
+}
+
+// containerStatusesEqual returns whether the fields of s1 and s2 that reconciliation depends on are the same.
+func containerStatusesEqual(s1 *v1.ContainerStatus, s2 *v1.ContainerStatus) bool {
+	if s1 == nil || s2 == nil {
+		return s1 == s2
+	}
+
+	if s1.Ready != s2.Ready || s1.RestartCount != s2.RestartCount {
+		return false
+	}
+
+	if (s1.Started == nil) != (s2.Started == nil) || (s1.Started != nil && *s1.Started != *s2.Started) {
+		return false
+	}
+
+	if (s1.State.Waiting == nil) != (s2.State.Waiting == nil) ||
+		(s1.State.Running == nil) != (s2.State.Running == nil) ||
+		(s1.State.Terminated == nil) != (s2.State.Terminated == nil) {
+		return false
+	}
+
+	if (s1.Resources == nil) != (s2.Resources == nil) {
+		return false
+	}
+
+	return s1.Resources == nil ||
+		(resourceListsEqual(s1.Resources.Requests, s2.Resources.Requests) &&
+			resourceListsEqual(s1.Resources.Limits, s2.Resources.Limits))
+}
+
+// resourceListsEqual returns whether l1 and l2 contain the same resources with semantically equal quantities.
+func resourceListsEqual(l1 v1.ResourceList, l2 v1.ResourceList) bool {
+	if len(l1) != len(l2) {
+		return false
+	}
+
+	for name, quantity1 := range l1 {
+		quantity2, ok := l2[name]
+		if !ok || !quantity1.Equal(quantity2) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func findContainer(containers []v1.Container, name string) *v1.Container {
+	for i := range containers {
+		if containers[i].Name == name {
+			return &containers[i]
+		}
+	}
+
+	return nil
+}
+
+func findContainerStatus(statuses []v1.ContainerStatus, name string) *v1.ContainerStatus {
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return &statuses[i]
+		}
+	}
+
+	return nil
+}
+
+func findCondition(conditions []v1.PodCondition, conditionType v1.PodConditionType) *v1.PodCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+
+	return nil
+}
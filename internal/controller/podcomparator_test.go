@@ -0,0 +1,238 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestHasRelevantChange(t *testing.T) {
+	tests := []struct {
+		name       string
+		mutateFunc func(*v1.Pod)
+		want       bool
+	}{
+		{"NoChange", func(*v1.Pod) {}, false},
+		{"StatusAnnotationChanged", func(pod *v1.Pod) { pod.Annotations[kubecommon.AnnotationStatus] = "changed" }, false},
+		{"IrrelevantSpecChanged", func(pod *v1.Pod) { pod.Spec.NodeName = "node" }, false},
+		{"IrrelevantStatusChanged", func(pod *v1.Pod) { pod.Status.PodIP = "10.0.0.1" }, false},
+		{
+			"OtherContainerStatusChanged",
+			func(pod *v1.Pod) {
+				pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, v1.ContainerStatus{Name: "other"})
+			},
+			false,
+		},
+		{
+			"ResourceQuantityFormatChanged",
+			func(pod *v1.Pod) {
+				quantity := pod.Spec.Containers[0].Resources.Requests[v1.ResourceCPU]
+				pod.Spec.Containers[0].Resources.Requests[v1.ResourceCPU] = resource.MustParse(quantity.AsDec().String())
+			},
+			false,
+		},
+		{"LabelAdded", func(pod *v1.Pod) { pod.Labels["new"] = "value" }, true},
+		{"LabelChanged", func(pod *v1.Pod) { pod.Labels[kubecommon.LabelEnabled] = "false" }, true},
+		{"AnnotationAdded", func(pod *v1.Pod) { pod.Annotations[kubecommon.AnnotationPaused] = "true" }, true},
+		{"AnnotationRemoved", func(pod *v1.Pod) { delete(pod.Annotations, kubecommon.AnnotationStatus) }, false},
+		{"QOSClassChanged", func(pod *v1.Pod) { pod.Status.QOSClass = v1.PodQOSBurstable }, true},
+		{
+			"ResizeConditionAdded",
+			func(pod *v1.Pod) {
+				pod.Status.Conditions = append(pod.Status.Conditions, v1.PodCondition{Type: v1.PodResizePending})
+			},
+			true,
+		},
+		{
+			"ResizeConditionMessageChanged",
+			func(pod *v1.Pod) { pod.Status.Conditions[0].Message = "changed" },
+			true,
+		},
+		{
+			"OtherConditionAdded",
+			func(pod *v1.Pod) {
+				pod.Status.Conditions = append(pod.Status.Conditions, v1.PodCondition{Type: v1.PodReady})
+			},
+			false,
+		},
+		{
+			"TargetContainerRemoved",
+			func(pod *v1.Pod) { pod.Spec.Containers = nil },
+			true,
+		},
+		{
+			"TargetContainerRequestsChanged",
+			func(pod *v1.Pod) {
+				pod.Spec.Containers[0].Resources.Requests[v1.ResourceCPU] = resource.MustParse("999m")
+			},
+			true,
+		},
+		{
+			"TargetContainerLimitsChanged",
+			func(pod *v1.Pod) {
+				pod.Spec.Containers[0].Resources.Limits[v1.ResourceMemory] = resource.MustParse("999M")
+			},
+			true,
+		},
+		{
+			"TargetContainerResizePolicyChanged",
+			func(pod *v1.Pod) {
+				pod.Spec.Containers[0].ResizePolicy = append(pod.Spec.Containers[0].ResizePolicy, v1.ContainerResizePolicy{})
+			},
+			true,
+		},
+		{"TargetContainerStartupProbeRemoved", func(pod *v1.Pod) { pod.Spec.Containers[0].StartupProbe = nil }, true},
+		{
+			"TargetContainerReadinessProbeRemoved",
+			func(pod *v1.Pod) { pod.Spec.Containers[0].ReadinessProbe = nil },
+			true,
+		},
+		{
+			"TargetContainerStatusRemoved",
+			func(pod *v1.Pod) { pod.Status.ContainerStatuses = nil },
+			true,
+		},
+		{
+			"TargetContainerStartedChanged",
+			func(pod *v1.Pod) {
+				started := !*pod.Status.ContainerStatuses[0].Started
+				pod.Status.ContainerStatuses[0].Started = &started
+			},
+			true,
+		},
+		{
+			"TargetContainerReadyChanged",
+			func(pod *v1.Pod) { pod.Status.ContainerStatuses[0].Ready = !pod.Status.ContainerStatuses[0].Ready },
+			true,
+		},
+		{"TargetContainerRestarted", func(pod *v1.Pod) { pod.Status.ContainerStatuses[0].RestartCount++ }, true},
+		{
+			"TargetContainerStateChanged",
+			func(pod *v1.Pod) {
+				pod.Status.ContainerStatuses[0].State = v1.ContainerState{Waiting: &v1.ContainerStateWaiting{}}
+			},
+			true,
+		},
+		{
+			"TargetContainerStatusResourcesChanged",
+			func(pod *v1.Pod) {
+				pod.Status.ContainerStatuses[0].Resources.Requests[v1.ResourceCPU] = resource.MustParse("999m")
+			},
+			true,
+		},
+		{
+			"TargetContainerStatusResourcesRemoved",
+			func(pod *v1.Pod) { pod.Status.ContainerStatuses[0].Resources = nil },
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldPod := comparatorPod()
+			newPod := oldPod.DeepCopy()
+			tt.mutateFunc(newPod)
+			assert.Equal(t, tt.want, hasRelevantChange(oldPod, newPod))
+		})
+	}
+}
+
+func TestStringMapsEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		m1   map[string]string
+		m2   map[string]string
+		want bool
+	}{
+		{"BothNil", nil, nil, true},
+		{"NilAndEmpty", nil, map[string]string{}, true},
+		{"OnlyIgnoredInOne", map[string]string{"ignore": "1"}, nil, true},
+		{"IgnoredDiffers", map[string]string{"ignore": "1", "k": "v"}, map[string]string{"ignore": "2", "k": "v"}, true},
+		{"ValueDiffers", map[string]string{"k": "v1"}, map[string]string{"k": "v2"}, false},
+		{"KeyDiffers", map[string]string{"k1": "v"}, map[string]string{"k2": "v"}, false},
+		{"LengthDiffers", map[string]string{"k": "v"}, map[string]string{"k": "v", "k2": "v"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, stringMapsEqual(tt.m1, tt.m2, "ignore"))
+		})
+	}
+}
+
+// BenchmarkHasRelevantChange compares hasRelevantChange with the reflection-based deep equality previously employed
+// by predicateUpdateFunc, for an update that only changes the CSA status (the worst case for both as all fields are
+// compared).
+func BenchmarkHasRelevantChange(b *testing.B) {
+	oldPod := comparatorPod()
+	newPod := oldPod.DeepCopy()
+	newPod.ResourceVersion = "2"
+	newPod.Annotations[kubecommon.AnnotationStatus] = "changed"
+
+	b.Run("Targeted", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if hasRelevantChange(oldPod, newPod) {
+				b.Fatal("unexpected relevant change")
+			}
+		}
+	})
+
+	b.Run("DeepEquality", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if deepEqualityHasRelevantChange(oldPod, newPod) {
+				b.Fatal("unexpected relevant change")
+			}
+		}
+	})
+}
+
+// deepEqualityHasRelevantChange is the reflection-based deep equality previously employed by predicateUpdateFunc.
+func deepEqualityHasRelevantChange(oldPod *v1.Pod, newPod *v1.Pod) bool {
+	if common.AreStructsEqual(oldPod.TypeMeta, newPod.TypeMeta) {
+		if common.AreStructsEqual(oldPod.Spec, newPod.Spec) {
+			if common.AreStructsEqual(oldPod.Status, newPod.Status) {
+				oldPodCopy, newPodCopy := oldPod.DeepCopy(), newPod.DeepCopy()
+				oldPodCopy.ResourceVersion, newPodCopy.ResourceVersion = "", ""
+				oldPodCopy.ManagedFields, newPodCopy.ManagedFields = nil, nil
+				delete(oldPodCopy.Annotations, kubecommon.AnnotationStatus)
+				delete(newPodCopy.Annotations, kubecommon.AnnotationStatus)
+
+				if common.AreStructsEqual(oldPodCopy.ObjectMeta, newPodCopy.ObjectMeta) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+func comparatorPod() *v1.Pod {
+	return kubetest.NewPodBuilder().
+		QOSClass(v1.PodQOSGuaranteed).
+		ResizeConditionsInProgress().
+		ContainerCustomizerFunc(func(b *kubetest.ContainerBuilder) { b.StartupProbe(true).ReadinessProbe(true) }).
+		AdditionalAnnotations(map[string]string{kubecommon.AnnotationStatus: "status"}).
+		Build()
+}
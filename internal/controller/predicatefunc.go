@@ -17,7 +17,6 @@ limitations under the License.
 package controller
 
 import (
-	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
 	csaevent "github.com/ExpediaGroup/container-startup-autoscaler/internal/event"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/event/eventcommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
@@ -75,8 +74,9 @@ func predicateDeleteFunc(event event.TypedDeleteEvent[*v1.Pod]) bool {
 		eventcommon.NewPodEvent(eventcommon.PodEventTypeDelete, event.Object),
 	)
 
-	// Don't need to reconcile deletes.
-	return false
+	// Don't need to reconcile pods that have actually been deleted. Pods that are deleted from the informer cache
+	// without a deletion timestamp have had their enabled label removed, so are reconciled to be handed back.
+	return event.Object.DeletionTimestamp.IsZero()
 }
 
 // predicateUpdateFunc returns whether update events should be reconciled.
@@ -107,31 +107,11 @@ func predicateUpdateFunc(event event.TypedUpdateEvent[*v1.Pod]) bool {
 		return true
 	}
 
-	// Don't reconcile pods that *only* have an updated controller status.
-
-	// Nested as deep struct comparison is expensive.
-	if common.AreStructsEqual(oldPod.TypeMeta, newPod.TypeMeta) {
-		// TypeMeta hasn't changed.
-
-		if common.AreStructsEqual(oldPod.Spec, newPod.Spec) {
-			// Spec hasn't changed.
-
-			if common.AreStructsEqual(oldPod.Status, newPod.Status) {
-				// Only ObjectMeta has changed.
-
-				// Remove variable fields from old and new to compare ObjectMeta - if same only status has been updated.
-				oldPodCopy, newPodCopy := oldPod.DeepCopy(), newPod.DeepCopy()
-				oldPodCopy.ResourceVersion, newPodCopy.ResourceVersion = "", ""
-				oldPodCopy.ManagedFields, newPodCopy.ManagedFields = nil, nil
-				delete(oldPodCopy.Annotations, kubecommon.AnnotationStatus)
-				delete(newPodCopy.Annotations, kubecommon.AnnotationStatus)
-
-				if common.AreStructsEqual(oldPodCopy.ObjectMeta, newPodCopy.ObjectMeta) {
-					reconciler.SkippedOnlyStatusChange().Inc()
-					return false
-				}
-			}
-		}
+	// Don't reconcile pods that have only changed in ways that reconciliation doesn't depend on, such as those that
+	// *only* have an updated controller status.
+	if !hasRelevantChange(oldPod, newPod) {
+		reconciler.SkippedOnlyStatusChange().Inc()
+		return false
 	}
 
 	return true
@@ -64,20 +64,34 @@ func TestPredicateDeleteFunc(t *testing.T) {
 		name,
 		[]eventcommon.PodEventType{eventcommon.PodEventTypeDelete},
 	)
-	pod := &v1.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: namespace,
-		},
+
+	tests := []struct {
+		name              string
+		deletionTimestamp *metav1.Time
+		want              bool
+	}{
+		{"Deleted", &metav1.Time{Time: time.Now()}, false},
+		{"RemovedFromCache", nil, true},
 	}
-	evt := event.TypedDeleteEvent[*v1.Pod]{Object: pod}
-	assert.False(t, predicateDeleteFunc(evt))
-	select {
-	case podEvent := <-podEventCh:
-		assert.Equal(t, eventcommon.PodEventTypeDelete, podEvent.EventType)
-		assert.Same(t, pod, podEvent.Pod)
-	case <-time.After(500 * time.Millisecond):
-		t.Fatalf("event not generated")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					DeletionTimestamp: tt.deletionTimestamp,
+				},
+			}
+			evt := event.TypedDeleteEvent[*v1.Pod]{Object: pod}
+			assert.Equal(t, tt.want, predicateDeleteFunc(evt))
+			select {
+			case podEvent := <-podEventCh:
+				assert.Equal(t, eventcommon.PodEventTypeDelete, podEvent.EventType)
+				assert.Same(t, pod, podEvent.Pod)
+			case <-time.After(500 * time.Millisecond):
+				t.Fatalf("event not generated")
+			}
+		})
 	}
 }
 
@@ -133,7 +147,7 @@ func TestPredicateUpdateFunc(t *testing.T) {
 		oldPod.ResourceVersion, newPod.ResourceVersion = "1", "2"
 		oldPod.Annotations = map[string]string{kubecommon.AnnotationStatus: "test1"}
 		newPod.Annotations = map[string]string{kubecommon.AnnotationStatus: "test1"}
-		oldPod.ObjectMeta.Name, oldPod.ObjectMeta.Name = "test1", "test2"
+		oldPod.Labels, newPod.Labels = map[string]string{"test": "test1"}, map[string]string{"test": "test2"}
 		evt := event.TypedUpdateEvent[*v1.Pod]{
 			ObjectOld: oldPod,
 			ObjectNew: newPod,
@@ -141,6 +155,22 @@ func TestPredicateUpdateFunc(t *testing.T) {
 		assert.True(t, predicateUpdateFunc(evt))
 	})
 
+	t.Run("IrrelevantChanged", func(t *testing.T) {
+		reconciler.ResetMetrics()
+		oldPod, newPod := &v1.Pod{}, &v1.Pod{}
+		oldPod.ResourceVersion, newPod.ResourceVersion = "1", "2"
+		oldPod.Annotations = map[string]string{kubecommon.AnnotationStatus: "test1"}
+		newPod.Annotations = map[string]string{kubecommon.AnnotationStatus: "test1"}
+		oldPod.Status.PodIP, newPod.Status.PodIP = "10.0.0.1", "10.0.0.2"
+		evt := event.TypedUpdateEvent[*v1.Pod]{
+			ObjectOld: oldPod,
+			ObjectNew: newPod,
+		}
+		assert.False(t, predicateUpdateFunc(evt))
+		metricVal, _ := testutil.GetCounterMetricValue(reconciler.SkippedOnlyStatusChange())
+		assert.Equal(t, float64(1), metricVal)
+	})
+
 	t.Run("Subscriber", func(t *testing.T) {
 		namespace := "namespace"
 		name := "name"
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/scale/scalecommon"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// priorityStartup is the priority of reconciles expected to command startup resources.
+	priorityStartup = 100
+
+	// priorityDefault is the priority of all other reconciles.
+	priorityDefault = 0
+)
+
+// priorityEventHandler enqueues a reconcile request for each pod event, with a priority derived from the pod if the
+// workqueue is a priority queue. Otherwise, requests are enqueued as per handler.TypedEnqueueRequestForObject.
+type priorityEventHandler struct{}
+
+var _ handler.TypedEventHandler[*v1.Pod, reconcile.Request] = priorityEventHandler{}
+
+// Create enqueues a reconcile request for the created pod. Pods not expected to command startup resources are given
+// low priority if the event results from the informer's initial list.
+func (h priorityEventHandler) Create(
+	_ context.Context,
+	evt event.TypedCreateEvent[*v1.Pod],
+	q workqueue.TypedRateLimitingInterface[reconcile.Request],
+) {
+	priority := reconcilePriority(evt.Object)
+	if priority == priorityDefault && evt.IsInInitialList {
+		priority = handler.LowPriority
+	}
+
+	h.enqueue(q, evt.Object, priority)
+}
+
+// Update enqueues a reconcile request for the updated pod.
+func (h priorityEventHandler) Update(
+	_ context.Context,
+	evt event.TypedUpdateEvent[*v1.Pod],
+	q workqueue.TypedRateLimitingInterface[reconcile.Request],
+) {
+	h.enqueue(q, evt.ObjectNew, reconcilePriority(evt.ObjectNew))
+}
+
+// Delete enqueues a reconcile request for the deleted pod.
+func (h priorityEventHandler) Delete(
+	_ context.Context,
+	evt event.TypedDeleteEvent[*v1.Pod],
+	q workqueue.TypedRateLimitingInterface[reconcile.Request],
+) {
+	h.enqueue(q, evt.Object, priorityDefault)
+}
+
+// Generic enqueues a reconcile request for the pod.
+func (h priorityEventHandler) Generic(
+	_ context.Context,
+	evt event.TypedGenericEvent[*v1.Pod],
+	q workqueue.TypedRateLimitingInterface[reconcile.Request],
+) {
+	h.enqueue(q, evt.Object, reconcilePriority(evt.Object))
+}
+
+// enqueue adds a reconcile request for pod to q, with priority if q is a priority queue.
+func (h priorityEventHandler) enqueue(
+	q workqueue.TypedRateLimitingInterface[reconcile.Request],
+	pod *v1.Pod,
+	priority int,
+) {
+	if pod == nil {
+		return
+	}
+
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}}
+
+	if priorityQueue, ok := q.(priorityqueue.PriorityQueue[reconcile.Request]); ok {
+		priorityQueue.AddWithOpts(priorityqueue.AddOpts{Priority: priority}, request)
+		return
+	}
+
+	q.Add(request)
+}
+
+// reconcilePriority returns the priority with which to reconcile pod. Reconciles are expected to command startup
+// resources if the target container hasn't yet started - this is the case for new pods and restarted containers.
+// Priority is determined only from the pod as it's invoked for every pod event.
+func reconcilePriority(pod *v1.Pod) int {
+	if pod == nil || !pod.DeletionTimestamp.IsZero() {
+		return priorityDefault
+	}
+
+	targetContainerName := pod.Annotations[scalecommon.AnnotationTargetContainerName]
+	if targetContainerName == "" {
+		return priorityDefault
+	}
+
+	if findContainer(pod.Spec.Containers, targetContainerName) == nil {
+		return priorityDefault
+	}
+
+	status := findContainerStatus(pod.Status.ContainerStatuses, targetContainerName)
+	if status == nil || status.Started == nil || !*status.Started {
+		return priorityStartup
+	}
+
+	return priorityDefault
+}
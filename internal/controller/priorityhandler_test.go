@@ -0,0 +1,213 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubetest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/scale/scalecommon"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestPriorityEventHandler(t *testing.T) {
+	startingPod := kubetest.NewPodBuilder().StateStarted(podcommon.StateBoolFalse).Build()
+	startedPod := kubetest.NewPodBuilder().StateStarted(podcommon.StateBoolTrue).Build()
+
+	tests := []struct {
+		name         string
+		enqueueFunc  func(workqueue.TypedRateLimitingInterface[reconcile.Request])
+		wantPriority int
+	}{
+		{
+			"CreateStarting",
+			func(q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				priorityEventHandler{}.Create(context.TODO(), event.TypedCreateEvent[*v1.Pod]{Object: startingPod}, q)
+			},
+			priorityStartup,
+		},
+		{
+			"CreateStartingInitialList",
+			func(q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				priorityEventHandler{}.Create(
+					context.TODO(),
+					event.TypedCreateEvent[*v1.Pod]{Object: startingPod, IsInInitialList: true},
+					q,
+				)
+			},
+			priorityStartup,
+		},
+		{
+			"CreateStarted",
+			func(q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				priorityEventHandler{}.Create(context.TODO(), event.TypedCreateEvent[*v1.Pod]{Object: startedPod}, q)
+			},
+			priorityDefault,
+		},
+		{
+			"CreateStartedInitialList",
+			func(q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				priorityEventHandler{}.Create(
+					context.TODO(),
+					event.TypedCreateEvent[*v1.Pod]{Object: startedPod, IsInInitialList: true},
+					q,
+				)
+			},
+			handler.LowPriority,
+		},
+		{
+			"UpdateStarting",
+			func(q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				priorityEventHandler{}.Update(
+					context.TODO(),
+					event.TypedUpdateEvent[*v1.Pod]{ObjectOld: startedPod, ObjectNew: startingPod},
+					q,
+				)
+			},
+			priorityStartup,
+		},
+		{
+			"UpdateStarted",
+			func(q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				priorityEventHandler{}.Update(
+					context.TODO(),
+					event.TypedUpdateEvent[*v1.Pod]{ObjectOld: startingPod, ObjectNew: startedPod},
+					q,
+				)
+			},
+			priorityDefault,
+		},
+		{
+			"Delete",
+			func(q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				priorityEventHandler{}.Delete(context.TODO(), event.TypedDeleteEvent[*v1.Pod]{Object: startingPod}, q)
+			},
+			priorityDefault,
+		},
+		{
+			"GenericStarting",
+			func(q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				priorityEventHandler{}.Generic(context.TODO(), event.TypedGenericEvent[*v1.Pod]{Object: startingPod}, q)
+			},
+			priorityStartup,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantRequest := reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: startingPod.Namespace, Name: startingPod.Name},
+			}
+
+			t.Run("PriorityQueue", func(t *testing.T) {
+				q := priorityqueue.New[reconcile.Request]("test")
+				defer q.ShutDown()
+
+				tt.enqueueFunc(q)
+				request, priority, _ := q.GetWithPriority()
+				assert.Equal(t, wantRequest, request)
+				assert.Equal(t, tt.wantPriority, priority)
+			})
+
+			t.Run("NonPriorityQueue", func(t *testing.T) {
+				q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+				defer q.ShutDown()
+
+				tt.enqueueFunc(q)
+				assert.Equal(t, 1, q.Len())
+				request, _ := q.Get()
+				assert.Equal(t, wantRequest, request)
+			})
+		})
+	}
+
+	t.Run("NilPod", func(t *testing.T) {
+		q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+		defer q.ShutDown()
+
+		priorityEventHandler{}.Generic(context.TODO(), event.TypedGenericEvent[*v1.Pod]{}, q)
+		assert.Equal(t, 0, q.Len())
+	})
+}
+
+func TestReconcilePriority(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *v1.Pod
+		want int
+	}{
+		{"Nil", nil, priorityDefault},
+		{"NotStarted", kubetest.NewPodBuilder().StateStarted(podcommon.StateBoolFalse).Build(), priorityStartup},
+		{
+			"StartedNil",
+			kubetest.NewPodBuilder().StateStarted(podcommon.StateBoolTrue).NilContainerStatusStarted(true).Build(),
+			priorityStartup,
+		},
+		{"Started", kubetest.NewPodBuilder().StateStarted(podcommon.StateBoolTrue).Build(), priorityDefault},
+		{
+			"NoContainerStatus",
+			func() *v1.Pod {
+				pod := kubetest.NewPodBuilder().StateStarted(podcommon.StateBoolTrue).Build()
+				pod.Status.ContainerStatuses = nil
+				return pod
+			}(),
+			priorityStartup,
+		},
+		{
+			"Deleting",
+			func() *v1.Pod {
+				pod := kubetest.NewPodBuilder().StateStarted(podcommon.StateBoolFalse).Build()
+				pod.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				return pod
+			}(),
+			priorityDefault,
+		},
+		{
+			"NoTargetContainerAnnotation",
+			func() *v1.Pod {
+				pod := kubetest.NewPodBuilder().StateStarted(podcommon.StateBoolFalse).Build()
+				delete(pod.Annotations, scalecommon.AnnotationTargetContainerName)
+				return pod
+			}(),
+			priorityDefault,
+		},
+		{
+			"TargetContainerNotInSpec",
+			func() *v1.Pod {
+				pod := kubetest.NewPodBuilder().StateStarted(podcommon.StateBoolFalse).Build()
+				pod.Annotations[scalecommon.AnnotationTargetContainerName] = "other"
+				return pod
+			}(),
+			priorityDefault,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, reconcilePriority(tt.pod))
+		})
+	}
+}
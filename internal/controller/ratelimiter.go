@@ -0,0 +1,98 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// newRateLimiter returns the rate limiter that determines how long to wait before requeuing reconciles that fail, per
+// the configured requeue rate limiter.
+func newRateLimiter(controllerConfig controllercommon.ControllerConfig) workqueue.TypedRateLimiter[reconcile.Request] {
+	if controllerConfig.RequeueRateLimiter != controllercommon.RequeueRateLimiterExponential {
+		return &fixedRateLimiter{delay: controllerConfig.RequeueDurationSecsDuration()}
+	}
+
+	// The longest delay of per-pod backoff and the overall token bucket is used, so that the API server is protected
+	// when many pods fail at once.
+	return workqueue.NewTypedMaxOfRateLimiter(
+		&jitterRateLimiter{
+			TypedRateLimiter: workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](
+				controllerConfig.RequeueBaseDelayDuration(),
+				controllerConfig.RequeueMaxDelayDuration(),
+			),
+			jitterFraction: float64(controllerConfig.RequeueJitterPercent) / 100,
+			randFloat:      rand.Float64,
+		},
+		&workqueue.TypedBucketRateLimiter[reconcile.Request]{
+			Limiter: rate.NewLimiter(rate.Limit(controllerConfig.RequeueQPS), controllerConfig.RequeueBurst),
+		},
+	)
+}
+
+// newWorkqueueRateLimiter returns the rate limiter for the controller-runtime workqueue, which determines how long to
+// wait before requeuing reconciles that fail with an error. If exponential, this is a separate instance of the
+// configured requeue rate limiter, since controller-runtime forgets failures tracked by the workqueue's rate limiter
+// when a reconcile requeues after a delay - sharing an instance would reset per-pod backoff upon every backoff requeue.
+// Otherwise, nil is returned so that controller-runtime's default rate limiter is used.
+func newWorkqueueRateLimiter(
+	controllerConfig controllercommon.ControllerConfig,
+) workqueue.TypedRateLimiter[reconcile.Request] {
+	if controllerConfig.RequeueRateLimiter != controllercommon.RequeueRateLimiterExponential {
+		return nil
+	}
+
+	return newRateLimiter(controllerConfig)
+}
+
+// fixedRateLimiter is a workqueue.TypedRateLimiter that always waits for the same delay.
+type fixedRateLimiter struct {
+	delay time.Duration
+}
+
+// When returns the fixed delay.
+func (l *fixedRateLimiter) When(_ reconcile.Request) time.Duration {
+	return l.delay
+}
+
+// Forget does nothing since failures aren't tracked.
+func (l *fixedRateLimiter) Forget(_ reconcile.Request) {}
+
+// NumRequeues always returns 0 since failures aren't tracked.
+func (l *fixedRateLimiter) NumRequeues(_ reconcile.Request) int {
+	return 0
+}
+
+// jitterRateLimiter is a workqueue.TypedRateLimiter that adds a random proportion of up to jitterFraction to the delays
+// of the wrapped rate limiter, so that pods that fail at the same time aren't requeued at the same time.
+type jitterRateLimiter struct {
+	workqueue.TypedRateLimiter[reconcile.Request]
+	jitterFraction float64
+	randFloat      func() float64
+}
+
+// When returns the delay of the wrapped rate limiter with jitter added.
+func (l *jitterRateLimiter) When(request reconcile.Request) time.Duration {
+	delay := l.TypedRateLimiter.When(request)
+	return delay + time.Duration(l.randFloat()*l.jitterFraction*float64(delay))
+}
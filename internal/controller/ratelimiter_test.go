@@ -0,0 +1,130 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+	request1 := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "namespace", Name: "name1"}}
+	request2 := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "namespace", Name: "name2"}}
+
+	t.Run("Fixed", func(t *testing.T) {
+		l := newRateLimiter(controllercommon.ControllerConfig{
+			RequeueRateLimiter:  controllercommon.RequeueRateLimiterFixed,
+			RequeueDurationSecs: 2,
+		})
+		assert.Equal(t, 2*time.Second, l.When(request1))
+		assert.Equal(t, 2*time.Second, l.When(request1))
+		assert.Equal(t, 0, l.NumRequeues(request1))
+		l.Forget(request1)
+	})
+
+	t.Run("Exponential", func(t *testing.T) {
+		l := newRateLimiter(controllercommon.ControllerConfig{
+			RequeueRateLimiter:     controllercommon.RequeueRateLimiterExponential,
+			RequeueBaseDelayMillis: 100,
+			RequeueMaxDelaySecs:    1,
+			RequeueJitterPercent:   0,
+			RequeueQPS:             1000,
+			RequeueBurst:           1000,
+		})
+
+		// Backs off per pod, up to the maximum delay.
+		assert.Equal(t, 100*time.Millisecond, l.When(request1))
+		assert.Equal(t, 200*time.Millisecond, l.When(request1))
+		assert.Equal(t, 400*time.Millisecond, l.When(request1))
+		assert.Equal(t, 800*time.Millisecond, l.When(request1))
+		assert.Equal(t, 1*time.Second, l.When(request1))
+		assert.Equal(t, 100*time.Millisecond, l.When(request2))
+		assert.Equal(t, 5, l.NumRequeues(request1))
+
+		// Backoff starts again once forgotten.
+		l.Forget(request1)
+		assert.Equal(t, 0, l.NumRequeues(request1))
+		assert.Equal(t, 100*time.Millisecond, l.When(request1))
+	})
+
+	t.Run("ExponentialTokenBucket", func(t *testing.T) {
+		l := newRateLimiter(controllercommon.ControllerConfig{
+			RequeueRateLimiter:     controllercommon.RequeueRateLimiterExponential,
+			RequeueBaseDelayMillis: 1,
+			RequeueMaxDelaySecs:    1,
+			RequeueQPS:             1,
+			RequeueBurst:           1,
+		})
+
+		// Burst is exhausted by the first pod, so the second pod waits for the overall rate.
+		assert.Equal(t, 1*time.Millisecond, l.When(request1))
+		assert.Greater(t, l.When(request2), 900*time.Millisecond)
+	})
+}
+
+func TestJitterRateLimiterWhen(t *testing.T) {
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "namespace", Name: "name"}}
+
+	tests := []struct {
+		name           string
+		jitterFraction float64
+		randFloat      float64
+		want           time.Duration
+	}{
+		{"NoJitter", 0, 0.5, 100 * time.Millisecond},
+		{"MinimumJitter", 0.5, 0, 100 * time.Millisecond},
+		{"HalfJitter", 0.5, 0.5, 125 * time.Millisecond},
+		{"MaximumJitter", 1, 1, 200 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &jitterRateLimiter{
+				TypedRateLimiter: workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](
+					100*time.Millisecond,
+					time.Second,
+				),
+				jitterFraction: tt.jitterFraction,
+				randFloat:      func() float64 { return tt.randFloat },
+			}
+			assert.Equal(t, tt.want, l.When(request))
+		})
+	}
+}
+
+func TestNewWorkqueueRateLimiter(t *testing.T) {
+	t.Run("NotExponential", func(t *testing.T) {
+		assert.Nil(t, newWorkqueueRateLimiter(controllercommon.ControllerConfig{
+			RequeueRateLimiter: controllercommon.RequeueRateLimiterFixed,
+		}))
+	})
+
+	t.Run("Exponential", func(t *testing.T) {
+		assert.NotNil(t, newWorkqueueRateLimiter(controllercommon.ControllerConfig{
+			RequeueRateLimiter:     controllercommon.RequeueRateLimiterExponential,
+			RequeueBaseDelayMillis: 100,
+			RequeueMaxDelaySecs:    1,
+			RequeueQPS:             1000,
+			RequeueBurst:           1000,
+		}))
+	})
+}
@@ -20,36 +20,56 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
 	ccontext "github.com/ExpediaGroup/container-startup-autoscaler/internal/context"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/control/controlcommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/reconciler"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/shard/shardcommon"
 	cmap "github.com/orcaman/concurrent-map/v2"
+	"k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+// suppressionRequeueDuration is how long to wait before requeuing a reconcile while scales are suppressed, so that
+// suppressed scales are commanded shortly after suppression ends. Blackout windows have minute precision.
+const suppressionRequeueDuration = 1 * time.Minute
+
 // containerStartupAutoscalerReconciler is the reconcile.Reconciler implementation that Controller-runtime is
 // configured to use.
 type containerStartupAutoscalerReconciler struct {
 	pod              *pod.Pod
+	control          controlcommon.Control
+	shard            shardcommon.Shard
 	controllerConfig controllercommon.ControllerConfig
 	reconcilingPods  cmap.ConcurrentMap[string, any]
+	rateLimiter      workqueue.TypedRateLimiter[reconcile.Request]
 	mutex            sync.Mutex
 }
 
 func newContainerStartupAutoscalerReconciler(
 	pod *pod.Pod,
+	control controlcommon.Control,
+	shard shardcommon.Shard,
 	controllerConfig controllercommon.ControllerConfig,
 ) *containerStartupAutoscalerReconciler {
 	return &containerStartupAutoscalerReconciler{
 		pod:              pod,
+		control:          control,
+		shard:            shard,
 		controllerConfig: controllerConfig,
 		reconcilingPods:  cmap.New[any](),
+		rateLimiter:      newRateLimiter(controllerConfig),
 	}
 }
 
@@ -61,6 +81,25 @@ func (r *containerStartupAutoscalerReconciler) Reconcile(
 	ctx context.Context,
 	request reconcile.Request,
 ) (reconcile.Result, error) {
+	// Double check shard ownership (originally filtered via predicate) as membership may have changed since.
+	if !r.shard.Owns(request.Namespace, request.Name) {
+		logging.Infof(ctx, logging.VDebug, "pod not owned by this shard (won't reconcile)")
+		return reconcile.Result{}, nil
+	}
+
+	// Reconciles that fail are requeued per the rate limiter, which tracks failures per pod until a reconcile doesn't
+	// fail.
+	backedOff := false
+	requeueWithBackoff := func() reconcile.Result {
+		backedOff = true
+		return reconcile.Result{RequeueAfter: r.rateLimiter.When(request)}
+	}
+	defer func() {
+		if !backedOff {
+			r.rateLimiter.Forget(request)
+		}
+	}()
+
 	namespacedName := request.NamespacedName.String()
 
 	// Prevent concurrent reconciles for the same pod to avoid overlap — requeue if necessary. Although
@@ -73,7 +112,7 @@ func (r *containerStartupAutoscalerReconciler) Reconcile(
 		r.mutex.Unlock()
 		logging.Infof(ctx, logging.VDebug, "existing reconcile in progress (will requeue)")
 		reconciler.ExistingInProgress().Inc()
-		return reconcile.Result{RequeueAfter: r.controllerConfig.RequeueDurationSecsDuration()}, nil
+		return requeueWithBackoff(), nil
 	}
 
 	r.reconcilingPods.Set(namespacedName, nil)
@@ -82,6 +121,8 @@ func (r *containerStartupAutoscalerReconciler) Reconcile(
 	// Set context items for standard retry.
 	ctx = ccontext.WithStandardRetryAttempts(ctx, r.controllerConfig.StandardRetryAttempts)
 	ctx = ccontext.WithStandardRetryDelaySecs(ctx, r.controllerConfig.StandardRetryDelaySecs)
+	ctx = ccontext.WithStandardRetryStrategy(ctx, r.controllerConfig.StandardRetryStrategy)
+	ctx = ccontext.WithStandardRetryMaxDelaySecs(ctx, r.controllerConfig.StandardRetryMaxDelaySecs)
 
 	defer r.reconcilingPods.Remove(namespacedName)
 
@@ -92,10 +133,25 @@ func (r *containerStartupAutoscalerReconciler) Reconcile(
 	if err != nil {
 		logging.Errorf(ctx, err, "unable to get pod (will requeue)")
 		reconciler.Failure(reconciler.FailureReasonUnableToGetPod).Inc()
-		return reconcile.Result{RequeueAfter: r.controllerConfig.RequeueDurationSecsDuration()}, nil
+		return requeueWithBackoff(), nil
 	}
 
 	if !podExists {
+		// The pod may have been removed from the informer cache since CSA has been disabled for it (the enabled label
+		// was removed) - in this case, it's handed back.
+		var disabled bool
+		disabled, kubePod, err = r.pod.HandBack.DisabledPod(ctx, request.NamespacedName)
+		if err != nil {
+			logging.Errorf(ctx, err, "unable to determine whether csa disabled for pod (will requeue)")
+			reconciler.Failure(reconciler.FailureReasonUnableToGetPod).Inc()
+			return requeueWithBackoff(), nil
+		}
+
+		if disabled {
+			ctx = ccontext.WithDryRun(ctx, r.isDryRun(kubePod))
+			return r.handBack(ctx, kubePod, requeueWithBackoff)
+		}
+
 		err = errors.New("pod doesn't exist (won't requeue)")
 		logging.Errorf(ctx, err, err.Error())
 		reconciler.Failure(reconciler.FailureReasonPodDoesNotExist).Inc()
@@ -103,7 +159,7 @@ func (r *containerStartupAutoscalerReconciler) Reconcile(
 	}
 
 	// Marshal and log pod only if VTrace - expensive.
-	if logging.CurrentV == logging.VTrace {
+	if logging.CurrentV() == logging.VTrace {
 		var podJson []byte
 		podJson, err = json.Marshal(kubePod)
 		if err != nil {
@@ -113,6 +169,12 @@ func (r *containerStartupAutoscalerReconciler) Reconcile(
 		}
 	}
 
+	ctx = ccontext.WithDryRun(ctx, r.isDryRun(kubePod))
+
+	if r.pod.HandBack.IsDisabled(kubePod) {
+		return r.handBack(ctx, kubePod, requeueWithBackoff)
+	}
+
 	scaleConfigs, err := r.pod.Configuration.Configure(kubePod)
 	if err != nil {
 		msg := "unable to configure pod (won't requeue)"
@@ -141,6 +203,9 @@ func (r *containerStartupAutoscalerReconciler) Reconcile(
 	logging.Infof(ctx, logging.VDebug, "scale configurations: %s", builder.String())
 
 	targetContainer, err := r.pod.Validation.Validate(ctx, kubePod, targetContainerName, scaleConfigs)
+	if result, open := r.circuitBreakerOpenResult(ctx, err); open {
+		return result, nil
+	}
 	if err != nil {
 		msg := "unable to validate pod (won't requeue)"
 		logging.Errorf(ctx, err, msg)
@@ -150,6 +215,9 @@ func (r *containerStartupAutoscalerReconciler) Reconcile(
 
 	// Determine target container states.
 	states, err := r.pod.TargetContainerState.States(ctx, kubePod, targetContainer, scaleConfigs)
+	if result, open := r.circuitBreakerOpenResult(ctx, err); open {
+		return result, nil
+	}
 	if err != nil {
 		msg := "unable to determine target container states (won't requeue)"
 		logging.Errorf(ctx, err, msg)
@@ -158,8 +226,27 @@ func (r *containerStartupAutoscalerReconciler) Reconcile(
 	}
 	ctx = ccontext.WithTargetContainerStates(ctx, states)
 
+	// Determine which scales are currently suppressed cluster-wide (via the kill switch or blackout windows). Requeue
+	// if this can't be determined, rather than risk scaling when it's not permitted.
+	suppression, err := r.control.Suppression(ctx)
+	if err != nil {
+		logging.Errorf(ctx, err, "unable to determine suppression (will requeue)")
+		reconciler.Failure(reconciler.FailureReasonControl).Inc()
+		return requeueWithBackoff(), nil
+	}
+	ctx = ccontext.WithSuppression(ctx, suppression)
+
 	// Execute action for determined target container states.
 	err = r.pod.TargetContainerAction.Execute(ctx, states, kubePod, targetContainer, scaleConfigs)
+	var requeueable pod.RequeueableError
+	if errors.As(err, &requeueable) {
+		// The condition preventing action is expected to clear over time, so retry with backoff.
+		logging.Infof(ctx, logging.VInfo, "%s (will requeue)", requeueable.RequeueReason())
+		return requeueWithBackoff(), nil
+	}
+	if result, open := r.circuitBreakerOpenResult(ctx, err); open {
+		return result, nil
+	}
 	if err != nil {
 		msg := "unable to action target container states (won't requeue)"
 		logging.Errorf(ctx, err, msg)
@@ -167,5 +254,75 @@ func (r *containerStartupAutoscalerReconciler) Reconcile(
 		return reconcile.Result{}, reconcile.TerminalError(common.WrapErrorf(err, msg))
 	}
 
+	if suppression != (controlcommon.Suppression{}) {
+		return reconcile.Result{RequeueAfter: suppressionRequeueDuration}, nil
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// isDryRun returns whether resizes should not be applied for the supplied pod, either because dry-run is configured
+// or the pod's dry-run annotation is 'true'. An annotation value that can't be parsed is treated as dry-run, since this
+// is reported upon during validation.
+func (r *containerStartupAutoscalerReconciler) isDryRun(kubePod *v1.Pod) bool {
+	if r.controllerConfig.DryRun {
+		return true
+	}
+
+	value, present := kubePod.Annotations[kubecommon.AnnotationDryRun]
+	if !present {
+		return false
+	}
+
+	dryRun, err := strconv.ParseBool(value)
+	if err != nil {
+		return true
+	}
+
+	return dryRun
+}
+
+// circuitBreakerOpenResult returns whether err indicates that a Kube API call wasn't made as the circuit breaker is
+// open, along with a result that requeues once the breaker permits a call to probe for recovery. Such errors aren't
+// terminal since the Kube API is expected to recover.
+func (r *containerStartupAutoscalerReconciler) circuitBreakerOpenResult(
+	ctx context.Context,
+	err error,
+) (reconcile.Result, bool) {
+	if !errors.As(err, &kube.CircuitBreakerOpenError{}) {
+		return reconcile.Result{}, false
+	}
+
+	logging.Infof(ctx, logging.VInfo, "kube api circuit breaker open (will requeue)")
+	return reconcile.Result{RequeueAfter: r.controllerConfig.CircuitBreakerOpenDuration()}, true
+}
+
+// handBack hands back the supplied pod, for which CSA has been disabled. requeueWithBackoff is invoked to requeue if the
+// pod can't be handed back. Hand back is requeued without backoff while commanding final resources is suppressed.
+func (r *containerStartupAutoscalerReconciler) handBack(
+	ctx context.Context,
+	kubePod *v1.Pod,
+	requeueWithBackoff func() reconcile.Result,
+) (reconcile.Result, error) {
+	suppression, err := r.control.Suppression(ctx)
+	if err != nil {
+		logging.Errorf(ctx, err, "unable to determine suppression (will requeue)")
+		reconciler.Failure(reconciler.FailureReasonControl).Inc()
+		return requeueWithBackoff(), nil
+	}
+	ctx = ccontext.WithSuppression(ctx, suppression)
+
+	err = r.pod.HandBack.Execute(ctx, kubePod)
+	if errors.As(err, &pod.HandBackSuppressedError{}) {
+		logging.Infof(ctx, logging.VInfo, "%s (will requeue)", err.Error())
+		return reconcile.Result{RequeueAfter: suppressionRequeueDuration}, nil
+	}
+	if err != nil {
+		msg := "unable to hand back pod (will requeue)"
+		logging.Errorf(ctx, err, msg)
+		reconciler.Failure(reconciler.FailureReasonHandBack).Inc()
+		return requeueWithBackoff(), nil
+	}
+
 	return reconcile.Result{}, nil
 }
@@ -22,8 +22,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/context/contexttest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/control/controlcommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/control/controltest"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubetest"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/reconciler"
@@ -31,10 +35,14 @@ import (
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podtest"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/scale/scaletest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/shard/shardcommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/shard/shardtest"
+	"github.com/avast/retry-go/v4"
 	cmap "github.com/orcaman/concurrent-map/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/component-base/metrics/testutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -43,10 +51,15 @@ import (
 func TestNewContainerStartupAutoscalerReconciler(t *testing.T) {
 	p := &pod.Pod{}
 	c := controllercommon.NewControllerConfig()
-	r := newContainerStartupAutoscalerReconciler(p, c)
+	ctrl := controltest.NewMockControl(nil)
+	s := shardtest.NewMockShard(nil)
+	r := newContainerStartupAutoscalerReconciler(p, ctrl, s, c)
 	assert.Equal(t, p, r.pod)
+	assert.Equal(t, ctrl, r.control)
+	assert.Equal(t, s, r.shard)
 	assert.Equal(t, c, r.controllerConfig)
 	assert.NotNil(t, r.reconcilingPods)
+	assert.NotNil(t, r.rateLimiter)
 }
 
 func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
@@ -58,7 +71,10 @@ func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
 		validation            podcommon.Validation
 		targetContainerState  podcommon.TargetContainerState
 		targetContainerAction podcommon.TargetContainerAction
+		handBack              podcommon.HandBack
 		podHelper             kubecommon.PodHelper
+		control               controlcommon.Control
+		shard                 shardcommon.Shard
 	}
 	tests := []struct {
 		name                    string
@@ -72,6 +88,22 @@ func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
 		wantEmptyMap            bool
 		configMetricAssertsFunc func(t *testing.T)
 	}{
+		{
+			"NotOwnedByShard",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{controllercommon.ControllerConfig{RequeueDurationSecs: 10}},
+			mocks{
+				shard: shardtest.NewMockShard(func(m *shardtest.MockShard) {
+					m.On("Owns", "podNamespace", "name").Return(false)
+				}),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{},
+			true,
+			nil,
+		},
 		{
 			"ExistingReconcileInProgress",
 			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {
@@ -108,11 +140,55 @@ func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
 				assert.Equal(t, float64(1), metricVal)
 			},
 		},
+		{
+			"UnableToDetermineWhetherDisabled",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{controllercommon.ControllerConfig{RequeueDurationSecs: 10}},
+			mocks{
+				handBack: podtest.NewMockHandBack(func(m *podtest.MockHandBack) {
+					m.On("DisabledPod", mock.Anything, mock.Anything).Return(false, nil, errors.New(""))
+				}),
+				podHelper: kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {
+					m.On("Get", mock.Anything, mock.Anything).Return(false, &v1.Pod{}, nil)
+				}),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{RequeueAfter: 10 * time.Second},
+			true,
+			func(t *testing.T) {
+				metricVal, _ := testutil.GetCounterMetricValue(reconciler.Failure(reconciler.FailureReasonUnableToGetPod))
+				assert.Equal(t, float64(2), metricVal)
+			},
+		},
+		{
+			"PodDoesntExistDisabledHandBack",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{},
+			mocks{
+				handBack: podtest.NewMockHandBack(func(m *podtest.MockHandBack) {
+					m.On("DisabledPod", mock.Anything, mock.Anything).Return(true, &v1.Pod{}, nil)
+					m.ExecuteDefault()
+				}),
+				podHelper: kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {
+					m.On("Get", mock.Anything, mock.Anything).Return(false, &v1.Pod{}, nil)
+				}),
+				control: controltest.NewMockControl(nil),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{},
+			true,
+			nil,
+		},
 		{
 			"PodDoesntExist",
 			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
 			fields{},
 			mocks{
+				handBack: podtest.NewMockHandBack(nil),
 				podHelper: kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {
 					m.On("Get", mock.Anything, mock.Anything).Return(false, &v1.Pod{}, nil)
 				}),
@@ -127,6 +203,91 @@ func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
 				assert.Equal(t, float64(1), metricVal)
 			},
 		},
+		{
+			"DisabledUnableToHandBack",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{controllercommon.ControllerConfig{RequeueDurationSecs: 10}},
+			mocks{
+				handBack: podtest.NewMockHandBack(func(m *podtest.MockHandBack) {
+					m.On("IsDisabled", mock.Anything).Return(true)
+					m.On("Execute", mock.Anything, mock.Anything).Return(errors.New(""))
+				}),
+				podHelper: kubetest.NewMockPodHelper(nil),
+				control:   controltest.NewMockControl(nil),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{RequeueAfter: 10 * time.Second},
+			true,
+			func(t *testing.T) {
+				metricVal, _ := testutil.GetCounterMetricValue(reconciler.Failure(reconciler.FailureReasonHandBack))
+				assert.Equal(t, float64(1), metricVal)
+			},
+		},
+		{
+			"DisabledUnableToDetermineSuppression",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{controllercommon.ControllerConfig{RequeueDurationSecs: 10}},
+			mocks{
+				handBack: podtest.NewMockHandBack(func(m *podtest.MockHandBack) {
+					m.On("IsDisabled", mock.Anything).Return(true)
+				}),
+				podHelper: kubetest.NewMockPodHelper(nil),
+				control: controltest.NewMockControl(func(m *controltest.MockControl) {
+					m.On("Suppression", mock.Anything).Return(controlcommon.Suppression{}, errors.New(""))
+				}),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{RequeueAfter: 10 * time.Second},
+			true,
+			func(t *testing.T) {
+				metricVal, _ := testutil.GetCounterMetricValue(reconciler.Failure(reconciler.FailureReasonControl))
+				assert.Equal(t, float64(1), metricVal)
+			},
+		},
+		{
+			"DisabledHandBackSuppressed",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{},
+			mocks{
+				handBack: podtest.NewMockHandBack(func(m *podtest.MockHandBack) {
+					m.On("IsDisabled", mock.Anything).Return(true)
+					m.On("Execute", mock.Anything, mock.Anything).Return(pod.NewHandBackSuppressedError(""))
+				}),
+				podHelper: kubetest.NewMockPodHelper(nil),
+				control: controltest.NewMockControl(func(m *controltest.MockControl) {
+					m.On("Suppression", mock.Anything).Return(controlcommon.NewSuppression(true, false, false), nil)
+				}),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{RequeueAfter: suppressionRequeueDuration},
+			true,
+			nil,
+		},
+		{
+			"DisabledHandBack",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{},
+			mocks{
+				handBack: podtest.NewMockHandBack(func(m *podtest.MockHandBack) {
+					m.On("IsDisabled", mock.Anything).Return(true)
+					m.ExecuteDefault()
+				}),
+				podHelper: kubetest.NewMockPodHelper(nil),
+				control:   controltest.NewMockControl(nil),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{},
+			true,
+			nil,
+		},
 		{
 			"UnableToConfigurePod",
 			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
@@ -135,6 +296,7 @@ func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
 				configuration: podtest.NewMockConfiguration(func(m *podtest.MockConfiguration) {
 					m.On("Configure", mock.Anything).Return(scaletest.NewMockConfigurations(nil), errors.New(""))
 				}),
+				handBack:  podtest.NewMockHandBack(nil),
 				podHelper: kubetest.NewMockPodHelper(nil),
 			},
 			"podNamespace",
@@ -160,6 +322,7 @@ func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
 						nil,
 					)
 				}),
+				handBack:  podtest.NewMockHandBack(nil),
 				podHelper: kubetest.NewMockPodHelper(nil),
 			},
 			"podNamespace",
@@ -182,6 +345,7 @@ func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
 					m.On("Validate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 						Return(&v1.Container{}, errors.New(""))
 				}),
+				handBack:  podtest.NewMockHandBack(nil),
 				podHelper: kubetest.NewMockPodHelper(nil),
 			},
 			"podNamespace",
@@ -194,6 +358,26 @@ func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
 				assert.Equal(t, float64(1), metricVal)
 			},
 		},
+		{
+			"CircuitBreakerOpenUponValidatePod",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{controllercommon.ControllerConfig{CircuitBreakerOpenSecs: 30}},
+			mocks{
+				configuration: podtest.NewMockConfiguration(nil),
+				validation: podtest.NewMockValidation(func(m *podtest.MockValidation) {
+					m.On("Validate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+						Return(&v1.Container{}, common.WrapErrorf(retry.Unrecoverable(kube.NewCircuitBreakerOpenError()), ""))
+				}),
+				handBack:  podtest.NewMockHandBack(nil),
+				podHelper: kubetest.NewMockPodHelper(nil),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{RequeueAfter: 30 * time.Second},
+			true,
+			nil,
+		},
 		{
 			"UnableToDetermineTargetContainerStates",
 			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
@@ -205,6 +389,7 @@ func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
 					m.On("States", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 						Return(podcommon.States{}, errors.New(""))
 				}),
+				handBack:  podtest.NewMockHandBack(nil),
 				podHelper: kubetest.NewMockPodHelper(nil),
 			},
 			"podNamespace",
@@ -217,6 +402,30 @@ func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
 				assert.Equal(t, float64(1), metricVal)
 			},
 		},
+		{
+			"UnableToDetermineSuppression",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{controllercommon.ControllerConfig{RequeueDurationSecs: 10}},
+			mocks{
+				configuration:        podtest.NewMockConfiguration(nil),
+				validation:           podtest.NewMockValidation(nil),
+				targetContainerState: podtest.NewMockTargetContainerState(nil),
+				handBack:             podtest.NewMockHandBack(nil),
+				podHelper:            kubetest.NewMockPodHelper(nil),
+				control: controltest.NewMockControl(func(m *controltest.MockControl) {
+					m.On("Suppression", mock.Anything).Return(controlcommon.Suppression{}, errors.New(""))
+				}),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{RequeueAfter: 10 * time.Second},
+			true,
+			func(t *testing.T) {
+				metricVal, _ := testutil.GetCounterMetricValue(reconciler.Failure(reconciler.FailureReasonControl))
+				assert.Equal(t, float64(2), metricVal)
+			},
+		},
 		{
 			"UnableToActionTargetContainerStates",
 			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
@@ -229,7 +438,9 @@ func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
 					m.On("Execute", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 						Return(errors.New(""))
 				}),
+				handBack:  podtest.NewMockHandBack(nil),
 				podHelper: kubetest.NewMockPodHelper(nil),
+				control:   controltest.NewMockControl(nil),
 			},
 			"podNamespace",
 			"name",
@@ -241,6 +452,189 @@ func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
 				assert.Equal(t, float64(1), metricVal)
 			},
 		},
+		{
+			"CircuitBreakerOpenUponActionTargetContainerStates",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{controllercommon.ControllerConfig{CircuitBreakerOpenSecs: 30}},
+			mocks{
+				configuration:        podtest.NewMockConfiguration(nil),
+				validation:           podtest.NewMockValidation(nil),
+				targetContainerState: podtest.NewMockTargetContainerState(nil),
+				targetContainerAction: podtest.NewMockTargetContainerAction(func(m *podtest.MockTargetContainerAction) {
+					m.On("Execute", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+						Return(common.WrapErrorf(retry.Unrecoverable(kube.NewCircuitBreakerOpenError()), ""))
+				}),
+				handBack:  podtest.NewMockHandBack(nil),
+				podHelper: kubetest.NewMockPodHelper(nil),
+				control:   controltest.NewMockControl(nil),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{RequeueAfter: 30 * time.Second},
+			true,
+			nil,
+		},
+		{
+			"InsufficientNodeCapacity",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{controllercommon.ControllerConfig{RequeueDurationSecs: 10}},
+			mocks{
+				configuration:        podtest.NewMockConfiguration(nil),
+				validation:           podtest.NewMockValidation(nil),
+				targetContainerState: podtest.NewMockTargetContainerState(nil),
+				targetContainerAction: podtest.NewMockTargetContainerAction(func(m *podtest.MockTargetContainerAction) {
+					m.On("Execute", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+						Return(pod.NewInsufficientNodeCapacityError(""))
+				}),
+				handBack:  podtest.NewMockHandBack(nil),
+				podHelper: kubetest.NewMockPodHelper(nil),
+				control:   controltest.NewMockControl(nil),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{RequeueAfter: 10 * time.Second},
+			true,
+			nil,
+		},
+		{
+			"StartupSurplusBudgetExceeded",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{controllercommon.ControllerConfig{RequeueDurationSecs: 10}},
+			mocks{
+				configuration:        podtest.NewMockConfiguration(nil),
+				validation:           podtest.NewMockValidation(nil),
+				targetContainerState: podtest.NewMockTargetContainerState(nil),
+				targetContainerAction: podtest.NewMockTargetContainerAction(func(m *podtest.MockTargetContainerAction) {
+					m.On("Execute", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+						Return(pod.NewStartupSurplusBudgetExceededError(""))
+				}),
+				handBack:  podtest.NewMockHandBack(nil),
+				podHelper: kubetest.NewMockPodHelper(nil),
+				control:   controltest.NewMockControl(nil),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{RequeueAfter: 10 * time.Second},
+			true,
+			nil,
+		},
+		{
+			"NodeUpscaleDeferred",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{controllercommon.ControllerConfig{RequeueDurationSecs: 10}},
+			mocks{
+				configuration:        podtest.NewMockConfiguration(nil),
+				validation:           podtest.NewMockValidation(nil),
+				targetContainerState: podtest.NewMockTargetContainerState(nil),
+				targetContainerAction: podtest.NewMockTargetContainerAction(func(m *podtest.MockTargetContainerAction) {
+					m.On("Execute", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+						Return(pod.NewNodeUpscaleDeferredError(""))
+				}),
+				handBack:  podtest.NewMockHandBack(nil),
+				podHelper: kubetest.NewMockPodHelper(nil),
+				control:   controltest.NewMockControl(nil),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{RequeueAfter: 10 * time.Second},
+			true,
+			nil,
+		},
+		{
+			"WorkloadStartupLimitReached",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{controllercommon.ControllerConfig{RequeueDurationSecs: 10}},
+			mocks{
+				configuration:        podtest.NewMockConfiguration(nil),
+				validation:           podtest.NewMockValidation(nil),
+				targetContainerState: podtest.NewMockTargetContainerState(nil),
+				targetContainerAction: podtest.NewMockTargetContainerAction(func(m *podtest.MockTargetContainerAction) {
+					m.On("Execute", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+						Return(pod.NewWorkloadStartupLimitReachedError(""))
+				}),
+				handBack:  podtest.NewMockHandBack(nil),
+				podHelper: kubetest.NewMockPodHelper(nil),
+				control:   controltest.NewMockControl(nil),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{RequeueAfter: 10 * time.Second},
+			true,
+			nil,
+		},
+		{
+			"InsufficientResourceQuota",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{controllercommon.ControllerConfig{RequeueDurationSecs: 10}},
+			mocks{
+				configuration:        podtest.NewMockConfiguration(nil),
+				validation:           podtest.NewMockValidation(nil),
+				targetContainerState: podtest.NewMockTargetContainerState(nil),
+				targetContainerAction: podtest.NewMockTargetContainerAction(func(m *podtest.MockTargetContainerAction) {
+					m.On("Execute", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+						Return(pod.NewInsufficientResourceQuotaError(""))
+				}),
+				handBack:  podtest.NewMockHandBack(nil),
+				podHelper: kubetest.NewMockPodHelper(nil),
+				control:   controltest.NewMockControl(nil),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{RequeueAfter: 10 * time.Second},
+			true,
+			nil,
+		},
+		{
+			"EvictionFallbackPending",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{controllercommon.ControllerConfig{RequeueDurationSecs: 10}},
+			mocks{
+				configuration:        podtest.NewMockConfiguration(nil),
+				validation:           podtest.NewMockValidation(nil),
+				targetContainerState: podtest.NewMockTargetContainerState(nil),
+				targetContainerAction: podtest.NewMockTargetContainerAction(func(m *podtest.MockTargetContainerAction) {
+					m.On("Execute", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+						Return(pod.NewEvictionFallbackPendingError(""))
+				}),
+				handBack:  podtest.NewMockHandBack(nil),
+				podHelper: kubetest.NewMockPodHelper(nil),
+				control:   controltest.NewMockControl(nil),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{RequeueAfter: 10 * time.Second},
+			true,
+			nil,
+		},
+		{
+			"OkSuppressed",
+			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
+			fields{},
+			mocks{
+				configuration:         podtest.NewMockConfiguration(nil),
+				validation:            podtest.NewMockValidation(nil),
+				targetContainerState:  podtest.NewMockTargetContainerState(nil),
+				targetContainerAction: podtest.NewMockTargetContainerAction(nil),
+				handBack:              podtest.NewMockHandBack(nil),
+				podHelper:             kubetest.NewMockPodHelper(nil),
+				control: controltest.NewMockControl(func(m *controltest.MockControl) {
+					m.On("Suppression", mock.Anything).Return(controlcommon.NewSuppression(true, false, false), nil)
+				}),
+			},
+			"podNamespace",
+			"name",
+			"",
+			reconcile.Result{RequeueAfter: suppressionRequeueDuration},
+			true,
+			nil,
+		},
 		{
 			"Ok",
 			func(cmap cmap.ConcurrentMap[string, any], podNamespacedName string) {},
@@ -250,7 +644,9 @@ func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
 				validation:            podtest.NewMockValidation(nil),
 				targetContainerState:  podtest.NewMockTargetContainerState(nil),
 				targetContainerAction: podtest.NewMockTargetContainerAction(nil),
+				handBack:              podtest.NewMockHandBack(nil),
 				podHelper:             kubetest.NewMockPodHelper(nil),
+				control:               controltest.NewMockControl(nil),
 			},
 			"podNamespace",
 			"name",
@@ -276,12 +672,20 @@ func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
 				Validation:            tt.mocks.validation,
 				TargetContainerState:  tt.mocks.targetContainerState,
 				TargetContainerAction: tt.mocks.targetContainerAction,
+				HandBack:              tt.mocks.handBack,
 				PodHelper:             tt.mocks.podHelper,
 			}
+			s := tt.mocks.shard
+			if s == nil {
+				s = shardtest.NewMockShard(nil)
+			}
 			r := &containerStartupAutoscalerReconciler{
 				pod:              p,
+				control:          tt.mocks.control,
+				shard:            s,
 				controllerConfig: tt.fields.controllerConfig,
 				reconcilingPods:  c,
+				rateLimiter:      newRateLimiter(tt.fields.controllerConfig),
 			}
 
 			buffer := &bytes.Buffer{}
@@ -301,3 +705,63 @@ func TestContainerStartupAutoscalerReconcilerReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestContainerStartupAutoscalerReconcilerReconcileBackoffGrows(t *testing.T) {
+	config := controllercommon.ControllerConfig{
+		RequeueRateLimiter:     controllercommon.RequeueRateLimiterExponential,
+		RequeueBaseDelayMillis: 100,
+		RequeueMaxDelaySecs:    10,
+		RequeueJitterPercent:   0,
+		RequeueQPS:             1000,
+		RequeueBurst:           1000,
+	}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "namespace", Name: "name"}}
+	reconcilingPods := cmap.New[any]()
+	reconcilingPods.Set(request.NamespacedName.String(), nil) // Causes backoff as an existing reconcile is in progress.
+	r := newContainerStartupAutoscalerReconciler(&pod.Pod{}, nil, shardtest.NewMockShard(nil), config)
+	r.reconcilingPods = reconcilingPods
+	workqueueRateLimiter := newWorkqueueRateLimiter(config)
+
+	var delays []time.Duration
+	for range 4 {
+		got, err := r.Reconcile(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), request)
+		assert.NoError(t, err)
+		delays = append(delays, got.RequeueAfter)
+
+		// controller-runtime forgets the request within the workqueue upon requeuing after a delay.
+		workqueueRateLimiter.Forget(request)
+	}
+
+	assert.Equal(
+		t,
+		[]time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond},
+		delays,
+	)
+}
+
+func TestContainerStartupAutoscalerReconcilerIsDryRun(t *testing.T) {
+	tests := []struct {
+		name             string
+		controllerConfig controllercommon.ControllerConfig
+		annotations      map[string]string
+		want             bool
+	}{
+		{"ConfiguredTrue", controllercommon.ControllerConfig{DryRun: true}, nil, true},
+		{"NoAnnotation", controllercommon.ControllerConfig{}, nil, false},
+		{"AnnotationInvalid", controllercommon.ControllerConfig{}, map[string]string{kubecommon.AnnotationDryRun: "test"}, true},
+		{"AnnotationTrue", controllercommon.ControllerConfig{}, map[string]string{kubecommon.AnnotationDryRun: "true"}, true},
+		{"AnnotationFalse", controllercommon.ControllerConfig{}, map[string]string{kubecommon.AnnotationDryRun: "false"}, false},
+		{
+			"ConfiguredTrueAnnotationFalse",
+			controllercommon.ControllerConfig{DryRun: true},
+			map[string]string{kubecommon.AnnotationDryRun: "false"},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newContainerStartupAutoscalerReconciler(&pod.Pod{}, nil, nil, tt.controllerConfig)
+			assert.Equal(t, tt.want, r.isDryRun(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}))
+		})
+	}
+}
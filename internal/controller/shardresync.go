@@ -0,0 +1,69 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/shard/shardcommon"
+	"k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// shardResync enqueues pods owned by this replica upon shard rebalance, so that pods that become owned aren't left
+// unreconciled until they next change.
+type shardResync struct {
+	reader client.Reader
+	shard  shardcommon.Shard
+	events chan event.TypedGenericEvent[*v1.Pod]
+}
+
+func newShardResync(reader client.Reader) *shardResync {
+	return &shardResync{
+		reader: reader,
+		events: make(chan event.TypedGenericEvent[*v1.Pod]),
+	}
+}
+
+// trigger asynchronously sends generic events for all owned pods.
+func (r *shardResync) trigger() {
+	go r.resync()
+}
+
+// resync sends generic events for all owned pods. Sends block until the events are consumed by the controller.
+func (r *shardResync) resync() {
+	pods := &v1.PodList{}
+	if err := r.reader.List(context.Background(), pods); err != nil {
+		logging.Errorf(nil, err, "unable to list pods upon shard rebalance (pods will be reconciled upon next change)")
+		return
+	}
+
+	count := 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !r.shard.Owns(pod.Namespace, pod.Name) {
+			continue
+		}
+
+		r.events <- event.TypedGenericEvent[*v1.Pod]{Object: pod}
+		count++
+	}
+
+	logging.Infof(nil, logging.VDebug, "enqueued %d owned pods upon shard rebalance", count)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/shard/shardtest"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestNewShardResync(t *testing.T) {
+	c := fake.NewClientBuilder().Build()
+	r := newShardResync(c)
+	assert.Equal(t, c, r.reader)
+	assert.NotNil(t, r.events)
+}
+
+func TestShardResyncTrigger(t *testing.T) {
+	c := fake.NewClientBuilder().WithObjects(
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "owned"}},
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "notowned"}},
+	).Build()
+	r := newShardResync(c)
+	r.shard = shardtest.NewMockShard(func(m *shardtest.MockShard) {
+		m.On("Owns", "namespace", "owned").Return(true)
+		m.On("Owns", "namespace", "notowned").Return(false)
+	})
+
+	r.trigger()
+
+	select {
+	case got := <-r.events:
+		assert.Equal(t, "owned", got.Object.Name)
+	case <-time.After(time.Second):
+		assert.Fail(t, "no event")
+	}
+
+	select {
+	case got := <-r.events:
+		assert.Fail(t, "unexpected event", got.Object.Name)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestShardResyncResync(t *testing.T) {
+	t.Run("UnableToListPods", func(t *testing.T) {
+		c := fake.NewClientBuilder().
+			WithInterceptorFuncs(interceptor.Funcs{
+				List: func(_ context.Context, _ client.WithWatch, _ client.ObjectList, _ ...client.ListOption) error {
+					return errors.New("")
+				},
+			}).
+			Build()
+		r := newShardResync(c)
+
+		buffer := &bytes.Buffer{}
+		logging.Init(buffer, logging.VTrace, false)
+		r.resync()
+		assert.Contains(t, buffer.String(), "unable to list pods upon shard rebalance")
+	})
+}
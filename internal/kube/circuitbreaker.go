@@ -0,0 +1,228 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	metricscircuitbreaker "github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/circuitbreaker"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+var allCircuitBreakerStates = []kubecommon.CircuitBreakerState{
+	kubecommon.CircuitBreakerStateClosed,
+	kubecommon.CircuitBreakerStateOpen,
+	kubecommon.CircuitBreakerStateHalfOpen,
+}
+
+// circuitBreaker is the default implementation of kubecommon.CircuitBreaker. While closed, outcomes of Kube API calls
+// are recorded within a fixed window and the breaker opens if the failure rate reaches failureRatePercent (once at
+// least minRequests have been recorded). While open, calls are failed fast. After openDuration, the breaker half-opens
+// and permits a single call to probe for recovery: it closes if the call succeeds, otherwise it opens again.
+type circuitBreaker struct {
+	failureRatePercent int
+	minRequests        int
+	window             time.Duration
+	openDuration       time.Duration
+	now                func() time.Time
+
+	mutex       sync.Mutex
+	state       kubecommon.CircuitBreakerState
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	probing     bool
+}
+
+func NewCircuitBreaker(
+	failureRatePercent int,
+	minRequests int,
+	window time.Duration,
+	openDuration time.Duration,
+) kubecommon.CircuitBreaker {
+	b := &circuitBreaker{
+		failureRatePercent: failureRatePercent,
+		minRequests:        minRequests,
+		window:             window,
+		openDuration:       openDuration,
+		now:                time.Now,
+	}
+	b.transition(kubecommon.CircuitBreakerStateClosed)
+
+	return b
+}
+
+// Allow returns an error if a Kube API call should not be made as the breaker is open, or half-open with a probing call
+// already in progress. Otherwise, the outcome of the call must be subsequently recorded via Record.
+func (b *circuitBreaker) Allow() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.state {
+	case kubecommon.CircuitBreakerStateOpen:
+		if b.now().Sub(b.openedAt) < b.openDuration {
+			metricscircuitbreaker.Rejected().Inc()
+			return NewCircuitBreakerOpenError()
+		}
+
+		b.transition(kubecommon.CircuitBreakerStateHalfOpen)
+		b.probing = true
+	case kubecommon.CircuitBreakerStateHalfOpen:
+		if b.probing {
+			metricscircuitbreaker.Rejected().Inc()
+			return NewCircuitBreakerOpenError()
+		}
+
+		b.probing = true
+	}
+
+	return nil
+}
+
+// Record records the outcome of a Kube API call that was previously allowed via Allow. Only errors that indicate the
+// Kube API is degraded are considered failures.
+func (b *circuitBreaker) Record(err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	failure := isKubeApiDegradedError(err)
+
+	switch b.state {
+	case kubecommon.CircuitBreakerStateClosed:
+		if b.now().Sub(b.windowStart) >= b.window {
+			b.resetWindow()
+		}
+
+		b.requests++
+		if failure {
+			b.failures++
+		}
+
+		if b.requests >= b.minRequests && b.failures*100 >= b.failureRatePercent*b.requests {
+			b.open()
+		}
+	case kubecommon.CircuitBreakerStateHalfOpen:
+		b.probing = false
+
+		if failure {
+			b.open()
+			return
+		}
+
+		logging.Infof(nil, logging.VInfo, "kube api circuit breaker closed")
+		b.resetWindow()
+		b.transition(kubecommon.CircuitBreakerStateClosed)
+	}
+}
+
+// State returns the current state of the breaker.
+func (b *circuitBreaker) State() kubecommon.CircuitBreakerState {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.state
+}
+
+// ReadyzCheck is a controller-runtime healthz.Checker that reports not ready while the breaker is open.
+func (b *circuitBreaker) ReadyzCheck(_ *http.Request) error {
+	if b.State() == kubecommon.CircuitBreakerStateOpen {
+		return fmt.Errorf("kube api %s", NewCircuitBreakerOpenError().Error())
+	}
+
+	return nil
+}
+
+// open transitions to the open state. The mutex must be held.
+func (b *circuitBreaker) open() {
+	logging.Infof(
+		nil, logging.VInfo,
+		"kube api circuit breaker opened (%d of %d calls failed)", b.failures, b.requests,
+	)
+	metricscircuitbreaker.Opened().Inc()
+	b.openedAt = b.now()
+	b.transition(kubecommon.CircuitBreakerStateOpen)
+}
+
+// resetWindow starts a new window. The mutex must be held.
+func (b *circuitBreaker) resetWindow() {
+	b.windowStart = b.now()
+	b.requests = 0
+	b.failures = 0
+}
+
+// transition sets the state, reflecting it within metrics. The mutex must be held.
+func (b *circuitBreaker) transition(state kubecommon.CircuitBreakerState) {
+	b.state = state
+
+	for _, s := range allCircuitBreakerStates {
+		value := float64(0)
+		if s == state {
+			value = 1
+		}
+		metricscircuitbreaker.State(string(s)).Set(value)
+	}
+}
+
+// disabledCircuitBreaker is a kubecommon.CircuitBreaker that never opens.
+type disabledCircuitBreaker struct{}
+
+func NewDisabledCircuitBreaker() kubecommon.CircuitBreaker {
+	return disabledCircuitBreaker{}
+}
+
+// Allow always returns nil.
+func (disabledCircuitBreaker) Allow() error {
+	return nil
+}
+
+// Record does nothing.
+func (disabledCircuitBreaker) Record(_ error) {}
+
+// State always returns kubecommon.CircuitBreakerStateClosed.
+func (disabledCircuitBreaker) State() kubecommon.CircuitBreakerState {
+	return kubecommon.CircuitBreakerStateClosed
+}
+
+// ReadyzCheck always returns nil.
+func (disabledCircuitBreaker) ReadyzCheck(_ *http.Request) error {
+	return nil
+}
+
+// isKubeApiDegradedError returns whether err indicates that the Kube API is degraded: a server error, throttling, a
+// timeout, or no response at all. Errors returned by a responsive Kube API (such as 'not found', 'conflict' or an
+// eviction blocked by a pod disruption budget) don't.
+func isKubeApiDegradedError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || IsEvictionBlockedError(err) {
+		return false
+	}
+
+	var stat kerrors.APIStatus
+	if !errors.As(err, &stat) {
+		return true
+	}
+
+	code := stat.Status().Code
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
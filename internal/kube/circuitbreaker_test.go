@@ -0,0 +1,207 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/stretchr/testify/assert"
+	policyv1 "k8s.io/api/policy/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestNewCircuitBreaker(t *testing.T) {
+	b := NewCircuitBreaker(50, 10, time.Second, 2*time.Second).(*circuitBreaker)
+	assert.Equal(t, 50, b.failureRatePercent)
+	assert.Equal(t, 10, b.minRequests)
+	assert.Equal(t, time.Second, b.window)
+	assert.Equal(t, 2*time.Second, b.openDuration)
+	assert.NotNil(t, b.now)
+	assert.Equal(t, kubecommon.CircuitBreakerStateClosed, b.State())
+}
+
+func TestCircuitBreakerAllowAndRecord(t *testing.T) {
+	degradedErr := kerrors.NewInternalError(errors.New(""))
+
+	newBreaker := func(now *time.Time) *circuitBreaker {
+		b := NewCircuitBreaker(50, 4, 10*time.Second, 5*time.Second).(*circuitBreaker)
+		b.now = func() time.Time { return *now }
+		b.resetWindow()
+		return b
+	}
+
+	record := func(b *circuitBreaker, errs ...error) {
+		for _, err := range errs {
+			assert.NoError(t, b.Allow())
+			b.Record(err)
+		}
+	}
+
+	t.Run("StaysClosedBelowMinRequests", func(t *testing.T) {
+		now := time.Now()
+		b := newBreaker(&now)
+
+		record(b, degradedErr, degradedErr, degradedErr)
+		assert.Equal(t, kubecommon.CircuitBreakerStateClosed, b.State())
+	})
+
+	t.Run("StaysClosedBelowFailureRate", func(t *testing.T) {
+		now := time.Now()
+		b := newBreaker(&now)
+
+		record(b, degradedErr, nil, nil, nil)
+		assert.Equal(t, kubecommon.CircuitBreakerStateClosed, b.State())
+	})
+
+	t.Run("StaysClosedWithNonDegradedErrors", func(t *testing.T) {
+		now := time.Now()
+		b := newBreaker(&now)
+		notFoundErr := kerrors.NewNotFound(schema.GroupResource{}, "")
+
+		record(b, notFoundErr, notFoundErr, notFoundErr, notFoundErr)
+		assert.Equal(t, kubecommon.CircuitBreakerStateClosed, b.State())
+	})
+
+	t.Run("OpensAtFailureRate", func(t *testing.T) {
+		now := time.Now()
+		b := newBreaker(&now)
+
+		record(b, degradedErr, nil, degradedErr, nil)
+		assert.Equal(t, kubecommon.CircuitBreakerStateOpen, b.State())
+	})
+
+	t.Run("WindowResets", func(t *testing.T) {
+		now := time.Now()
+		b := newBreaker(&now)
+
+		record(b, degradedErr, degradedErr, degradedErr)
+		now = now.Add(10 * time.Second)
+		record(b, degradedErr)
+		assert.Equal(t, kubecommon.CircuitBreakerStateClosed, b.State())
+		assert.Equal(t, 1, b.requests)
+		assert.Equal(t, 1, b.failures)
+	})
+
+	t.Run("RejectsWhileOpen", func(t *testing.T) {
+		now := time.Now()
+		b := newBreaker(&now)
+
+		record(b, degradedErr, degradedErr, degradedErr, degradedErr)
+		now = now.Add(4 * time.Second)
+		err := b.Allow()
+		assert.ErrorAs(t, err, &CircuitBreakerOpenError{})
+		assert.Equal(t, kubecommon.CircuitBreakerStateOpen, b.State())
+	})
+
+	t.Run("HalfOpenPermitsSingleProbe", func(t *testing.T) {
+		now := time.Now()
+		b := newBreaker(&now)
+
+		record(b, degradedErr, degradedErr, degradedErr, degradedErr)
+		now = now.Add(5 * time.Second)
+		assert.NoError(t, b.Allow())
+		assert.Equal(t, kubecommon.CircuitBreakerStateHalfOpen, b.State())
+		assert.ErrorAs(t, b.Allow(), &CircuitBreakerOpenError{})
+	})
+
+	t.Run("HalfOpenProbeSucceeds", func(t *testing.T) {
+		now := time.Now()
+		b := newBreaker(&now)
+
+		record(b, degradedErr, degradedErr, degradedErr, degradedErr)
+		now = now.Add(5 * time.Second)
+		record(b, nil)
+		assert.Equal(t, kubecommon.CircuitBreakerStateClosed, b.State())
+		assert.Equal(t, 0, b.requests)
+		assert.Equal(t, 0, b.failures)
+		assert.NoError(t, b.Allow())
+	})
+
+	t.Run("HalfOpenProbeFails", func(t *testing.T) {
+		now := time.Now()
+		b := newBreaker(&now)
+
+		record(b, degradedErr, degradedErr, degradedErr, degradedErr)
+		now = now.Add(5 * time.Second)
+		record(b, degradedErr)
+		assert.Equal(t, kubecommon.CircuitBreakerStateOpen, b.State())
+		assert.ErrorAs(t, b.Allow(), &CircuitBreakerOpenError{})
+	})
+}
+
+func TestCircuitBreakerReadyzCheck(t *testing.T) {
+	t.Run("Closed", func(t *testing.T) {
+		b := NewCircuitBreaker(50, 1, time.Second, time.Second)
+		assert.NoError(t, b.ReadyzCheck(nil))
+	})
+
+	t.Run("Open", func(t *testing.T) {
+		b := NewCircuitBreaker(50, 1, time.Second, time.Second)
+		assert.NoError(t, b.Allow())
+		b.Record(errors.New(""))
+		assert.ErrorContains(t, b.ReadyzCheck(nil), "kube api circuit breaker open")
+	})
+}
+
+func TestNewDisabledCircuitBreaker(t *testing.T) {
+	b := NewDisabledCircuitBreaker()
+	assert.Equal(t, disabledCircuitBreaker{}, b)
+}
+
+func TestDisabledCircuitBreaker(t *testing.T) {
+	b := NewDisabledCircuitBreaker()
+	for range 10 {
+		assert.NoError(t, b.Allow())
+		b.Record(errors.New(""))
+	}
+	assert.Equal(t, kubecommon.CircuitBreakerStateClosed, b.State())
+	assert.NoError(t, b.ReadyzCheck(nil))
+}
+
+func TestIsKubeApiDegradedError(t *testing.T) {
+	evictionBlockedErr := kerrors.NewTooManyRequests("", 0)
+	evictionBlockedErr.ErrStatus.Details.Causes = []metav1.StatusCause{{Type: policyv1.DisruptionBudgetCause}}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"Nil", nil, false},
+		{"ContextCanceled", fmt.Errorf("wrapped: %w", context.Canceled), false},
+		{"NoResponse", errors.New(""), true},
+		{"NotFound", kerrors.NewNotFound(schema.GroupResource{}, ""), false},
+		{"Conflict", kerrors.NewConflict(schema.GroupResource{}, "", errors.New("")), false},
+		{"TooManyRequests", kerrors.NewTooManyRequests("", 1), true},
+		{"EvictionBlocked", evictionBlockedErr, false},
+		{"InternalError", kerrors.NewInternalError(errors.New("")), true},
+		{"ServiceUnavailable", kerrors.NewServiceUnavailable(""), true},
+		{"Timeout", kerrors.NewTimeoutError("", 1), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isKubeApiDegradedError(tt.err))
+		})
+	}
+}
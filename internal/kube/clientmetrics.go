@@ -0,0 +1,82 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	metricskubeclient "github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/kubeclient"
+	"github.com/prometheus/client_golang/prometheus"
+	clientmetrics "k8s.io/client-go/tools/metrics"
+)
+
+// nonResource is the resource reported for requests that don't target a Kube API resource (e.g. discovery).
+const nonResource = "non-resource"
+
+// RegisterClientMetrics hooks client-go so that Kube API request latency and client-side rate limiter wait time are
+// reported via metrics. client-go's hooks are set directly since clientmetrics.Register only takes effect once and is
+// already called by controller-runtime.
+func RegisterClientMetrics() {
+	clientmetrics.RequestLatency = &latencyAdapter{observerFunc: metricskubeclient.RequestLatency}
+	clientmetrics.RateLimiterLatency = &latencyAdapter{observerFunc: metricskubeclient.RateLimiterWait}
+}
+
+// latencyAdapter is a clientmetrics.LatencyMetric that observes latencies by verb and resource.
+type latencyAdapter struct {
+	observerFunc func(verb string, resource string) prometheus.Observer
+}
+
+func (a *latencyAdapter) Observe(_ context.Context, verb string, u url.URL, latency time.Duration) {
+	a.observerFunc(verb, resourceFromPath(u.Path)).Observe(latency.Seconds())
+}
+
+// resourceFromPath returns the resource (and subresource, if any) targeted by the supplied Kube API request path -
+// e.g. 'pods/resize' for '/api/v1/namespaces/{namespace}/pods/{name}/resize'. Namespaces and names are dropped to
+// keep metric cardinality low.
+func resourceFromPath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	switch {
+	case segments[0] == "api" && len(segments) > 2:
+		segments = segments[2:]
+	case segments[0] == "apis" && len(segments) > 3:
+		segments = segments[3:]
+	default:
+		return nonResource
+	}
+
+	if segments[0] == "watch" {
+		segments = segments[1:]
+	}
+
+	if len(segments) > 2 && segments[0] == "namespaces" {
+		segments = segments[2:]
+	}
+
+	if len(segments) == 0 {
+		return nonResource
+	}
+
+	if len(segments) > 2 {
+		return segments[0] + "/" + segments[2]
+	}
+
+	return segments[0]
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	metricskubeclient "github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/kubeclient"
+	"github.com/stretchr/testify/assert"
+	clientmetrics "k8s.io/client-go/tools/metrics"
+	"k8s.io/component-base/metrics/testutil"
+)
+
+func TestRegisterClientMetrics(t *testing.T) {
+	originalRequestLatency, originalRateLimiterLatency := clientmetrics.RequestLatency, clientmetrics.RateLimiterLatency
+	defer func() {
+		clientmetrics.RequestLatency, clientmetrics.RateLimiterLatency = originalRequestLatency, originalRateLimiterLatency
+	}()
+	metricskubeclient.ResetMetrics()
+
+	RegisterClientMetrics()
+	u := url.URL{Path: "/api/v1/namespaces/{namespace}/pods/{name}"}
+	clientmetrics.RequestLatency.Observe(context.TODO(), "GET", u, time.Second)
+	clientmetrics.RateLimiterLatency.Observe(context.TODO(), "GET", u, time.Second)
+
+	value, _ := testutil.GetHistogramMetricCount(metricskubeclient.RequestLatency("GET", "pods"))
+	assert.Equal(t, uint64(1), value)
+	value, _ = testutil.GetHistogramMetricCount(metricskubeclient.RateLimiterWait("GET", "pods"))
+	assert.Equal(t, uint64(1), value)
+}
+
+func TestResourceFromPath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{"Empty", "", nonResource},
+		{"NonResource", "/version", nonResource},
+		{"CoreDiscovery", "/api/v1", nonResource},
+		{"GroupDiscovery", "/apis/coordination.k8s.io/v1", nonResource},
+		{"CoreClusterScoped", "/api/v1/nodes/{name}", "nodes"},
+		{"CoreNamespace", "/api/v1/namespaces/{name}", "namespaces"},
+		{"CoreNamespacedList", "/api/v1/namespaces/{namespace}/pods", "pods"},
+		{"CoreNamespaced", "/api/v1/namespaces/{namespace}/pods/{name}", "pods"},
+		{"CoreSubresource", "/api/v1/namespaces/{namespace}/pods/{name}/resize", "pods/resize"},
+		{"CoreAllNamespacesList", "/api/v1/pods", "pods"},
+		{"CoreWatch", "/api/v1/watch/namespaces/{namespace}/pods", "pods"},
+		{"Group", "/apis/coordination.k8s.io/v1/namespaces/{namespace}/leases/{name}", "leases"},
+		{"GroupSubresource", "/apis/apps/v1/namespaces/{namespace}/deployments/{name}/scale", "deployments/scale"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, resourceFromPath(tt.path))
+		})
+	}
+}
@@ -16,6 +16,13 @@ limitations under the License.
 
 package kube
 
+import (
+	"strings"
+
+	policyv1 "k8s.io/api/policy/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
 // ContainerStatusNotPresentError is an error that indicates container status is not present.
 type ContainerStatusNotPresentError struct{}
 
@@ -37,3 +44,26 @@ func NewContainerStatusResourcesNotPresentError() error {
 func (e ContainerStatusResourcesNotPresentError) Error() string {
 	return "container status resources not present"
 }
+
+// CircuitBreakerOpenError is an error that indicates a Kube API call wasn't made as the circuit breaker is open.
+type CircuitBreakerOpenError struct{}
+
+func NewCircuitBreakerOpenError() error {
+	return CircuitBreakerOpenError{}
+}
+
+func (e CircuitBreakerOpenError) Error() string {
+	return "circuit breaker open"
+}
+
+// IsResourceQuotaExceededError returns whether err indicates that the Kube API rejected a request since it would exceed
+// a namespace resource quota.
+func IsResourceQuotaExceededError(err error) bool {
+	return kerrors.IsForbidden(err) && strings.Contains(err.Error(), "exceeded quota")
+}
+
+// IsEvictionBlockedError returns whether err indicates that the Kube API rejected an eviction since it would violate a
+// pod disruption budget.
+func IsEvictionBlockedError(err error) bool {
+	return kerrors.IsTooManyRequests(err) && kerrors.HasStatusCause(err, policyv1.DisruptionBudgetCause)
+}
@@ -17,9 +17,15 @@ limitations under the License.
 package kube
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
 	"github.com/stretchr/testify/assert"
+	policyv1 "k8s.io/api/policy/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestNewContainerStatusNotPresentError(t *testing.T) {
@@ -39,3 +45,59 @@ func TestContainerStatusResourcesNotPresentErrorError(t *testing.T) {
 	e := NewContainerStatusResourcesNotPresentError()
 	assert.Equal(t, "container status resources not present", e.Error())
 }
+
+func TestNewCircuitBreakerOpenError(t *testing.T) {
+	assert.Equal(t, CircuitBreakerOpenError{}, NewCircuitBreakerOpenError())
+}
+
+func TestCircuitBreakerOpenErrorError(t *testing.T) {
+	e := NewCircuitBreakerOpenError()
+	assert.Equal(t, "circuit breaker open", e.Error())
+}
+
+func TestIsResourceQuotaExceededError(t *testing.T) {
+	quotaErr := kerrors.NewForbidden(
+		schema.GroupResource{Resource: "pods"},
+		"pod",
+		errors.New("exceeded quota: quota, requested: requests.cpu=1, used: requests.cpu=2, limited: requests.cpu=2"),
+	)
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"Nil", nil, false},
+		{"NotKubeError", errors.New("exceeded quota"), false},
+		{"ForbiddenNotQuota", kerrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "pod", errors.New("")), false},
+		{"ForbiddenQuota", quotaErr, true},
+		{"ForbiddenQuotaWrapped", common.WrapErrorf(quotaErr, ""), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsResourceQuotaExceededError(tt.err))
+		})
+	}
+}
+
+func TestIsEvictionBlockedError(t *testing.T) {
+	blockedErr := kerrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+	blockedErr.ErrStatus.Details.Causes = []metav1.StatusCause{{Type: policyv1.DisruptionBudgetCause}}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"Nil", nil, false},
+		{"NotKubeError", errors.New("disruption budget"), false},
+		{"TooManyRequestsNotBlocked", kerrors.NewTooManyRequests("", 1), false},
+		{"TooManyRequestsBlocked", blockedErr, true},
+		{"TooManyRequestsBlockedWrapped", common.WrapErrorf(blockedErr, ""), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsEvictionBlockedError(tt.err))
+		})
+	}
+}
@@ -18,6 +18,7 @@ package kubecommon
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/event/eventcommon"
 	v1 "k8s.io/api/core/v1"
@@ -32,6 +33,11 @@ type PodHelper interface {
 		name types.NamespacedName,
 	) (bool, *v1.Pod, error)
 
+	List(
+		ctx context.Context,
+		namespace string,
+	) ([]v1.Pod, error)
+
 	Patch(
 		ctx context.Context,
 		podEventPublisher eventcommon.PodEventPublisher,
@@ -40,6 +46,11 @@ type PodHelper interface {
 		patchResize bool,
 	) (*v1.Pod, error)
 
+	Evict(
+		ctx context.Context,
+		pod *v1.Pod,
+	) error
+
 	HasAnnotation(
 		pod *v1.Pod,
 		name string,
@@ -128,3 +139,42 @@ type ContainerHelper interface {
 		resourceName v1.ResourceName,
 	) (resource.Quantity, error)
 }
+
+// NodeHelper performs operations relating to Kube nodes.
+type NodeHelper interface {
+	FreeAllocatable(
+		ctx context.Context,
+		nodeName string,
+	) (v1.ResourceList, error)
+	CachedPods(
+		ctx context.Context,
+		nodeName string,
+	) ([]v1.Pod, error)
+}
+
+// NamespaceHelper performs operations relating to Kube namespace-scoped resource constraints.
+type NamespaceHelper interface {
+	LimitRanges(
+		ctx context.Context,
+		namespace string,
+	) ([]v1.LimitRange, error)
+	ResourceQuotas(
+		ctx context.Context,
+		namespace string,
+	) ([]v1.ResourceQuota, error)
+}
+
+// CircuitBreaker guards Kube API calls, failing them fast while the Kube API is degraded.
+type CircuitBreaker interface {
+	Allow() error
+
+	Record(
+		err error,
+	)
+
+	State() CircuitBreakerState
+
+	ReadyzCheck(
+		req *http.Request,
+	) error
+}
@@ -19,9 +19,17 @@ package kubecommon
 const Namespace = "csa.expediagroup.com"
 
 const (
-	LabelEnabled = Namespace + "/enabled"
+	LabelEnabled    = Namespace + "/enabled"
+	LabelClass      = Namespace + "/class"
+	LabelShardGroup = Namespace + "/shard-group"
 )
 
 const (
-	AnnotationStatus = Namespace + "/status"
+	AnnotationStatus                = Namespace + "/status"
+	AnnotationPaused                = Namespace + "/paused"
+	AnnotationForceState            = Namespace + "/force-state"
+	AnnotationDryRun                = Namespace + "/dry-run"
+	AnnotationMaxConcurrentStartups = Namespace + "/max-concurrent-startups"
+	AnnotationEvictionFallback      = Namespace + "/eviction-fallback"
+	AnnotationStartupProtection     = Namespace + "/startup-protection"
 )
@@ -0,0 +1,62 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubetest
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"k8s.io/api/core/v1"
+)
+
+type MockNamespaceHelper struct {
+	mock.Mock
+}
+
+func NewMockNamespaceHelper(configFunc func(*MockNamespaceHelper)) *MockNamespaceHelper {
+	m := &MockNamespaceHelper{}
+	if configFunc != nil {
+		configFunc(m)
+	} else {
+		m.AllDefaults()
+	}
+
+	return m
+}
+
+func (m *MockNamespaceHelper) LimitRanges(ctx context.Context, namespace string) ([]v1.LimitRange, error) {
+	args := m.Called(ctx, namespace)
+	return args.Get(0).([]v1.LimitRange), args.Error(1)
+}
+
+func (m *MockNamespaceHelper) ResourceQuotas(ctx context.Context, namespace string) ([]v1.ResourceQuota, error) {
+	args := m.Called(ctx, namespace)
+	return args.Get(0).([]v1.ResourceQuota), args.Error(1)
+}
+
+func (m *MockNamespaceHelper) LimitRangesDefault() {
+	m.On("LimitRanges", mock.Anything, mock.Anything).Return([]v1.LimitRange{}, nil)
+}
+
+func (m *MockNamespaceHelper) ResourceQuotasDefault() {
+	m.On("ResourceQuotas", mock.Anything, mock.Anything).Return([]v1.ResourceQuota{}, nil)
+}
+
+func (m *MockNamespaceHelper) AllDefaults() {
+	m.LimitRangesDefault()
+	m.ResourceQuotasDefault()
+}
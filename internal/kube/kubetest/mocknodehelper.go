@@ -0,0 +1,69 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubetest
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+type MockNodeHelper struct {
+	mock.Mock
+}
+
+func NewMockNodeHelper(configFunc func(*MockNodeHelper)) *MockNodeHelper {
+	m := &MockNodeHelper{}
+	if configFunc != nil {
+		configFunc(m)
+	} else {
+		m.AllDefaults()
+	}
+
+	return m
+}
+
+func (m *MockNodeHelper) FreeAllocatable(ctx context.Context, nodeName string) (v1.ResourceList, error) {
+	args := m.Called(ctx, nodeName)
+	return args.Get(0).(v1.ResourceList), args.Error(1)
+}
+
+func (m *MockNodeHelper) CachedPods(ctx context.Context, nodeName string) ([]v1.Pod, error) {
+	args := m.Called(ctx, nodeName)
+	return args.Get(0).([]v1.Pod), args.Error(1)
+}
+
+func (m *MockNodeHelper) FreeAllocatableDefault() {
+	m.On("FreeAllocatable", mock.Anything, mock.Anything).Return(
+		v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("100"),
+			v1.ResourceMemory: resource.MustParse("100Gi"),
+		},
+		nil,
+	)
+}
+
+func (m *MockNodeHelper) CachedPodsDefault() {
+	m.On("CachedPods", mock.Anything, mock.Anything).Return([]v1.Pod{}, nil)
+}
+
+func (m *MockNodeHelper) AllDefaults() {
+	m.FreeAllocatableDefault()
+	m.CachedPodsDefault()
+}
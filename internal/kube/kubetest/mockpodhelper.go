@@ -48,6 +48,11 @@ func (m *MockPodHelper) Get(ctx context.Context, name types.NamespacedName) (boo
 	return args.Bool(0), args.Get(1).(*v1.Pod), args.Error(2)
 }
 
+func (m *MockPodHelper) List(ctx context.Context, namespace string) ([]v1.Pod, error) {
+	args := m.Called(ctx, namespace)
+	return args.Get(0).([]v1.Pod), args.Error(1)
+}
+
 func (m *MockPodHelper) Patch(
 	ctx context.Context,
 	podEventPublisher eventcommon.PodEventPublisher,
@@ -59,6 +64,11 @@ func (m *MockPodHelper) Patch(
 	return args.Get(0).(*v1.Pod), args.Error(1)
 }
 
+func (m *MockPodHelper) Evict(ctx context.Context, pod *v1.Pod) error {
+	args := m.Called(ctx, pod)
+	return args.Error(0)
+}
+
 func (m *MockPodHelper) HasAnnotation(pod *v1.Pod, name string) (bool, string) {
 	args := m.Called(pod, name)
 	return args.Bool(0), args.String(1)
@@ -93,6 +103,10 @@ func (m *MockPodHelper) GetDefault() {
 	m.On("Get", mock.Anything, mock.Anything).Return(true, &v1.Pod{}, nil)
 }
 
+func (m *MockPodHelper) ListDefault() {
+	m.On("List", mock.Anything, mock.Anything).Return([]v1.Pod{}, nil)
+}
+
 func (m *MockPodHelper) PatchDefault() {
 	m.On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
 		&v1.Pod{},
@@ -100,6 +114,10 @@ func (m *MockPodHelper) PatchDefault() {
 	)
 }
 
+func (m *MockPodHelper) EvictDefault() {
+	m.On("Evict", mock.Anything, mock.Anything).Return(nil)
+}
+
 func (m *MockPodHelper) HasAnnotationDefault() {
 	m.On("HasAnnotation", mock.Anything, mock.Anything).Return(true, "")
 }
@@ -178,7 +196,9 @@ func (m *MockPodHelper) QOSClassDefault() {
 
 func (m *MockPodHelper) AllDefaults() {
 	m.GetDefault()
+	m.ListDefault()
 	m.PatchDefault()
+	m.EvictDefault()
 	m.HasAnnotationDefault()
 	m.ExpectedLabelValueAsDefault()
 	m.ExpectedAnnotationValueAsDefault()
@@ -0,0 +1,43 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TransformNamespaceForCache is a cache.TransformFunc that strips all fields other than those that identify a
+// namespace and its labels before they're placed within the informer cache, to reduce memory use (only labels are read
+// to evaluate the namespace label selector). Cached namespaces are never written back to the Kube API. Objects that
+// aren't namespaces are returned unchanged.
+func TransformNamespaceForCache(obj any) (any, error) {
+	namespace, ok := obj.(*v1.Namespace)
+	if !ok {
+		return obj, nil
+	}
+
+	return &v1.Namespace{
+		TypeMeta: namespace.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            namespace.Name,
+			UID:             namespace.UID,
+			ResourceVersion: namespace.ResourceVersion,
+			Labels:          namespace.Labels,
+		},
+	}, nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTransformNamespaceForCache(t *testing.T) {
+	t.Run("NotNamespace", func(t *testing.T) {
+		obj := &v1.ConfigMap{Data: map[string]string{"key": "value"}}
+		got, err := TransformNamespaceForCache(obj)
+		assert.NoError(t, err)
+		assert.Equal(t, obj, got)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		namespace := &v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "namespace",
+				UID:             "uid",
+				ResourceVersion: "1",
+				Labels:          map[string]string{"key": "value"},
+				Annotations:     map[string]string{"key": "value"},
+				ManagedFields:   []metav1.ManagedFieldsEntry{{}},
+			},
+			Spec:   v1.NamespaceSpec{Finalizers: []v1.FinalizerName{v1.FinalizerKubernetes}},
+			Status: v1.NamespaceStatus{Phase: v1.NamespaceActive},
+		}
+
+		got, err := TransformNamespaceForCache(namespace)
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			&v1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "namespace",
+					UID:             "uid",
+					ResourceVersion: "1",
+					Labels:          map[string]string{"key": "value"},
+				},
+			},
+			got,
+		)
+	})
+
+	t.Run("Idempotent", func(t *testing.T) {
+		once, _ := TransformNamespaceForCache(&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "namespace"}})
+		twice, _ := TransformNamespaceForCache(once)
+		assert.Equal(t, once, twice)
+	})
+}
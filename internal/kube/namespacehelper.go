@@ -0,0 +1,58 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"context"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/retry"
+	"k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// namespaceHelper is the default implementation of kubecommon.NamespaceHelper.
+type namespaceHelper struct {
+	client client.Client
+}
+
+func NewNamespaceHelper(client client.Client) kubecommon.NamespaceHelper {
+	return &namespaceHelper{client: client}
+}
+
+// LimitRanges returns the limit ranges within the supplied namespace from the informer cache.
+func (h *namespaceHelper) LimitRanges(ctx context.Context, namespace string) ([]v1.LimitRange, error) {
+	limitRanges := &v1.LimitRangeList{}
+	retryableFunc := func() error { return h.client.List(ctx, limitRanges, client.InNamespace(namespace)) }
+	if err := retry.DoStandardRetryWithMoreOpts(ctx, retryableFunc, kubeApiRetryOptions(ctx)); err != nil {
+		return nil, common.WrapErrorf(err, "unable to list limit ranges")
+	}
+
+	return limitRanges.Items, nil
+}
+
+// ResourceQuotas returns the resource quotas within the supplied namespace from the informer cache.
+func (h *namespaceHelper) ResourceQuotas(ctx context.Context, namespace string) ([]v1.ResourceQuota, error) {
+	quotas := &v1.ResourceQuotaList{}
+	retryableFunc := func() error { return h.client.List(ctx, quotas, client.InNamespace(namespace)) }
+	if err := retry.DoStandardRetryWithMoreOpts(ctx, retryableFunc, kubeApiRetryOptions(ctx)); err != nil {
+		return nil, common.WrapErrorf(err, "unable to list resource quotas")
+	}
+
+	return quotas.Items, nil
+}
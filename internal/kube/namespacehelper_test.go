@@ -0,0 +1,101 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/context/contexttest"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestNewNamespaceHelper(t *testing.T) {
+	c := fake.NewClientBuilder().Build()
+	h := NewNamespaceHelper(c)
+	assert.Equal(t, &namespaceHelper{client: c}, h)
+}
+
+func TestNamespaceHelperLimitRanges(t *testing.T) {
+	t.Run("UnableToListLimitRanges", func(t *testing.T) {
+		c := fake.NewClientBuilder().
+			WithInterceptorFuncs(interceptor.Funcs{
+				List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+					return errors.New("")
+				},
+			}).
+			Build()
+		h := NewNamespaceHelper(c)
+
+		got, err := h.LimitRanges(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), "namespace")
+		assert.Nil(t, got)
+		assert.ErrorContains(t, err, "unable to list limit ranges")
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		c := fake.NewClientBuilder().
+			WithObjects(
+				&v1.LimitRange{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "limitrange"}},
+				&v1.LimitRange{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "limitrange"}},
+			).
+			Build()
+		h := NewNamespaceHelper(c)
+
+		got, err := h.LimitRanges(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), "namespace")
+		assert.Nil(t, err)
+		assert.Len(t, got, 1)
+		assert.Equal(t, "namespace", got[0].Namespace)
+	})
+}
+
+func TestNamespaceHelperResourceQuotas(t *testing.T) {
+	t.Run("UnableToListResourceQuotas", func(t *testing.T) {
+		c := fake.NewClientBuilder().
+			WithInterceptorFuncs(interceptor.Funcs{
+				List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+					return errors.New("")
+				},
+			}).
+			Build()
+		h := NewNamespaceHelper(c)
+
+		got, err := h.ResourceQuotas(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), "namespace")
+		assert.Nil(t, got)
+		assert.ErrorContains(t, err, "unable to list resource quotas")
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		c := fake.NewClientBuilder().
+			WithObjects(
+				&v1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "quota"}},
+				&v1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "quota"}},
+			).
+			Build()
+		h := NewNamespaceHelper(c)
+
+		got, err := h.ResourceQuotas(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), "namespace")
+		assert.Nil(t, err)
+		assert.Len(t, got, 1)
+		assert.Equal(t, "namespace", got[0].Namespace)
+	})
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TransformNodeForCache is a cache.TransformFunc that strips all fields other than those that identify a node and its
+// allocatable resources before they're placed within the informer cache, to reduce memory use (nodes report images,
+// conditions and more that CSA doesn't read). Cached nodes are never written back to the Kube API. Objects that aren't
+// nodes are returned unchanged.
+func TransformNodeForCache(obj any) (any, error) {
+	node, ok := obj.(*v1.Node)
+	if !ok {
+		return obj, nil
+	}
+
+	return &v1.Node{
+		TypeMeta: node.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            node.Name,
+			UID:             node.UID,
+			ResourceVersion: node.ResourceVersion,
+		},
+		Status: v1.NodeStatus{
+			Allocatable: node.Status.Allocatable,
+		},
+	}, nil
+}
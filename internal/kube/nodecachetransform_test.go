@@ -0,0 +1,72 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTransformNodeForCache(t *testing.T) {
+	t.Run("NotNode", func(t *testing.T) {
+		obj := &v1.ConfigMap{Data: map[string]string{"key": "value"}}
+		got, err := TransformNodeForCache(obj)
+		assert.NoError(t, err)
+		assert.Equal(t, obj, got)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		allocatable := v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")}
+		node := &v1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "node",
+				UID:             "uid",
+				ResourceVersion: "1",
+				Labels:          map[string]string{"key": "value"},
+				ManagedFields:   []metav1.ManagedFieldsEntry{{}},
+			},
+			Spec: v1.NodeSpec{PodCIDR: "10.0.0.0/24"},
+			Status: v1.NodeStatus{
+				Capacity:    allocatable,
+				Allocatable: allocatable,
+				Images:      []v1.ContainerImage{{Names: []string{"image"}}},
+				Conditions:  []v1.NodeCondition{{Type: v1.NodeReady}},
+			},
+		}
+
+		got, err := TransformNodeForCache(node)
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node", UID: "uid", ResourceVersion: "1"},
+				Status:     v1.NodeStatus{Allocatable: allocatable},
+			},
+			got,
+		)
+	})
+
+	t.Run("Idempotent", func(t *testing.T) {
+		once, _ := TransformNodeForCache(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node", Labels: map[string]string{}}})
+		twice, _ := TransformNodeForCache(once)
+		assert.Equal(t, once, twice)
+	})
+}
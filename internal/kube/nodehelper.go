@@ -0,0 +1,177 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"context"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/retry"
+	"k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PodNodeNameField is the pod field used to list the pods scheduled on a node, both as a Kube API field selector and as
+// an informer cache index (see PodNodeNameIndexFunc).
+const PodNodeNameField = "spec.nodeName"
+
+// PodNodeNameIndexFunc indexes pods within the informer cache by PodNodeNameField.
+func PodNodeNameIndexFunc(obj client.Object) []string {
+	pod, ok := obj.(*v1.Pod)
+	if !ok || pod.Spec.NodeName == "" {
+		return nil
+	}
+
+	return []string{pod.Spec.NodeName}
+}
+
+// nodeHelper is the default implementation of kubecommon.NodeHelper.
+type nodeHelper struct {
+	client    client.Client
+	podReader client.Reader
+}
+
+func NewNodeHelper(
+	client client.Client,
+	podReader client.Reader,
+) kubecommon.NodeHelper {
+	return &nodeHelper{
+		client:    client,
+		podReader: podReader,
+	}
+}
+
+// FreeAllocatable returns the allocatable resources of the node with the supplied name, less the requests of all pods
+// scheduled on it that haven't terminated. The node is retrieved from the informer cache, whereas pods are listed from
+// podReader (a NodePodCache) since the informer cache only includes pods that CSA is enabled for.
+func (h *nodeHelper) FreeAllocatable(ctx context.Context, nodeName string) (v1.ResourceList, error) {
+	node := &v1.Node{}
+	retryableFunc := func() error { return h.client.Get(ctx, client.ObjectKey{Name: nodeName}, node) }
+	if err := retry.DoStandardRetryWithMoreOpts(ctx, retryableFunc, kubeApiRetryOptions(ctx)); err != nil {
+		return nil, common.WrapErrorf(err, "unable to get node")
+	}
+
+	pods := &v1.PodList{}
+	retryableFunc = func() error { return h.podReader.List(ctx, pods, client.MatchingFields{PodNodeNameField: nodeName}) }
+	if err := retry.DoStandardRetryWithMoreOpts(ctx, retryableFunc, kubeApiRetryOptions(ctx)); err != nil {
+		return nil, common.WrapErrorf(err, "unable to list pods on node")
+	}
+
+	free := node.Status.Allocatable.DeepCopy()
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+
+		for resourceName, requests := range podRequests(pod) {
+			if quantity, found := free[resourceName]; found {
+				quantity.Sub(requests)
+				free[resourceName] = quantity
+			}
+		}
+	}
+
+	return free, nil
+}
+
+// CachedPods returns the pods scheduled on the node with the supplied name from the informer cache, which only includes
+// pods that CSA is enabled for. Requires the informer cache to be indexed by PodNodeNameField.
+func (h *nodeHelper) CachedPods(ctx context.Context, nodeName string) ([]v1.Pod, error) {
+	pods := &v1.PodList{}
+	retryableFunc := func() error { return h.client.List(ctx, pods, client.MatchingFields{PodNodeNameField: nodeName}) }
+	if err := retry.DoStandardRetryWithMoreOpts(ctx, retryableFunc, kubeApiRetryOptions(ctx)); err != nil {
+		return nil, common.WrapErrorf(err, "unable to list cached pods on node")
+	}
+
+	return pods.Items, nil
+}
+
+// podRequests returns the resources requested by pod, in the same way that the scheduler and kubelet account for them:
+// the greater of the sum of regular and sidecar containers, and each init container (plus sidecars started before it),
+// plus pod overhead. Resources allocated to a container that are greater than those requested (e.g. while a resize
+// down is in progress) are used instead.
+func podRequests(pod *v1.Pod) v1.ResourceList {
+	allocated := map[string]v1.ResourceList{}
+	for _, status := range pod.Status.ContainerStatuses {
+		allocated[status.Name] = status.AllocatedResources
+	}
+	for _, status := range pod.Status.InitContainerStatuses {
+		allocated[status.Name] = status.AllocatedResources
+	}
+
+	reqs := v1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addResourceList(reqs, containerRequests(container, allocated[container.Name]))
+	}
+
+	initReqs := v1.ResourceList{}
+	sidecarReqs := v1.ResourceList{}
+	for _, container := range pod.Spec.InitContainers {
+		containerReqs := containerRequests(container, allocated[container.Name])
+
+		if container.RestartPolicy != nil && *container.RestartPolicy == v1.ContainerRestartPolicyAlways {
+			addResourceList(reqs, containerReqs)
+			addResourceList(sidecarReqs, containerReqs)
+			containerReqs = sidecarReqs
+		} else {
+			tmp := sidecarReqs.DeepCopy()
+			addResourceList(tmp, containerReqs)
+			containerReqs = tmp
+		}
+
+		maxResourceList(initReqs, containerReqs)
+	}
+
+	maxResourceList(reqs, initReqs)
+	addResourceList(reqs, pod.Spec.Overhead)
+
+	return reqs
+}
+
+// containerRequests returns the greater of container's requests and the supplied allocated resources, per resource.
+func containerRequests(container v1.Container, allocated v1.ResourceList) v1.ResourceList {
+	ret := container.Resources.Requests.DeepCopy()
+	if ret == nil {
+		ret = v1.ResourceList{}
+	}
+
+	maxResourceList(ret, allocated)
+	return ret
+}
+
+// addResourceList adds the resources in toAdd to list.
+func addResourceList(list v1.ResourceList, toAdd v1.ResourceList) {
+	for name, quantity := range toAdd {
+		if value, found := list[name]; found {
+			value.Add(quantity)
+			list[name] = value
+		} else {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+// maxResourceList sets each resource in list to the greater of its value and that in other.
+func maxResourceList(list v1.ResourceList, other v1.ResourceList) {
+	for name, quantity := range other {
+		if value, found := list[name]; !found || quantity.Cmp(value) > 0 {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
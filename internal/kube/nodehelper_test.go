@@ -0,0 +1,256 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/context/contexttest"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestNewNodeHelper(t *testing.T) {
+	c := fake.NewClientBuilder().Build()
+	h := NewNodeHelper(c, c)
+	assert.Equal(t, &nodeHelper{client: c, podReader: c}, h)
+}
+
+func TestNodeHelperFreeAllocatable(t *testing.T) {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node"},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("4"),
+				v1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+		},
+	}
+	newPod := func(name string, nodeName string, phase v1.PodPhase, cpu string, memory string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: name},
+			Spec: v1.PodSpec{
+				NodeName: nodeName,
+				Containers: []v1.Container{{
+					Name: "container",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							v1.ResourceCPU:    resource.MustParse(cpu),
+							v1.ResourceMemory: resource.MustParse(memory),
+						},
+					},
+				}},
+			},
+			Status: v1.PodStatus{Phase: phase},
+		}
+	}
+	newClient := func(funcs interceptor.Funcs, objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithObjects(objs...).
+			WithIndex(&v1.Pod{}, PodNodeNameField, PodNodeNameIndexFunc).
+			WithInterceptorFuncs(funcs).
+			Build()
+	}
+
+	t.Run("UnableToGetNode", func(t *testing.T) {
+		c := newClient(interceptor.Funcs{})
+		h := NewNodeHelper(c, c)
+
+		got, err := h.FreeAllocatable(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), "node")
+		assert.Nil(t, got)
+		assert.ErrorContains(t, err, "unable to get node")
+	})
+
+	t.Run("UnableToListPods", func(t *testing.T) {
+		c := newClient(
+			interceptor.Funcs{
+				List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+					return errors.New("")
+				},
+			},
+			node,
+		)
+		h := NewNodeHelper(c, c)
+
+		got, err := h.FreeAllocatable(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), "node")
+		assert.Nil(t, got)
+		assert.ErrorContains(t, err, "unable to list pods on node")
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		c := newClient(
+			interceptor.Funcs{},
+			node,
+			newPod("running", "node", v1.PodRunning, "1", "1Gi"),
+			newPod("pending", "node", v1.PodPending, "500m", "512Mi"),
+			newPod("succeeded", "node", v1.PodSucceeded, "1", "1Gi"),
+			newPod("failed", "node", v1.PodFailed, "1", "1Gi"),
+			newPod("othernode", "other", v1.PodRunning, "1", "1Gi"),
+		)
+		h := NewNodeHelper(c, c)
+
+		got, err := h.FreeAllocatable(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), "node")
+		assert.NoError(t, err)
+		assert.True(t, resource.MustParse("2500m").Equal(got[v1.ResourceCPU]))
+		assert.True(t, resource.MustParse("6656Mi").Equal(got[v1.ResourceMemory]))
+	})
+}
+
+func TestNodeHelperCachedPods(t *testing.T) {
+	t.Run("UnableToList", func(t *testing.T) {
+		c := fake.NewClientBuilder().
+			WithIndex(&v1.Pod{}, PodNodeNameField, PodNodeNameIndexFunc).
+			WithInterceptorFuncs(interceptor.Funcs{
+				List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+					return errors.New("")
+				},
+			}).
+			Build()
+		h := NewNodeHelper(c, c)
+
+		got, err := h.CachedPods(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), "node")
+		assert.Nil(t, got)
+		assert.ErrorContains(t, err, "unable to list cached pods on node")
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		c := fake.NewClientBuilder().
+			WithObjects(
+				&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "pod1"}, Spec: v1.PodSpec{NodeName: "node"}},
+				&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "pod2"}, Spec: v1.PodSpec{NodeName: "other"}},
+				&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "pod3"}},
+			).
+			WithIndex(&v1.Pod{}, PodNodeNameField, PodNodeNameIndexFunc).
+			Build()
+		h := NewNodeHelper(c, c)
+
+		got, err := h.CachedPods(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), "node")
+		assert.NoError(t, err)
+		assert.Len(t, got, 1)
+		assert.Equal(t, "pod1", got[0].Name)
+	})
+}
+
+func TestPodNodeNameIndexFunc(t *testing.T) {
+	t.Run("NotPod", func(t *testing.T) {
+		assert.Nil(t, PodNodeNameIndexFunc(&v1.Node{}))
+	})
+
+	t.Run("NotScheduled", func(t *testing.T) {
+		assert.Nil(t, PodNodeNameIndexFunc(&v1.Pod{}))
+	})
+
+	t.Run("Scheduled", func(t *testing.T) {
+		assert.Equal(t, []string{"node"}, PodNodeNameIndexFunc(&v1.Pod{Spec: v1.PodSpec{NodeName: "node"}}))
+	})
+}
+
+func TestPodRequests(t *testing.T) {
+	cpu := func(value string) v1.ResourceList {
+		return v1.ResourceList{v1.ResourceCPU: resource.MustParse(value)}
+	}
+	container := func(name string, requests v1.ResourceList) v1.Container {
+		return v1.Container{Name: name, Resources: v1.ResourceRequirements{Requests: requests}}
+	}
+	sidecar := func(name string, requests v1.ResourceList) v1.Container {
+		c := container(name, requests)
+		c.RestartPolicy = ptr.To(v1.ContainerRestartPolicyAlways)
+		return c
+	}
+
+	tests := []struct {
+		name string
+		pod  *v1.Pod
+		want string
+	}{
+		{
+			"ContainersSummed",
+			&v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{container("1", cpu("1")), container("2", cpu("2"))}}},
+			"3",
+		},
+		{
+			"NoRequests",
+			&v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{container("1", nil)}}},
+			"0",
+		},
+		{
+			"AllocatedGreaterThanRequests",
+			&v1.Pod{
+				Spec: v1.PodSpec{Containers: []v1.Container{container("1", cpu("1"))}},
+				Status: v1.PodStatus{
+					ContainerStatuses: []v1.ContainerStatus{{Name: "1", AllocatedResources: cpu("2")}},
+				},
+			},
+			"2",
+		},
+		{
+			"InitContainerGreater",
+			&v1.Pod{Spec: v1.PodSpec{
+				InitContainers: []v1.Container{container("init", cpu("5"))},
+				Containers:     []v1.Container{container("1", cpu("1"))},
+			}},
+			"5",
+		},
+		{
+			"InitContainerLess",
+			&v1.Pod{Spec: v1.PodSpec{
+				InitContainers: []v1.Container{container("init", cpu("500m"))},
+				Containers:     []v1.Container{container("1", cpu("1"))},
+			}},
+			"1",
+		},
+		{
+			"SidecarSummed",
+			&v1.Pod{Spec: v1.PodSpec{
+				InitContainers: []v1.Container{sidecar("sidecar", cpu("1"))},
+				Containers:     []v1.Container{container("1", cpu("1"))},
+			}},
+			"2",
+		},
+		{
+			"InitContainerAfterSidecar",
+			&v1.Pod{Spec: v1.PodSpec{
+				InitContainers: []v1.Container{sidecar("sidecar", cpu("1")), container("init", cpu("3"))},
+				Containers:     []v1.Container{container("1", cpu("1"))},
+			}},
+			"4",
+		},
+		{
+			"Overhead",
+			&v1.Pod{Spec: v1.PodSpec{
+				Containers: []v1.Container{container("1", cpu("1"))},
+				Overhead:   cpu("250m"),
+			}},
+			"1250m",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := podRequests(tt.pod)
+			quantity := got[v1.ResourceCPU]
+			assert.True(t, resource.MustParse(tt.want).Equal(quantity), "got %s", quantity.String())
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"context"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NodePodCache is an informer cache of all pods regardless of whether CSA is enabled for them, indexed by
+// PodNodeNameField, from which the pods scheduled on a node are listed to determine its free allocatable capacity. It's
+// separate from the controller-runtime manager's cache, which only includes pods that CSA is enabled for.
+type NodePodCache struct {
+	cache.Cache
+}
+
+// NewNodePodCache returns a new NodePodCache per the supplied options. If nodeName is supplied, only pods scheduled on
+// that node are cached (e.g. in node-local mode). Pods are cached using the same transform as the manager's cache to
+// reduce memory. The returned cache must be added to the manager to be started.
+func NewNodePodCache(restConfig *rest.Config, options cache.Options, nodeName string) (*NodePodCache, error) {
+	podByObject := cache.ByObject{Transform: TransformPodForCache}
+	if nodeName != "" {
+		podByObject.Field = fields.OneTermEqualSelector(PodNodeNameField, nodeName)
+	}
+	options.ByObject = map[client.Object]cache.ByObject{&v1.Pod{}: podByObject}
+
+	c, err := cache.New(restConfig, options)
+	if err != nil {
+		return nil, common.WrapErrorf(err, "unable to create cache")
+	}
+
+	// Also registers the pod informer so that it's started (and synced) along with the cache.
+	if err = c.IndexField(context.Background(), &v1.Pod{}, PodNodeNameField, PodNodeNameIndexFunc); err != nil {
+		return nil, common.WrapErrorf(err, "unable to index pods by node name")
+	}
+
+	return &NodePodCache{Cache: c}, nil
+}
+
+// GetCache returns the cache, so that the controller-runtime manager treats it as a cache: it's started and synced
+// before controllers are started.
+func (c *NodePodCache) GetCache() cache.Cache {
+	return c.Cache
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+func TestNewNodePodCache(t *testing.T) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(v1.SchemeGroupVersion.WithKind("Pod"), meta.RESTScopeNamespace)
+	options := cache.Options{Scheme: scheme.Scheme, Mapper: mapper}
+
+	t.Run("UnableToCreateCache", func(t *testing.T) {
+		got, err := NewNodePodCache(
+			&rest.Config{Host: "http://localhost"},
+			cache.Options{Scheme: scheme.Scheme, Mapper: meta.NewDefaultRESTMapper(nil)},
+			"",
+		)
+		assert.ErrorContains(t, err, "unable to create cache")
+		assert.Nil(t, got)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		got, err := NewNodePodCache(&rest.Config{Host: "http://localhost"}, options, "node")
+		assert.NoError(t, err)
+		assert.NotNil(t, got.Cache)
+		assert.Equal(t, got.Cache, got.GetCache())
+	})
+}
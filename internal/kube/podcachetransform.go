@@ -0,0 +1,59 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"k8s.io/api/core/v1"
+)
+
+// lastAppliedConfigAnnotation is the annotation kubectl uses to record the last applied configuration of an object.
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// TransformPodForCache is a cache.TransformFunc that strips fields CSA doesn't read from pods before they're placed
+// within the informer cache, to reduce memory use. Stripped fields are never written back to the Kube API: merge
+// patches calculated from cached pods omit fields absent from both the original and mutated pod, and resize
+// subresource patches only apply container resources. Objects that aren't pods are returned unchanged.
+func TransformPodForCache(obj any) (any, error) {
+	pod, ok := obj.(*v1.Pod)
+	if !ok {
+		return obj, nil
+	}
+
+	pod.ManagedFields = nil
+	delete(pod.Annotations, lastAppliedConfigAnnotation)
+
+	pod.Spec.Volumes = nil
+	pod.Spec.EphemeralContainers = nil
+	for i := range pod.Spec.InitContainers {
+		stripContainer(&pod.Spec.InitContainers[i])
+	}
+	for i := range pod.Spec.Containers {
+		stripContainer(&pod.Spec.Containers[i])
+	}
+
+	return pod, nil
+}
+
+// stripContainer strips fields CSA doesn't read from container.
+func stripContainer(container *v1.Container) {
+	container.Command = nil
+	container.Args = nil
+	container.Env = nil
+	container.EnvFrom = nil
+	container.VolumeMounts = nil
+	container.VolumeDevices = nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestTransformPodForCache(t *testing.T) {
+	t.Run("NotPod", func(t *testing.T) {
+		obj := &v1.ConfigMap{Data: map[string]string{"key": "value"}}
+		got, err := TransformPodForCache(obj)
+		assert.NoError(t, err)
+		assert.Equal(t, obj, got)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		pod := benchmarkPod(0)
+		want := pod.DeepCopy()
+
+		got, err := TransformPodForCache(pod)
+		assert.NoError(t, err)
+		gotPod := got.(*v1.Pod)
+
+		assert.Nil(t, gotPod.ManagedFields)
+		assert.NotContains(t, gotPod.Annotations, lastAppliedConfigAnnotation)
+		assert.Nil(t, gotPod.Spec.Volumes)
+		assert.Nil(t, gotPod.Spec.EphemeralContainers)
+		for _, ctrs := range [][]v1.Container{gotPod.Spec.InitContainers, gotPod.Spec.Containers} {
+			for _, ctr := range ctrs {
+				assert.Nil(t, ctr.Command)
+				assert.Nil(t, ctr.Args)
+				assert.Nil(t, ctr.Env)
+				assert.Nil(t, ctr.EnvFrom)
+				assert.Nil(t, ctr.VolumeMounts)
+				assert.Nil(t, ctr.VolumeDevices)
+			}
+		}
+
+		// Fields that CSA reads are retained.
+		assert.Equal(t, want.Name, gotPod.Name)
+		assert.Equal(t, want.Labels, gotPod.Labels)
+		assert.Equal(t, want.Annotations[kubecommon.AnnotationStatus], gotPod.Annotations[kubecommon.AnnotationStatus])
+		assert.Equal(t, want.Spec.Containers[0].Name, gotPod.Spec.Containers[0].Name)
+		assert.Equal(t, want.Spec.Containers[0].Resources, gotPod.Spec.Containers[0].Resources)
+		assert.Equal(t, want.Spec.Containers[0].StartupProbe, gotPod.Spec.Containers[0].StartupProbe)
+		assert.Equal(t, want.Spec.Containers[0].ReadinessProbe, gotPod.Spec.Containers[0].ReadinessProbe)
+		assert.Equal(t, want.Status, gotPod.Status)
+	})
+
+	t.Run("Idempotent", func(t *testing.T) {
+		once, _ := TransformPodForCache(benchmarkPod(0))
+		want := once.(*v1.Pod).DeepCopy()
+		twice, _ := TransformPodForCache(once)
+		assert.Equal(t, want, twice)
+	})
+}
+
+// BenchmarkTransformPodForCache reports the heap retained by a cache store of 10,000 pods with and without
+// TransformPodForCache applied. Timings include pod construction.
+func BenchmarkTransformPodForCache(b *testing.B) {
+	const podCount = 10000
+
+	benchmarks := []struct {
+		name      string
+		transform cache.TransformFunc
+	}{
+		{"Untransformed", nil},
+		{"Transformed", TransformPodForCache},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			var retainedBytes uint64
+
+			for i := 0; i < b.N; i++ {
+				before := heapAlloc()
+
+				store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+				for j := 0; j < podCount; j++ {
+					var obj any = benchmarkPod(j)
+					if bm.transform != nil {
+						obj, _ = bm.transform(obj)
+					}
+					_ = store.Add(obj)
+				}
+
+				retainedBytes += heapAlloc() - before
+				runtime.KeepAlive(store)
+			}
+
+			b.ReportMetric(float64(retainedBytes)/float64(b.N)/podCount, "retained-B/pod")
+		})
+	}
+}
+
+// benchmarkPod returns a pod that's representative of those found in production clusters.
+func benchmarkPod(i int) *v1.Pod {
+	pod := kubetest.NewPodBuilder().Build()
+	pod.Name = fmt.Sprintf("pod-%d", i)
+	pod.Annotations[kubecommon.AnnotationStatus] = `{"status":"status"}`
+	pod.Annotations[lastAppliedConfigAnnotation] = strings.Repeat("x", 2048)
+	pod.ManagedFields = []metav1.ManagedFieldsEntry{
+		{Manager: "kube-controller-manager", FieldsV1: &metav1.FieldsV1{Raw: []byte(strings.Repeat("f", 2048))}},
+		{Manager: "kubelet", FieldsV1: &metav1.FieldsV1{Raw: []byte(strings.Repeat("f", 2048))}},
+	}
+
+	for j := 0; j < 5; j++ {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+			Name:         fmt.Sprintf("volume-%d", j),
+			VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{}},
+		})
+	}
+
+	for j := range pod.Spec.Containers {
+		ctr := &pod.Spec.Containers[j]
+		ctr.Command = []string{"/bin/app"}
+		ctr.Args = []string{"--flag1", "--flag2", "--flag3"}
+		for k := 0; k < 20; k++ {
+			ctr.Env = append(ctr.Env, v1.EnvVar{
+				Name:  fmt.Sprintf("ENV_VAR_%d", k),
+				Value: strings.Repeat("v", 64),
+			})
+		}
+		for _, volume := range pod.Spec.Volumes {
+			ctr.VolumeMounts = append(ctr.VolumeMounts, v1.VolumeMount{Name: volume.Name, MountPath: "/" + volume.Name})
+		}
+	}
+
+	return pod
+}
+
+// heapAlloc returns the bytes of allocated heap objects after garbage collection.
+func heapAlloc() uint64 {
+	runtime.GC()
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return stats.HeapAlloc
+}
@@ -32,7 +32,9 @@ import (
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/retry"
 	retrygo "github.com/avast/retry-go/v4"
 	"k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -46,18 +48,29 @@ const (
 
 // podHelper is the default implementation of kubecommon.PodHelper.
 type podHelper struct {
-	client client.Client
+	client  client.Client
+	breaker kubecommon.CircuitBreaker
 }
 
-func NewPodHelper(client client.Client) kubecommon.PodHelper {
-	return &podHelper{client: client}
+func NewPodHelper(client client.Client, breaker kubecommon.CircuitBreaker) kubecommon.PodHelper {
+	return &podHelper{
+		client:  client,
+		breaker: breaker,
+	}
 }
 
 // Get returns the pod with the supplied name, along with whether the pod exists.
 func (h *podHelper) Get(ctx context.Context, name types.NamespacedName) (bool, *v1.Pod, error) {
 	pod := &v1.Pod{}
 	retryableFunc := func() error {
-		return h.client.Get(ctx, name, pod)
+		getFunc := func() error { return h.client.Get(ctx, name, pod) }
+
+		// Only reads that are made directly against the Kube API are guarded by the circuit breaker.
+		if _, isUncached := h.client.(*uncachedClient); isUncached {
+			return h.callKubeApi(getFunc)
+		}
+
+		return getFunc()
 	}
 
 	err := retry.DoStandardRetryWithMoreOpts(ctx, retryableFunc, kubeApiRetryOptions(ctx))
@@ -72,11 +85,33 @@ func (h *podHelper) Get(ctx context.Context, name types.NamespacedName) (bool, *
 	return true, pod, nil
 }
 
+// List returns the pods within the supplied namespace.
+func (h *podHelper) List(ctx context.Context, namespace string) ([]v1.Pod, error) {
+	pods := &v1.PodList{}
+	retryableFunc := func() error {
+		listFunc := func() error { return h.client.List(ctx, pods, client.InNamespace(namespace)) }
+
+		// Only reads that are made directly against the Kube API are guarded by the circuit breaker.
+		if _, isUncached := h.client.(*uncachedClient); isUncached {
+			return h.callKubeApi(listFunc)
+		}
+
+		return listFunc()
+	}
+
+	if err := retry.DoStandardRetryWithMoreOpts(ctx, retryableFunc, kubeApiRetryOptions(ctx)); err != nil {
+		return nil, common.WrapErrorf(err, "unable to list pods")
+	}
+
+	return pods.Items, nil
+}
+
 // Patch applies the mutations dictated by podMutationFuncs to either the 'resize' subresource of the supplied pod, or
 // the pod itself. If any podMutationFunc specifies a non-nil function return value, it waits for the patched pod to be
 // updated in the informer cache using the conditions specified by these functions.  It returns the new server
 // representation of the pod. The patch is retried and specially handled if there's a conflict: the latest version is
-// retrieved and the mutations are reapplied before attempting again. The supplied pod is never mutated.
+// retrieved and the mutations are reapplied before attempting again. The supplied pod is never mutated. Resize patches
+// are not applied when dry-running, in which case the supplied pod is returned.
 func (h *podHelper) Patch(
 	ctx context.Context,
 	podEventPublisher eventcommon.PodEventPublisher,
@@ -102,6 +137,11 @@ func (h *podHelper) Patch(
 		return pod, nil
 	}
 
+	if patchResize && ccontext.DryRun(ctx) { // Resizes are never applied when dry-running.
+		logging.Infof(ctx, logging.VDebug, "dry run so will not patch resize")
+		return pod, nil
+	}
+
 	var podEventCh <-chan eventcommon.PodEvent
 	shouldWaitForCacheUpdate := h.shouldWaitForCacheUpdate(waitCacheConditionsMetFuncs)
 	if shouldWaitForCacheUpdate {
@@ -119,11 +159,13 @@ func (h *podHelper) Patch(
 			)
 		}
 
-		if patchResize {
-			err = h.client.SubResource("resize").Patch(ctx, mutatedPod, client.MergeFrom(pod))
-		} else {
-			err = h.client.Patch(ctx, mutatedPod, client.MergeFrom(pod))
-		}
+		err = h.callKubeApi(func() error {
+			if patchResize {
+				return h.client.SubResource("resize").Patch(ctx, mutatedPod, client.MergeFrom(pod))
+			}
+
+			return h.client.Patch(ctx, mutatedPod, client.MergeFrom(pod))
+		})
 
 		if err != nil {
 			if kerrors.IsConflict(err) {
@@ -169,6 +211,34 @@ func (h *podHelper) Patch(
 	return mutatedPod, nil
 }
 
+// Evict evicts the supplied pod via the Eviction API so that any pod disruption budget is respected. Evictions blocked
+// by a pod disruption budget aren't retried and the Kube API error is returned (see IsEvictionBlockedError). Pods that
+// no longer exist are considered evicted. Evictions are not performed when dry-running.
+func (h *podHelper) Evict(ctx context.Context, pod *v1.Pod) error {
+	if ccontext.DryRun(ctx) {
+		logging.Infof(ctx, logging.VDebug, "dry run so will not evict")
+		return nil
+	}
+
+	eviction := &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{Namespace: pod.Namespace, Name: pod.Name},
+	}
+	retryableFunc := func() error {
+		return h.callKubeApi(func() error { return h.client.SubResource("eviction").Create(ctx, pod, eviction) })
+	}
+
+	err := retry.DoStandardRetryWithMoreOpts(ctx, retryableFunc, kubeApiRetryOptions(ctx))
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+
+		return common.WrapErrorf(err, "unable to evict pod")
+	}
+
+	return nil
+}
+
 // HasAnnotation returns whether the supplied pod has the supplied name annotation.
 func (h *podHelper) HasAnnotation(pod *v1.Pod, name string) (bool, string) {
 	if value, has := pod.Annotations[name]; has {
@@ -299,7 +369,7 @@ func (h *podHelper) waitForCacheUpdate(
 
 			allConditionsMet := true
 			for _, conditionsMetFunc := range conditionsMetFuncs {
-				if !conditionsMetFunc(event.Pod) {
+				if conditionsMetFunc != nil && !conditionsMetFunc(event.Pod) {
 					allConditionsMet = false
 				}
 			}
@@ -314,3 +384,15 @@ func (h *podHelper) waitForCacheUpdate(
 		}
 	}
 }
+
+// callKubeApi invokes apiFunc if permitted by the circuit breaker, recording its outcome. If not permitted, an
+// unrecoverable error is returned so that the call is failed fast rather than retried.
+func (h *podHelper) callKubeApi(apiFunc func() error) error {
+	if err := h.breaker.Allow(); err != nil {
+		return retrygo.Unrecoverable(err)
+	}
+
+	err := apiFunc()
+	h.breaker.Record(err)
+	return err
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/context/contexttest"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/event/eventcommon"
@@ -34,6 +35,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -47,7 +49,8 @@ import (
 
 func TestNewPodHelper(t *testing.T) {
 	c := fake.NewClientBuilder().Build()
-	assert.Equal(t, &podHelper{client: c}, NewPodHelper(c))
+	b := NewDisabledCircuitBreaker()
+	assert.Equal(t, &podHelper{client: c, breaker: b}, NewPodHelper(c, b))
 }
 
 func TestPodHelperGet(t *testing.T) {
@@ -110,7 +113,7 @@ func TestPodHelperGet(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewPodHelper(tt.client)
+			h := NewPodHelper(tt.client, NewDisabledCircuitBreaker())
 
 			gotFound, gotPod, err := h.Get(tt.args.ctx, tt.args.name)
 			if tt.wantErrMsg != "" {
@@ -124,12 +127,54 @@ func TestPodHelperGet(t *testing.T) {
 	}
 }
 
+func TestPodHelperList(t *testing.T) {
+	t.Run("UnableToListPods", func(t *testing.T) {
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset() },
+				func() interceptor.Funcs {
+					return interceptor.Funcs{
+						List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+							return errors.New("")
+						},
+					}
+				},
+			),
+			NewDisabledCircuitBreaker(),
+		)
+
+		got, err := h.List(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), kubetest.DefaultPodNamespace)
+		assert.ErrorContains(t, err, "unable to list pods")
+		assert.Nil(t, got)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		h := NewPodHelper(
+			fake.NewClientBuilder().
+				WithObjects(
+					&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "pod1"}},
+					&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "pod2"}},
+				).
+				Build(),
+			NewDisabledCircuitBreaker(),
+		)
+
+		got, err := h.List(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), "namespace")
+		assert.NoError(t, err)
+		assert.Len(t, got, 1)
+		assert.Equal(t, "pod1", got[0].Name)
+	})
+}
+
 func TestPodHelperPatch(t *testing.T) {
 	t.Run("UnableToMutatePod", func(t *testing.T) {
-		h := NewPodHelper(kubetest.ControllerRuntimeFakeClientWithKubeFake(
-			func() *kubefake.Clientset { return kubefake.NewClientset() },
-			func() interceptor.Funcs { return interceptor.Funcs{} },
-		))
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset() },
+				func() interceptor.Funcs { return interceptor.Funcs{} },
+			),
+			NewDisabledCircuitBreaker(),
+		)
 
 		got, err := h.Patch(
 			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
@@ -145,10 +190,13 @@ func TestPodHelperPatch(t *testing.T) {
 	})
 
 	t.Run("UnableToPatchPod", func(t *testing.T) {
-		h := NewPodHelper(kubetest.ControllerRuntimeFakeClientWithKubeFake(
-			func() *kubefake.Clientset { return kubefake.NewClientset() },
-			func() interceptor.Funcs { return interceptor.Funcs{Patch: kubetest.InterceptorFuncPatchFail()} },
-		))
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset() },
+				func() interceptor.Funcs { return interceptor.Funcs{Patch: kubetest.InterceptorFuncPatchFail()} },
+			),
+			NewDisabledCircuitBreaker(),
+		)
 
 		got, err := h.Patch(
 			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
@@ -163,17 +211,129 @@ func TestPodHelperPatch(t *testing.T) {
 		assert.ErrorContains(t, err, "unable to patch pod")
 	})
 
+	t.Run("ResourceQuotaExceededNotRetried", func(t *testing.T) {
+		patchCalls := 0
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset() },
+				func() interceptor.Funcs {
+					return interceptor.Funcs{
+						Patch: func(context.Context, client.WithWatch, client.Object, client.Patch, ...client.PatchOption) error {
+							patchCalls++
+							return kerrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "", errors.New("exceeded quota"))
+						},
+					}
+				},
+			),
+			NewDisabledCircuitBreaker(),
+		)
+
+		got, err := h.Patch(
+			contexttest.NewCtxBuilder(contexttest.NewOneRetryCtxConfig(nil)).Build(),
+			nil,
+			&v1.Pod{},
+			[]func(*v1.Pod) (bool, func(*v1.Pod) bool, error){
+				func(*v1.Pod) (bool, func(*v1.Pod) bool, error) { return true, nil, nil },
+			},
+			false,
+		)
+		assert.Nil(t, got)
+		assert.True(t, IsResourceQuotaExceededError(err))
+		assert.Equal(t, 1, patchCalls)
+	})
+
+	t.Run("CircuitBreakerOpen", func(t *testing.T) {
+		patchCalled := false
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset() },
+				func() interceptor.Funcs {
+					return interceptor.Funcs{
+						Patch: func(context.Context, client.WithWatch, client.Object, client.Patch, ...client.PatchOption) error {
+							patchCalled = true
+							return nil
+						},
+					}
+				},
+			),
+			openCircuitBreaker(),
+		)
+
+		got, err := h.Patch(
+			contexttest.NewCtxBuilder(contexttest.NewOneRetryCtxConfig(nil)).Build(),
+			nil,
+			&v1.Pod{},
+			[]func(*v1.Pod) (bool, func(*v1.Pod) bool, error){
+				func(*v1.Pod) (bool, func(*v1.Pod) bool, error) { return true, nil, nil },
+			},
+			false,
+		)
+		assert.Nil(t, got)
+		assert.ErrorAs(t, err, &CircuitBreakerOpenError{})
+		assert.False(t, patchCalled)
+	})
+
+	t.Run("DryRunResize", func(t *testing.T) {
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset() },
+				func() interceptor.Funcs {
+					return interceptor.Funcs{SubResourcePatch: kubetest.InterceptorFuncSubResourcePatchFail()}
+				},
+			),
+			NewDisabledCircuitBreaker(),
+		)
+		pod := &v1.Pod{}
+
+		got, err := h.Patch(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).DryRun(true).Build(),
+			nil,
+			pod,
+			[]func(*v1.Pod) (bool, func(*v1.Pod) bool, error){
+				func(*v1.Pod) (bool, func(*v1.Pod) bool, error) { return true, nil, nil },
+			},
+			true,
+		)
+		assert.NoError(t, err)
+		assert.Same(t, pod, got)
+	})
+
+	t.Run("DryRunNotResize", func(t *testing.T) {
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset() },
+				func() interceptor.Funcs { return interceptor.Funcs{Patch: kubetest.InterceptorFuncPatchFail()} },
+			),
+			NewDisabledCircuitBreaker(),
+		)
+
+		got, err := h.Patch(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).DryRun(true).Build(),
+			nil,
+			&v1.Pod{},
+			[]func(*v1.Pod) (bool, func(*v1.Pod) bool, error){
+				func(*v1.Pod) (bool, func(*v1.Pod) bool, error) { return true, nil, nil },
+			},
+			false,
+		)
+		assert.Nil(t, got)
+		assert.ErrorContains(t, err, "unable to patch pod")
+	})
+
 	t.Run("ConflictUnableToGetPod", func(t *testing.T) {
 		conflictErr := kerrors.NewConflict(schema.GroupResource{}, "", errors.New(""))
-		h := NewPodHelper(kubetest.ControllerRuntimeFakeClientWithKubeFake(
-			func() *kubefake.Clientset { return kubefake.NewClientset() },
-			func() interceptor.Funcs {
-				return interceptor.Funcs{
-					Patch: kubetest.InterceptorFuncPatchFail(conflictErr),
-					Get:   kubetest.InterceptorFuncGetFail(),
-				}
-			},
-		))
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset() },
+				func() interceptor.Funcs {
+					return interceptor.Funcs{
+						Patch: kubetest.InterceptorFuncPatchFail(conflictErr),
+						Get:   kubetest.InterceptorFuncGetFail(),
+					}
+				},
+			),
+			NewDisabledCircuitBreaker(),
+		)
 
 		got, err := h.Patch(
 			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
@@ -191,15 +351,18 @@ func TestPodHelperPatch(t *testing.T) {
 	t.Run("ConflictPodDoesntExist", func(t *testing.T) {
 		conflictErr := kerrors.NewConflict(schema.GroupResource{}, "", errors.New(""))
 		notFoundErr := kerrors.NewNotFound(schema.GroupResource{}, "")
-		h := NewPodHelper(kubetest.ControllerRuntimeFakeClientWithKubeFake(
-			func() *kubefake.Clientset { return kubefake.NewClientset() },
-			func() interceptor.Funcs {
-				return interceptor.Funcs{
-					Patch: kubetest.InterceptorFuncPatchFail(conflictErr),
-					Get:   kubetest.InterceptorFuncGetFail(notFoundErr),
-				}
-			},
-		))
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset() },
+				func() interceptor.Funcs {
+					return interceptor.Funcs{
+						Patch: kubetest.InterceptorFuncPatchFail(conflictErr),
+						Get:   kubetest.InterceptorFuncGetFail(notFoundErr),
+					}
+				},
+			),
+			NewDisabledCircuitBreaker(),
+		)
 
 		got, err := h.Patch(
 			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
@@ -218,10 +381,13 @@ func TestPodHelperPatch(t *testing.T) {
 		pod := kubetest.NewPodBuilder().Build()
 		podMutationFunc1 := func(*v1.Pod) (bool, func(*v1.Pod) bool, error) { return false, nil, nil }
 		podMutationFunc2 := func(*v1.Pod) (bool, func(*v1.Pod) bool, error) { return false, nil, nil }
-		h := NewPodHelper(kubetest.ControllerRuntimeFakeClientWithKubeFake(
-			func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
-			func() interceptor.Funcs { return interceptor.Funcs{} },
-		))
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
+				func() interceptor.Funcs { return interceptor.Funcs{} },
+			),
+			NewDisabledCircuitBreaker(),
+		)
 
 		got, err := h.Patch(
 			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
@@ -247,10 +413,13 @@ func TestPodHelperPatch(t *testing.T) {
 			mutatePod.Spec.Containers[0].Resources.Limits[v1.ResourceMemory] = kubetest.PodMemoryPostStartupLimitsEnabled
 			return true, nil, nil
 		}
-		h := NewPodHelper(kubetest.ControllerRuntimeFakeClientWithKubeFake(
-			func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
-			func() interceptor.Funcs { return interceptor.Funcs{} },
-		))
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
+				func() interceptor.Funcs { return interceptor.Funcs{} },
+			),
+			NewDisabledCircuitBreaker(),
+		)
 
 		got, err := h.Patch(
 			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
@@ -285,12 +454,15 @@ func TestPodHelperPatch(t *testing.T) {
 			return true, nil, nil
 		}
 		conflictErr := kerrors.NewConflict(schema.GroupResource{}, "", errors.New(""))
-		h := NewPodHelper(kubetest.ControllerRuntimeFakeClientWithKubeFake(
-			func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
-			func() interceptor.Funcs {
-				return interceptor.Funcs{SubResourcePatch: kubetest.InterceptorFuncSubResourcePatchFailFirstOnly(conflictErr)}
-			},
-		))
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
+				func() interceptor.Funcs {
+					return interceptor.Funcs{SubResourcePatch: kubetest.InterceptorFuncSubResourcePatchFailFirstOnly(conflictErr)}
+				},
+			),
+			NewDisabledCircuitBreaker(),
+		)
 
 		beforeMetricVal, _ := testutil.GetCounterMetricValue(retry.Retry(strings.ToLower(string(metav1.StatusReasonConflict))))
 		got, err := h.Patch(
@@ -322,10 +494,13 @@ func TestPodHelperPatch(t *testing.T) {
 			return true, nil, nil
 		}
 		podMutationFunc3 := func(*v1.Pod) (bool, func(*v1.Pod) bool, error) { return false, nil, nil }
-		h := NewPodHelper(kubetest.ControllerRuntimeFakeClientWithKubeFake(
-			func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
-			func() interceptor.Funcs { return interceptor.Funcs{} },
-		))
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
+				func() interceptor.Funcs { return interceptor.Funcs{} },
+			),
+			NewDisabledCircuitBreaker(),
+		)
 
 		got, err := h.Patch(
 			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
@@ -354,10 +529,13 @@ func TestPodHelperPatch(t *testing.T) {
 			mutatePod.Annotations["test"] = "test"
 			return true, nil, nil
 		}
-		h := NewPodHelper(kubetest.ControllerRuntimeFakeClientWithKubeFake(
-			func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
-			func() interceptor.Funcs { return interceptor.Funcs{} },
-		))
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
+				func() interceptor.Funcs { return interceptor.Funcs{} },
+			),
+			NewDisabledCircuitBreaker(),
+		)
 
 		got, err := h.Patch(
 			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
@@ -393,10 +571,13 @@ func TestPodHelperPatch(t *testing.T) {
 				Run(func(_ mock.Arguments) { subscribeCalled = true })
 			m.On("Unsubscribe", mock.Anything).Run(func(_ mock.Arguments) { unsubscribeCalled = true })
 		})
-		h := NewPodHelper(kubetest.ControllerRuntimeFakeClientWithKubeFake(
-			func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
-			func() interceptor.Funcs { return interceptor.Funcs{} },
-		))
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
+				func() interceptor.Funcs { return interceptor.Funcs{} },
+			),
+			NewDisabledCircuitBreaker(),
+		)
 
 		got, err := h.Patch(
 			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
@@ -412,6 +593,115 @@ func TestPodHelperPatch(t *testing.T) {
 	})
 }
 
+func TestPodHelperEvict(t *testing.T) {
+	t.Run("UnableToEvictPod", func(t *testing.T) {
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset() },
+				func() interceptor.Funcs {
+					return interceptor.Funcs{
+						SubResourceCreate: func(context.Context, client.Client, string, client.Object, client.Object, ...client.SubResourceCreateOption) error {
+							return errors.New("")
+						},
+					}
+				},
+			),
+			NewDisabledCircuitBreaker(),
+		)
+
+		err := h.Evict(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), &v1.Pod{})
+		assert.ErrorContains(t, err, "unable to evict pod")
+	})
+
+	t.Run("EvictionBlockedNotRetried", func(t *testing.T) {
+		createCalls := 0
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset() },
+				func() interceptor.Funcs {
+					return interceptor.Funcs{
+						SubResourceCreate: func(context.Context, client.Client, string, client.Object, client.Object, ...client.SubResourceCreateOption) error {
+							createCalls++
+							err := kerrors.NewTooManyRequests("", 0)
+							err.ErrStatus.Details.Causes = []metav1.StatusCause{{Type: policyv1.DisruptionBudgetCause}}
+							return err
+						},
+					}
+				},
+			),
+			NewDisabledCircuitBreaker(),
+		)
+
+		err := h.Evict(contexttest.NewCtxBuilder(contexttest.NewOneRetryCtxConfig(nil)).Build(), &v1.Pod{})
+		assert.True(t, IsEvictionBlockedError(err))
+		assert.Equal(t, 1, createCalls)
+	})
+
+	t.Run("CircuitBreakerOpen", func(t *testing.T) {
+		createCalled := false
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset() },
+				func() interceptor.Funcs {
+					return interceptor.Funcs{
+						SubResourceCreate: func(context.Context, client.Client, string, client.Object, client.Object, ...client.SubResourceCreateOption) error {
+							createCalled = true
+							return nil
+						},
+					}
+				},
+			),
+			openCircuitBreaker(),
+		)
+
+		err := h.Evict(contexttest.NewCtxBuilder(contexttest.NewOneRetryCtxConfig(nil)).Build(), &v1.Pod{})
+		assert.ErrorAs(t, err, &CircuitBreakerOpenError{})
+		assert.False(t, createCalled)
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		createCalled := false
+		h := NewPodHelper(
+			kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset() },
+				func() interceptor.Funcs {
+					return interceptor.Funcs{
+						SubResourceCreate: func(context.Context, client.Client, string, client.Object, client.Object, ...client.SubResourceCreateOption) error {
+							createCalled = true
+							return nil
+						},
+					}
+				},
+			),
+			NewDisabledCircuitBreaker(),
+		)
+
+		err := h.Evict(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).DryRun(true).Build(), &v1.Pod{})
+		assert.NoError(t, err)
+		assert.False(t, createCalled)
+	})
+
+	t.Run("OkNotFound", func(t *testing.T) {
+		h := NewPodHelper(fake.NewClientBuilder().Build(), NewDisabledCircuitBreaker())
+
+		err := h.Evict(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "pod"}},
+		)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "pod"}}
+		c := fake.NewClientBuilder().WithObjects(pod).Build()
+		h := NewPodHelper(c, NewDisabledCircuitBreaker())
+
+		err := h.Evict(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), pod)
+		assert.NoError(t, err)
+		assert.True(t, kerrors.IsNotFound(c.Get(context.TODO(), client.ObjectKeyFromObject(pod), &v1.Pod{})))
+	})
+}
+
 func TestPodHelperHasAnnotation(t *testing.T) {
 	type args struct {
 		pod  *v1.Pod
@@ -444,7 +734,7 @@ func TestPodHelperHasAnnotation(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewPodHelper(nil)
+			h := NewPodHelper(nil, NewDisabledCircuitBreaker())
 
 			gotBool, gotString := h.HasAnnotation(tt.args.pod, tt.args.name)
 			assert.Equal(t, tt.wantBool, gotBool)
@@ -513,7 +803,7 @@ func TestPodHelperExpectedLabelValueAs(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewPodHelper(nil)
+			h := NewPodHelper(nil, NewDisabledCircuitBreaker())
 
 			if tt.wantPanicErrMsg != "" {
 				assert.PanicsWithError(t, tt.wantPanicErrMsg, func() { _, _ = h.ExpectedLabelValueAs(tt.args.pod, tt.args.name, tt.args.as) })
@@ -558,7 +848,7 @@ func TestPodHelperExpectedAnnotationValueAs(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewPodHelper(nil)
+			h := NewPodHelper(nil, NewDisabledCircuitBreaker())
 
 			if tt.wantPanicErrMsg != "" {
 				assert.PanicsWithError(t, tt.wantPanicErrMsg, func() { _, _ = h.ExpectedAnnotationValueAs(tt.args.pod, tt.args.name, tt.args.as) })
@@ -605,7 +895,7 @@ func TestPodHelperIsContainerInSpec(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewPodHelper(nil)
+			h := NewPodHelper(nil, NewDisabledCircuitBreaker())
 
 			got := h.IsContainerInSpec(tt.args.pod, tt.args.containerName)
 			assert.Equal(t, tt.want, got)
@@ -615,7 +905,7 @@ func TestPodHelperIsContainerInSpec(t *testing.T) {
 
 func TestPodHelperResizeConditions(t *testing.T) {
 	t.Run("OkWithoutConditions", func(t *testing.T) {
-		h := NewPodHelper(nil)
+		h := NewPodHelper(nil, NewDisabledCircuitBreaker())
 		pod := kubetest.NewPodBuilder().ResizeConditions().Build()
 
 		got := h.ResizeConditions(pod)
@@ -623,7 +913,7 @@ func TestPodHelperResizeConditions(t *testing.T) {
 	})
 
 	t.Run("OkWithConditions", func(t *testing.T) {
-		h := NewPodHelper(nil)
+		h := NewPodHelper(nil, NewDisabledCircuitBreaker())
 		condition1 := v1.PodCondition{Type: v1.PodResizePending}
 		condition2 := v1.PodCondition{Type: "othertype"}
 		condition3 := v1.PodCondition{Type: v1.PodResizeInProgress}
@@ -636,7 +926,7 @@ func TestPodHelperResizeConditions(t *testing.T) {
 
 func TestPodHelperQOSClass(t *testing.T) {
 	t.Run("NotPresent", func(t *testing.T) {
-		h := NewPodHelper(nil)
+		h := NewPodHelper(nil, NewDisabledCircuitBreaker())
 		pod := kubetest.NewPodBuilder().QOSClassNotPresent().Build()
 
 		got, err := h.QOSClass(pod)
@@ -645,7 +935,7 @@ func TestPodHelperQOSClass(t *testing.T) {
 	})
 
 	t.Run("Ok", func(t *testing.T) {
-		h := NewPodHelper(nil)
+		h := NewPodHelper(nil, NewDisabledCircuitBreaker())
 		pod := kubetest.NewPodBuilder().Build()
 
 		got, err := h.QOSClass(pod)
@@ -705,6 +995,7 @@ func TestPodHelperWaitForCacheUpdate(t *testing.T) {
 			func(currentPod *v1.Pod) bool {
 				return currentPod.Status.ContainerStatuses[0].Ready == false
 			},
+			nil,
 		}
 
 		got := h.waitForCacheUpdate(
@@ -743,3 +1034,10 @@ func TestPodHelperWaitForCacheUpdate(t *testing.T) {
 		assert.Equal(t, beforeMetricVal+1, afterMetricVal)
 	})
 }
+
+func openCircuitBreaker() kubecommon.CircuitBreaker {
+	b := NewCircuitBreaker(100, 1, time.Minute, time.Minute)
+	_ = b.Allow()
+	b.Record(errors.New(""))
+	return b
+}
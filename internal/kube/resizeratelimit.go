@@ -0,0 +1,67 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	metricskubeclient "github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/kubeclient"
+	"k8s.io/client-go/transport"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+const resizeSubresourcePathSuffix = "/resize"
+
+// NewResizeRateLimitWrapper returns a transport.WrapperFunc that rate limits pod resize patches to qps with the supplied
+// burst. Other requests are passed through untouched. The returned function shares a single rate limiter between all
+// transports that it wraps.
+func NewResizeRateLimitWrapper(qps int, burst int) transport.WrapperFunc {
+	limiter := flowcontrol.NewTokenBucketRateLimiter(float32(qps), burst)
+
+	return func(delegate http.RoundTripper) http.RoundTripper {
+		return &resizeRateLimitRoundTripper{
+			delegate: delegate,
+			limiter:  limiter,
+		}
+	}
+}
+
+// resizeRateLimitRoundTripper is a http.RoundTripper that waits for limiter before delegating pod resize patches.
+type resizeRateLimitRoundTripper struct {
+	delegate http.RoundTripper
+	limiter  flowcontrol.RateLimiter
+}
+
+func (rt *resizeRateLimitRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodPatch && strings.HasSuffix(req.URL.Path, resizeSubresourcePathSuffix) {
+		start := time.Now()
+		err := rt.limiter.Wait(req.Context())
+		metricskubeclient.RateLimiterWait(req.Method, resourceFromPath(req.URL.Path)).Observe(time.Since(start).Seconds())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rt.delegate.RoundTrip(req)
+}
+
+// WrappedRoundTripper returns the delegate http.RoundTripper.
+func (rt *resizeRateLimitRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.delegate
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	metricskubeclient "github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/kubeclient"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/component-base/metrics/testutil"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewResizeRateLimitWrapper(t *testing.T) {
+	wrapper := NewResizeRateLimitWrapper(1, 1)
+	delegate := roundTripperFunc(func(*http.Request) (*http.Response, error) { return nil, nil })
+
+	rt1 := wrapper(delegate).(*resizeRateLimitRoundTripper)
+	rt2 := wrapper(delegate).(*resizeRateLimitRoundTripper)
+	assert.NotNil(t, rt1.limiter)
+	assert.Same(t, rt1.limiter, rt2.limiter)
+	assert.NotNil(t, rt1.WrappedRoundTripper())
+}
+
+func TestResizeRateLimitRoundTripperRoundTrip(t *testing.T) {
+	newRequest := func(ctx context.Context, method string, path string) *http.Request {
+		req, _ := http.NewRequestWithContext(ctx, method, "https://localhost"+path, nil)
+		return req
+	}
+	resizePath := "/api/v1/namespaces/namespace/pods/name/resize"
+
+	t.Run("NotResizePatch", func(t *testing.T) {
+		metricskubeclient.ResetMetrics()
+		calls := 0
+		rt := NewResizeRateLimitWrapper(1, 1)(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{}, nil
+		}))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		for _, req := range []*http.Request{
+			newRequest(ctx, http.MethodPatch, "/api/v1/namespaces/namespace/pods/name"),
+			newRequest(ctx, http.MethodGet, resizePath),
+		} {
+			_, err := rt.RoundTrip(req)
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, 2, calls)
+		value, _ := testutil.GetHistogramMetricCount(metricskubeclient.RateLimiterWait(http.MethodPatch, "pods"))
+		assert.Equal(t, uint64(0), value)
+	})
+
+	t.Run("ResizePatchPermitted", func(t *testing.T) {
+		metricskubeclient.ResetMetrics()
+		calls := 0
+		rt := NewResizeRateLimitWrapper(1, 1)(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{}, nil
+		}))
+
+		_, err := rt.RoundTrip(newRequest(context.Background(), http.MethodPatch, resizePath))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		value, _ := testutil.GetHistogramMetricCount(metricskubeclient.RateLimiterWait(http.MethodPatch, "pods/resize"))
+		assert.Equal(t, uint64(1), value)
+	})
+
+	t.Run("ResizePatchWaitFails", func(t *testing.T) {
+		metricskubeclient.ResetMetrics()
+		calls := 0
+		rt := NewResizeRateLimitWrapper(1, 1)(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{}, nil
+		}))
+		ctx, cancel := context.WithCancel(context.Background())
+
+		// Consume the burst, then wait with a cancelled context.
+		_, err := rt.RoundTrip(newRequest(ctx, http.MethodPatch, resizePath))
+		assert.NoError(t, err)
+		cancel()
+		_, err = rt.RoundTrip(newRequest(ctx, http.MethodPatch, resizePath))
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+		value, _ := testutil.GetHistogramMetricCount(metricskubeclient.RateLimiterWait(http.MethodPatch, "pods/resize"))
+		assert.Equal(t, uint64(2), value)
+	})
+}
@@ -20,9 +20,12 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
+	ccontext "github.com/ExpediaGroup/container-startup-autoscaler/internal/context"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
 	metricsretry "github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/retry"
+	csaretry "github.com/ExpediaGroup/container-startup-autoscaler/internal/retry"
 	"github.com/avast/retry-go/v4"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,11 +35,23 @@ import (
 func kubeApiRetryOptions(ctx context.Context) []retry.Option {
 	var opts []retry.Option
 
-	// Don't retry if it's a 'not found' error or not recoverable.
+	// Don't retry if it's a 'not found' error, a resource quota rejection or an eviction blocked by a pod disruption
+	// budget (both unlikely to be resolved within the retry period) or not recoverable.
 	opts = append(opts, retry.RetryIf(func(err error) bool {
-		return !kerrors.IsNotFound(err) && retry.IsRecoverable(err)
+		return !kerrors.IsNotFound(err) && !IsResourceQuotaExceededError(err) && !IsEvictionBlockedError(err) &&
+			retry.IsRecoverable(err)
 	}))
 
+	// Honour any delay requested by the Kube API upon a 429 response (per its 'Retry-After' header), otherwise delay per
+	// the standard retry strategy. client-go itself retries such responses per 'Retry-After', but returns the error once
+	// its own retries are exhausted - further retries here must also respect the Kube API's request so as to not add to
+	// its load, whilst capped so that a single retry can't block a reconcile indefinitely.
+	maxDelay := time.Duration(max(
+		ccontext.StandardRetryMaxDelaySecs(ctx),
+		ccontext.StandardRetryDelaySecs(ctx),
+	)) * time.Second
+	opts = append(opts, retry.DelayType(retryAfterDelayFunc(csaretry.StandardDelayFunc(ctx), maxDelay)))
+
 	// Log retry.
 	opts = append(opts, retry.OnRetry(func(n uint, err error) {
 		reason := "unknown"
@@ -57,3 +72,17 @@ func kubeApiRetryOptions(ctx context.Context) []retry.Option {
 
 	return opts
 }
+
+// retryAfterDelayFunc returns a function that returns the delay requested by the Kube API (capped at maxDelay) if the
+// error is a 429 response that specifies one, otherwise the delay returned by fallbackFunc.
+func retryAfterDelayFunc(fallbackFunc retry.DelayTypeFunc, maxDelay time.Duration) retry.DelayTypeFunc {
+	return func(n uint, err error, config *retry.Config) time.Duration {
+		if kerrors.IsTooManyRequests(err) {
+			if secs, ok := kerrors.SuggestsClientDelay(err); ok {
+				return min(time.Duration(secs)*time.Second, maxDelay)
+			}
+		}
+
+		return fallbackFunc(n, err, config)
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	"github.com/avast/retry-go/v4"
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestRetryAfterDelayFunc(t *testing.T) {
+	fallbackFunc := func(uint, error, *retry.Config) time.Duration { return time.Second }
+
+	tests := []struct {
+		name string
+		err  error
+		want time.Duration
+	}{
+		{"NotKubeError", errors.New(""), time.Second},
+		{"TooManyRequestsWithRetryAfter", kerrors.NewTooManyRequests("", 5), 5 * time.Second},
+		{
+			"TooManyRequestsWithRetryAfterWrapped",
+			common.WrapErrorf(kerrors.NewTooManyRequests("", 5), ""),
+			5 * time.Second,
+		},
+		{"TooManyRequestsWithRetryAfterCapped", kerrors.NewTooManyRequests("", 60), 10 * time.Second},
+		{"TooManyRequestsWithoutRetryAfter", kerrors.NewTooManyRequests("", 0), time.Second},
+		{"OtherWithRetryAfter", kerrors.NewServerTimeout(schema.GroupResource{}, "", 5), time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, retryAfterDelayFunc(fallbackFunc, 10*time.Second)(0, tt.err, nil))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// uncachedClient is a client.Client that reads directly from the Kube API rather than the informer cache. Writes are
+// delegated to the wrapped client.
+type uncachedClient struct {
+	client.Client
+	apiReader client.Reader
+}
+
+func NewUncachedClient(client client.Client, apiReader client.Reader) client.Client {
+	return &uncachedClient{
+		Client:    client,
+		apiReader: apiReader,
+	}
+}
+
+// Get retrieves the object with the supplied key directly from the Kube API.
+func (c *uncachedClient) Get(
+	ctx context.Context,
+	key client.ObjectKey,
+	obj client.Object,
+	opts ...client.GetOption,
+) error {
+	return c.apiReader.Get(ctx, key, obj, opts...)
+}
+
+// List retrieves the list of objects directly from the Kube API.
+func (c *uncachedClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.apiReader.List(ctx, list, opts...)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNewUncachedClient(t *testing.T) {
+	c := fake.NewClientBuilder().Build()
+	r := fake.NewClientBuilder().Build()
+	assert.Equal(t, &uncachedClient{Client: c, apiReader: r}, NewUncachedClient(c, r))
+}
+
+func TestUncachedClientGet(t *testing.T) {
+	pod := kubetest.NewPodBuilder().Build()
+	c := NewUncachedClient(fake.NewClientBuilder().Build(), fake.NewClientBuilder().WithObjects(pod).Build())
+
+	got := &v1.Pod{}
+	err := c.Get(context.TODO(), types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}, got)
+	assert.NoError(t, err)
+	assert.Equal(t, pod.Name, got.Name)
+}
+
+func TestUncachedClientList(t *testing.T) {
+	pod := kubetest.NewPodBuilder().Build()
+	c := NewUncachedClient(fake.NewClientBuilder().Build(), fake.NewClientBuilder().WithObjects(pod).Build())
+
+	got := &v1.PodList{}
+	err := c.List(context.TODO(), got)
+	assert.NoError(t, err)
+	assert.Len(t, got.Items, 1)
+}
@@ -23,6 +23,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"sync/atomic"
 
 	ccontext "github.com/ExpediaGroup/container-startup-autoscaler/internal/context"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
@@ -48,11 +49,11 @@ const (
 var (
 	DefaultW = os.Stdout
 	Logger   logr.Logger
-	CurrentV = DefaultV
 )
 
 var (
 	zLogger     zerolog.Logger
+	currentV    atomic.Int64
 	exitOnFatal = true
 )
 
@@ -79,7 +80,22 @@ func configureLogger(w io.Writer, v V, addCaller bool) {
 	}
 	zLogger = zLoggerCtx.Timestamp().Logger()
 	Logger = zerologr.New(&zLogger)
-	CurrentV = v
+	currentV.Store(int64(v))
+}
+
+// SetV sets the verbosity of the logger without otherwise reconfiguring it. DefaultV is used if v is invalid.
+func SetV(v V) {
+	if v < VInfo || v > VTrace {
+		v = DefaultV
+	}
+
+	zerologr.SetMaxV(int(v))
+	currentV.Store(int64(v))
+}
+
+// CurrentV returns the current verbosity of the logger.
+func CurrentV() V {
+	return V(currentV.Load())
 }
 
 // Errorf logs err with a formatted message.
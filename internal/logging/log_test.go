@@ -83,7 +83,7 @@ func TestInit(t *testing.T) {
 		buffer := &bytes.Buffer{}
 		Init(buffer, VDebug, true)
 		assert.Equal(t, int(zerolog.DebugLevel), int(zerolog.GlobalLevel()))
-		assert.Equal(t, VDebug, CurrentV)
+		assert.Equal(t, VDebug, CurrentV())
 		Infof(nil, VDebug, "test")
 		assert.Contains(t, buffer.String(), fmt.Sprintf("\"%s\":", zerolog.CallerFieldName))
 
@@ -94,7 +94,7 @@ func TestInit(t *testing.T) {
 		buffer := &bytes.Buffer{}
 		Init(buffer, VDebug, false)
 		assert.Equal(t, int(zerolog.DebugLevel), int(zerolog.GlobalLevel()))
-		assert.Equal(t, VDebug, CurrentV)
+		assert.Equal(t, VDebug, CurrentV())
 		Infof(nil, VDebug, "test")
 		assert.NotContains(t, buffer.String(), fmt.Sprintf("\"%s\":", zerolog.CallerFieldName))
 
@@ -102,6 +102,23 @@ func TestInit(t *testing.T) {
 	})
 }
 
+func TestSetV(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		SetV(VDebug)
+		assert.Equal(t, int(zerolog.DebugLevel), int(zerolog.GlobalLevel()))
+		assert.Equal(t, VDebug, CurrentV())
+
+		Init(DefaultW, testV, testAddCaller) // Reset.
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		SetV(V(3))
+		assert.Equal(t, DefaultV, CurrentV())
+
+		Init(DefaultW, testV, testAddCaller) // Reset.
+	})
+}
+
 func TestErrorf(t *testing.T) {
 	tests := []test{
 		{
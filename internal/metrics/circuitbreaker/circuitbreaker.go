@@ -0,0 +1,81 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package circuitbreaker
+
+import (
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/metricscommon"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	Subsystem = "circuitbreaker"
+)
+
+const (
+	stateName    = "state"
+	openedName   = "opened"
+	rejectedName = "rejected"
+)
+
+var (
+	state = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricscommon.Namespace,
+		Subsystem: Subsystem,
+		Name:      stateName,
+		Help:      "Whether the Kube API circuit breaker is in each state (1 for the current state, otherwise 0)",
+	}, []string{metricscommon.StateLabelName})
+
+	opened = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricscommon.Namespace,
+		Subsystem: Subsystem,
+		Name:      openedName,
+		Help:      "Number of times the Kube API circuit breaker opened",
+	}, []string{})
+
+	rejected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricscommon.Namespace,
+		Subsystem: Subsystem,
+		Name:      rejectedName,
+		Help:      "Number of Kube API calls failed fast as the circuit breaker was open",
+	}, []string{})
+)
+
+// allMetrics must include all metrics defined above.
+var allMetrics = []prometheus.Collector{
+	state, opened, rejected,
+}
+
+func RegisterMetrics(registry metrics.RegistererGatherer) {
+	registry.MustRegister(allMetrics...)
+}
+
+func ResetMetrics() {
+	metricscommon.ResetMetrics(allMetrics)
+}
+
+func State(stateValue string) prometheus.Gauge {
+	return state.WithLabelValues(stateValue)
+}
+
+func Opened() prometheus.Counter {
+	return opened.WithLabelValues()
+}
+
+func Rejected() prometheus.Counter {
+	return rejected.WithLabelValues()
+}
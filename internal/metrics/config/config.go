@@ -0,0 +1,57 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/metricscommon"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	Subsystem = "config"
+)
+
+const (
+	reloadName = "reload"
+)
+
+var (
+	reload = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricscommon.Namespace,
+		Subsystem: Subsystem,
+		Name:      reloadName,
+		Help:      "Number of configuration reloads (by outcome)",
+	}, []string{metricscommon.OutcomeLabelName})
+)
+
+// allMetrics must include all metrics defined above.
+var allMetrics = []prometheus.Collector{
+	reload,
+}
+
+func RegisterMetrics(registry metrics.RegistererGatherer) {
+	registry.MustRegister(allMetrics...)
+}
+
+func ResetMetrics() {
+	metricscommon.ResetMetrics(allMetrics)
+}
+
+func Reload(outcome metricscommon.Outcome) prometheus.Counter {
+	return reload.WithLabelValues(string(outcome))
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeclient
+
+import (
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/metricscommon"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	Subsystem = "kubeclient"
+)
+
+const (
+	requestLatencyName  = "request_latency_seconds"
+	rateLimiterWaitName = "rate_limiter_wait_seconds"
+)
+
+var (
+	requestLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricscommon.Namespace,
+		Subsystem: Subsystem,
+		Name:      requestLatencyName,
+		Help:      "Kube API request latency in seconds (by verb, resource)",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, []string{metricscommon.VerbLabelName, metricscommon.ResourceLabelName})
+
+	rateLimiterWait = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricscommon.Namespace,
+		Subsystem: Subsystem,
+		Name:      rateLimiterWaitName,
+		Help:      "Time Kube API requests waited for client-side rate limiting in seconds (by verb, resource)",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, []string{metricscommon.VerbLabelName, metricscommon.ResourceLabelName})
+)
+
+// allMetrics must include all metrics defined above.
+var allMetrics = []prometheus.Collector{
+	requestLatency, rateLimiterWait,
+}
+
+func RegisterMetrics(registry metrics.RegistererGatherer) {
+	registry.MustRegister(allMetrics...)
+}
+
+func ResetMetrics() {
+	metricscommon.ResetMetrics(allMetrics)
+}
+
+func RequestLatency(verb string, resource string) prometheus.Observer {
+	return requestLatency.WithLabelValues(verb, resource)
+}
+
+func RateLimiterWait(verb string, resource string) prometheus.Observer {
+	return rateLimiterWait.WithLabelValues(verb, resource)
+}
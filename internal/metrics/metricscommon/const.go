@@ -22,6 +22,9 @@ const (
 	DirectionLabelName = "direction"
 	OutcomeLabelName   = "outcome"
 	ReasonLabelName    = "reason"
+	ResourceLabelName  = "resource"
+	StateLabelName     = "state"
+	VerbLabelName      = "verb"
 )
 
 // Direction indicates the direction of a scale.
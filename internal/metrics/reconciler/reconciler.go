@@ -41,6 +41,8 @@ const (
 	FailureReasonValidation          = FailureReason("validation")
 	FailureReasonStatesDetermination = FailureReason("states_determination")
 	FailureReasonStatesAction        = FailureReason("states_action")
+	FailureReasonHandBack            = FailureReason("hand_back")
+	FailureReasonControl             = FailureReason("control")
 )
 
 var (
@@ -48,7 +50,7 @@ var (
 		Namespace: metricscommon.Namespace,
 		Subsystem: Subsystem,
 		Name:      skippedOnlyStatusChangeName,
-		Help:      "Number of reconciles that were skipped because only the status changed",
+		Help:      "Number of reconciles that were skipped because only the status (or fields not depended upon) changed",
 	}, []string{})
 
 	existingInProgress = prometheus.NewCounterVec(prometheus.CounterOpts{
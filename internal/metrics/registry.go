@@ -17,10 +17,14 @@ limitations under the License.
 package metrics
 
 import (
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/circuitbreaker"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/config"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/informercache"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/kubeclient"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/reconciler"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/retry"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/scale"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/shard"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
@@ -30,4 +34,8 @@ func RegisterAllMetrics(registry metrics.RegistererGatherer) {
 	retry.RegisterKubeApiMetrics(registry)
 	scale.RegisterMetrics(registry)
 	informercache.RegisterMetrics(registry)
+	config.RegisterMetrics(registry)
+	shard.RegisterMetrics(registry)
+	circuitbreaker.RegisterMetrics(registry)
+	kubeclient.RegisterMetrics(registry)
 }
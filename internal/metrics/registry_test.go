@@ -22,11 +22,15 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/circuitbreaker"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/config"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/informercache"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/kubeclient"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/metricscommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/reconciler"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/retry"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/scale"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/shard"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 )
@@ -35,17 +39,23 @@ func TestRegisterAllMetrics(t *testing.T) {
 	registry := prometheus.NewRegistry()
 	RegisterAllMetrics(registry)
 
-	gotReconciler, gotRetryKubeapi, gotScale, gotInformerCache := gotSubsystems(registry)
+	gotReconciler, gotRetryKubeapi, gotScale, gotInformerCache, gotConfig, gotShard, gotCircuitBreaker, gotKubeClient :=
+		gotSubsystems(registry)
 	assert.True(t, gotReconciler)
 	assert.True(t, gotScale)
 	assert.True(t, gotRetryKubeapi)
 	assert.True(t, gotInformerCache)
+	assert.True(t, gotConfig)
+	assert.True(t, gotShard)
+	assert.True(t, gotCircuitBreaker)
+	assert.True(t, gotKubeClient)
 }
 
-func gotSubsystems(registry *prometheus.Registry) (bool, bool, bool, bool) {
+func gotSubsystems(registry *prometheus.Registry) (bool, bool, bool, bool, bool, bool, bool, bool) {
 	descCh := make(chan *prometheus.Desc)
 	doneCh := make(chan struct{})
-	gotReconciler, gotRetryKubeapi, gotScale, gotInformerCache := false, false, false, false
+	gotReconciler, gotRetryKubeapi, gotScale, gotInformerCache, gotConfig, gotShard, gotCircuitBreaker, gotKubeClient :=
+		false, false, false, false, false, false, false, false
 
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -71,6 +81,22 @@ func gotSubsystems(registry *prometheus.Registry) (bool, bool, bool, bool) {
 					gotInformerCache = true
 				}
 
+				if strings.Contains(desc.String(), fmt.Sprintf("%s_%s", metricscommon.Namespace, config.Subsystem)) {
+					gotConfig = true
+				}
+
+				if strings.Contains(desc.String(), fmt.Sprintf("%s_%s", metricscommon.Namespace, shard.Subsystem)) {
+					gotShard = true
+				}
+
+				if strings.Contains(desc.String(), fmt.Sprintf("%s_%s", metricscommon.Namespace, circuitbreaker.Subsystem)) {
+					gotCircuitBreaker = true
+				}
+
+				if strings.Contains(desc.String(), fmt.Sprintf("%s_%s", metricscommon.Namespace, kubeclient.Subsystem)) {
+					gotKubeClient = true
+				}
+
 			case <-doneCh:
 				return
 			}
@@ -80,5 +106,6 @@ func gotSubsystems(registry *prometheus.Registry) (bool, bool, bool, bool) {
 	registry.Describe(descCh)
 	doneCh <- struct{}{}
 	wg.Wait()
-	return gotReconciler, gotRetryKubeapi, gotScale, gotInformerCache
+	return gotReconciler, gotRetryKubeapi, gotScale, gotInformerCache, gotConfig, gotShard, gotCircuitBreaker,
+		gotKubeClient
 }
@@ -27,9 +27,14 @@ const (
 )
 
 const (
-	failureName             = "failure"
-	commandedUnknownResName = "commanded_unknown_resources"
-	durationName            = "duration_seconds"
+	failureName               = "failure"
+	commandedUnknownResName   = "commanded_unknown_resources"
+	commandedReconfiguredName = "commanded_reconfigured"
+	suppressedName            = "suppressed"
+	dryRunCommandedName       = "dry_run_commanded"
+	evictedName               = "evicted"
+	durationName              = "duration_seconds"
+	surplusBudgetUsageName    = "node_startup_surplus_budget_usage_ratio"
 )
 
 var (
@@ -47,6 +52,34 @@ var (
 		Help:      "Number of scales commanded upon encountering unknown resources",
 	}, []string{})
 
+	commandedReconfigured = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricscommon.Namespace,
+		Subsystem: Subsystem,
+		Name:      commandedReconfiguredName,
+		Help:      "Number of scales commanded upon encountering changed configuration",
+	}, []string{})
+
+	suppressed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricscommon.Namespace,
+		Subsystem: Subsystem,
+		Name:      suppressedName,
+		Help:      "Number of scales suppressed by the kill switch, blackout windows, insufficient node capacity, insufficient resource quota, the node startup surplus budget, node upscale ordering or the workload concurrent startup limit (by scale direction, reason)",
+	}, []string{metricscommon.DirectionLabelName, metricscommon.ReasonLabelName})
+
+	dryRunCommanded = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricscommon.Namespace,
+		Subsystem: Subsystem,
+		Name:      dryRunCommandedName,
+		Help:      "Number of scales that would have been commanded if not dry-running (by scale direction)",
+	}, []string{metricscommon.DirectionLabelName})
+
+	evicted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricscommon.Namespace,
+		Subsystem: Subsystem,
+		Name:      evictedName,
+		Help:      "Number of pods evicted as startup resources couldn't be enacted (by reason)",
+	}, []string{metricscommon.ReasonLabelName})
+
 	duration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Namespace: metricscommon.Namespace,
 		Subsystem: Subsystem,
@@ -54,11 +87,20 @@ var (
 		Help:      "Scale duration (from commanded to enacted) in seconds (by scale direction, outcome)",
 		Buckets:   []float64{1, 2, 4, 8, 16, 32, 64, 128},
 	}, []string{metricscommon.DirectionLabelName, metricscommon.OutcomeLabelName})
+
+	surplusBudgetUsage = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricscommon.Namespace,
+		Subsystem: Subsystem,
+		Name:      surplusBudgetUsageName,
+		Help:      "Ratio of the node startup surplus budget that commanding startup resources would use (by resource)",
+		Buckets:   []float64{0.25, 0.5, 0.75, 0.9, 1, 1.25, 1.5, 2},
+	}, []string{metricscommon.ResourceLabelName})
 )
 
 // allMetrics must include all metrics defined above.
 var allMetrics = []prometheus.Collector{
-	failure, commandedUnknownRes, duration,
+	failure, commandedUnknownRes, commandedReconfigured, suppressed, dryRunCommanded, evicted, duration,
+	surplusBudgetUsage,
 }
 
 func RegisterMetrics(registry metrics.RegistererGatherer) {
@@ -77,6 +119,26 @@ func CommandedUnknownRes() prometheus.Counter {
 	return commandedUnknownRes.WithLabelValues()
 }
 
+func CommandedReconfigured() prometheus.Counter {
+	return commandedReconfigured.WithLabelValues()
+}
+
+func Suppressed(direction metricscommon.Direction, reason string) prometheus.Counter {
+	return suppressed.WithLabelValues(string(direction), reason)
+}
+
+func DryRunCommanded(direction metricscommon.Direction) prometheus.Counter {
+	return dryRunCommanded.WithLabelValues(string(direction))
+}
+
+func Evicted(reason string) prometheus.Counter {
+	return evicted.WithLabelValues(reason)
+}
+
 func Duration(direction metricscommon.Direction, outcome metricscommon.Outcome) prometheus.Observer {
 	return duration.WithLabelValues(string(direction), string(outcome))
 }
+
+func SurplusBudgetUsage(resource string) prometheus.Observer {
+	return surplusBudgetUsage.WithLabelValues(resource)
+}
@@ -61,6 +61,42 @@ func TestCommandedUnknownRes(t *testing.T) {
 	)
 }
 
+func TestCommandedReconfigured(t *testing.T) {
+	m := CommandedReconfigured()
+	assert.Contains(
+		t,
+		m.Desc().String(),
+		fmt.Sprintf("%s_%s_%s", metricscommon.Namespace, Subsystem, commandedReconfiguredName),
+	)
+}
+
+func TestSuppressed(t *testing.T) {
+	m := Suppressed("", "")
+	assert.Contains(
+		t,
+		m.Desc().String(),
+		fmt.Sprintf("%s_%s_%s", metricscommon.Namespace, Subsystem, suppressedName),
+	)
+}
+
+func TestDryRunCommanded(t *testing.T) {
+	m := DryRunCommanded("")
+	assert.Contains(
+		t,
+		m.Desc().String(),
+		fmt.Sprintf("%s_%s_%s", metricscommon.Namespace, Subsystem, dryRunCommandedName),
+	)
+}
+
+func TestEvicted(t *testing.T) {
+	m := Evicted("")
+	assert.Contains(
+		t,
+		m.Desc().String(),
+		fmt.Sprintf("%s_%s_%s", metricscommon.Namespace, Subsystem, evictedName),
+	)
+}
+
 func TestDuration(t *testing.T) {
 	m := Duration("", "").(prometheus.Metric)
 	assert.Contains(
@@ -70,6 +106,15 @@ func TestDuration(t *testing.T) {
 	)
 }
 
+func TestSurplusBudgetUsage(t *testing.T) {
+	m := SurplusBudgetUsage("").(prometheus.Metric)
+	assert.Contains(
+		t,
+		m.Desc().String(),
+		fmt.Sprintf("%s_%s_%s", metricscommon.Namespace, Subsystem, surplusBudgetUsageName),
+	)
+}
+
 func descs(registry *prometheus.Registry) []string {
 	ch := make(chan *prometheus.Desc)
 	done := make(chan struct{})
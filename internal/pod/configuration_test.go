@@ -28,7 +28,7 @@ import (
 )
 
 func TestNewConfiguration(t *testing.T) {
-	podHelper := kube.NewPodHelper(nil)
+	podHelper := kube.NewPodHelper(nil, kube.NewDisabledCircuitBreaker())
 	containerHelper := kube.NewContainerHelper()
 	config := newConfiguration(podHelper, containerHelper)
 	expected := &configuration{
@@ -48,3 +48,140 @@ func (e validationError) Error() string {
 func (e validationError) Unwrap() error {
 	return e.wrapped
 }
+
+// RequeueableError is an error that indicates the condition preventing action is expected to clear over time, so the
+// pod should be requeued with backoff rather than treated as failed.
+type RequeueableError interface {
+	error
+
+	// RequeueReason returns a human-readable reason for requeuing.
+	RequeueReason() string
+}
+
+// InsufficientNodeCapacityError is an error that indicates startup resources weren't commanded as the pod's node has
+// insufficient free capacity.
+type InsufficientNodeCapacityError struct {
+	message string
+}
+
+func NewInsufficientNodeCapacityError(message string) error {
+	return InsufficientNodeCapacityError{message: message}
+}
+
+func (e InsufficientNodeCapacityError) Error() string {
+	return "insufficient node capacity: " + e.message
+}
+
+// RequeueReason implements RequeueableError. Free node capacity may change as other pods terminate or scale down.
+func (e InsufficientNodeCapacityError) RequeueReason() string {
+	return "insufficient node capacity for startup resources"
+}
+
+// StartupSurplusBudgetExceededError is an error that indicates startup resources weren't commanded as doing so would
+// exceed the node startup surplus budget.
+type StartupSurplusBudgetExceededError struct {
+	message string
+}
+
+func NewStartupSurplusBudgetExceededError(message string) error {
+	return StartupSurplusBudgetExceededError{message: message}
+}
+
+func (e StartupSurplusBudgetExceededError) Error() string {
+	return "startup surplus budget exceeded: " + e.message
+}
+
+// RequeueReason implements RequeueableError. Budget is released as other pods on the node are commanded post-startup resources.
+func (e StartupSurplusBudgetExceededError) RequeueReason() string {
+	return "node startup surplus budget exceeded"
+}
+
+// WorkloadStartupLimitReachedError is an error that indicates startup resources weren't commanded as the maximum number
+// of pods of the same workload concurrently holding startup resources has been reached.
+type WorkloadStartupLimitReachedError struct {
+	message string
+}
+
+func NewWorkloadStartupLimitReachedError(message string) error {
+	return WorkloadStartupLimitReachedError{message: message}
+}
+
+func (e WorkloadStartupLimitReachedError) Error() string {
+	return "workload startup limit reached: " + e.message
+}
+
+// RequeueReason implements RequeueableError. Slots are released as other pods of the workload are commanded post-startup resources.
+func (e WorkloadStartupLimitReachedError) RequeueReason() string {
+	return "workload startup limit reached"
+}
+
+// NodeUpscaleDeferredError is an error that indicates startup resources weren't commanded as other pods waiting for
+// startup resources on the same node rank ahead.
+type NodeUpscaleDeferredError struct {
+	message string
+}
+
+func NewNodeUpscaleDeferredError(message string) error {
+	return NodeUpscaleDeferredError{message: message}
+}
+
+func (e NodeUpscaleDeferredError) Error() string {
+	return "node upscale deferred: " + e.message
+}
+
+// RequeueReason implements RequeueableError. Pods that rank ahead are upscaled (or expire) over time.
+func (e NodeUpscaleDeferredError) RequeueReason() string {
+	return "node upscale deferred to pods that rank ahead"
+}
+
+// InsufficientResourceQuotaError is an error that indicates startup resources weren't commanded, or were rejected, as
+// a resource quota within the pod's namespace would be exceeded.
+type InsufficientResourceQuotaError struct {
+	message string
+}
+
+func NewInsufficientResourceQuotaError(message string) error {
+	return InsufficientResourceQuotaError{message: message}
+}
+
+func (e InsufficientResourceQuotaError) Error() string {
+	return "insufficient resource quota: " + e.message
+}
+
+// RequeueReason implements RequeueableError. Quota headroom is released as other pods in the namespace terminate or scale down.
+func (e InsufficientResourceQuotaError) RequeueReason() string {
+	return "insufficient resource quota for startup resources"
+}
+
+// EvictionFallbackPendingError is an error that indicates a pod that opts into eviction fallback is yet to be evicted,
+// either since startup resources haven't been deferred for long enough, or since the eviction couldn't be performed.
+type EvictionFallbackPendingError struct {
+	message string
+}
+
+func NewEvictionFallbackPendingError(message string) error {
+	return EvictionFallbackPendingError{message: message}
+}
+
+func (e EvictionFallbackPendingError) Error() string {
+	return "eviction fallback pending: " + e.message
+}
+
+// RequeueReason implements RequeueableError. The deferred timeout elapses, and cooldowns and pod disruption budgets permit eviction, over time.
+func (e EvictionFallbackPendingError) RequeueReason() string {
+	return "eviction fallback pending"
+}
+
+// HandBackSuppressedError is an error that indicates a pod for which CSA has been disabled can't yet be handed back,
+// since commanding its final resources is currently suppressed cluster-wide.
+type HandBackSuppressedError struct {
+	message string
+}
+
+func NewHandBackSuppressedError(message string) error {
+	return HandBackSuppressedError{message: message}
+}
+
+func (e HandBackSuppressedError) Error() string {
+	return "hand back suppressed: " + e.message
+}
@@ -46,3 +46,73 @@ func TestValidationErrorError(t *testing.T) {
 		assert.Equal(t, "validation error: test", e.Error())
 	})
 }
+
+func TestNewInsufficientNodeCapacityError(t *testing.T) {
+	err := NewInsufficientNodeCapacityError("test")
+	assert.Equal(t, InsufficientNodeCapacityError{message: "test"}, err)
+}
+
+func TestInsufficientNodeCapacityErrorError(t *testing.T) {
+	e := NewInsufficientNodeCapacityError("test")
+	assert.Equal(t, "insufficient node capacity: test", e.Error())
+}
+
+func TestNewStartupSurplusBudgetExceededError(t *testing.T) {
+	err := NewStartupSurplusBudgetExceededError("test")
+	assert.Equal(t, StartupSurplusBudgetExceededError{message: "test"}, err)
+}
+
+func TestStartupSurplusBudgetExceededErrorError(t *testing.T) {
+	e := NewStartupSurplusBudgetExceededError("test")
+	assert.Equal(t, "startup surplus budget exceeded: test", e.Error())
+}
+
+func TestNewWorkloadStartupLimitReachedError(t *testing.T) {
+	err := NewWorkloadStartupLimitReachedError("test")
+	assert.Equal(t, WorkloadStartupLimitReachedError{message: "test"}, err)
+}
+
+func TestWorkloadStartupLimitReachedErrorError(t *testing.T) {
+	e := NewWorkloadStartupLimitReachedError("test")
+	assert.Equal(t, "workload startup limit reached: test", e.Error())
+}
+
+func TestNewNodeUpscaleDeferredError(t *testing.T) {
+	err := NewNodeUpscaleDeferredError("test")
+	assert.Equal(t, NodeUpscaleDeferredError{message: "test"}, err)
+}
+
+func TestNodeUpscaleDeferredErrorError(t *testing.T) {
+	e := NewNodeUpscaleDeferredError("test")
+	assert.Equal(t, "node upscale deferred: test", e.Error())
+}
+
+func TestNewInsufficientResourceQuotaError(t *testing.T) {
+	err := NewInsufficientResourceQuotaError("test")
+	assert.Equal(t, InsufficientResourceQuotaError{message: "test"}, err)
+}
+
+func TestInsufficientResourceQuotaErrorError(t *testing.T) {
+	e := NewInsufficientResourceQuotaError("test")
+	assert.Equal(t, "insufficient resource quota: test", e.Error())
+}
+
+func TestNewEvictionFallbackPendingError(t *testing.T) {
+	err := NewEvictionFallbackPendingError("test")
+	assert.Equal(t, EvictionFallbackPendingError{message: "test"}, err)
+}
+
+func TestEvictionFallbackPendingErrorError(t *testing.T) {
+	e := NewEvictionFallbackPendingError("test")
+	assert.Equal(t, "eviction fallback pending: test", e.Error())
+}
+
+func TestNewHandBackSuppressedError(t *testing.T) {
+	err := NewHandBackSuppressedError("test")
+	assert.Equal(t, HandBackSuppressedError{message: "test"}, err)
+}
+
+func TestHandBackSuppressedErrorError(t *testing.T) {
+	e := NewHandBackSuppressedError("test")
+	assert.Equal(t, "hand back suppressed: test", e.Error())
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	ccontext "github.com/ExpediaGroup/container-startup-autoscaler/internal/context"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// evictionFallbackLeasePrefix is the name prefix of the Leases that record when a pod of each workload was last evicted.
+const evictionFallbackLeasePrefix = "csa-eviction-"
+
+// evictionFallback is the default implementation of podcommon.EvictionFallback. Evictions are limited per workload so
+// that eviction loops are prevented (e.g. where recreated pods are repeatedly scheduled to nodes that can't accommodate
+// startup resources). When a pod of each workload was last evicted is recorded within a Lease in the workload's
+// namespace, so that cooldowns survive restarts and leader changes, and apply across replicas (e.g. when sharding or
+// node-local). The Lease is claimed using optimistic concurrency before evicting, so only one replica evicts per
+// cooldown. Leases aren't claimed when dry-running, so that dry runs don't start cooldowns.
+type evictionFallback struct {
+	podHelper kubecommon.PodHelper
+	client    client.Client
+	apiReader client.Reader
+	cooldown  time.Duration
+	now       func() time.Time
+}
+
+func newEvictionFallback(
+	controllerConfig controllercommon.ControllerConfig,
+	podHelper kubecommon.PodHelper,
+	client client.Client,
+	apiReader client.Reader,
+) *evictionFallback {
+	return &evictionFallback{
+		podHelper: podHelper,
+		client:    client,
+		apiReader: apiReader,
+		cooldown:  controllerConfig.EvictionFallbackCooldownDuration(),
+		now:       time.Now,
+	}
+}
+
+// Evict evicts the supplied pod, returning whether it was evicted. Pods aren't evicted if another pod of the same
+// workload was evicted within the cooldown, in which case the remaining cooldown is also returned. Pods that aren't
+// controlled by a workload are never evicted since they wouldn't be recreated.
+func (e *evictionFallback) Evict(ctx context.Context, pod *v1.Pod) (bool, time.Duration, error) {
+	key := workloadKeyFor(pod)
+	if key == "" {
+		return false, 0, errors.New("pod not controlled by a workload")
+	}
+
+	lease, previous, remaining, err := e.claim(ctx, pod, key)
+	if err != nil {
+		return false, 0, err
+	}
+
+	if remaining > 0 {
+		return false, remaining, nil
+	}
+
+	if err = e.podHelper.Evict(ctx, pod); err != nil {
+		if lease != nil {
+			e.unclaim(ctx, lease, previous)
+		}
+
+		return false, 0, common.WrapErrorf(err, "unable to evict pod")
+	}
+
+	return true, 0, nil
+}
+
+// claim claims the eviction Lease of the supplied pod's workload (with the supplied key) by setting its renew time to
+// now, unless the cooldown since it was last renewed hasn't elapsed - in which case, the remaining cooldown is returned.
+// Returns the claimed Lease along with its previous renew time. Nothing is claimed if there's no cooldown or when
+// dry-running.
+func (e *evictionFallback) claim(
+	ctx context.Context,
+	pod *v1.Pod,
+	key string,
+) (*coordinationv1.Lease, *metav1.MicroTime, time.Duration, error) {
+	if e.cooldown == 0 || ccontext.DryRun(ctx) {
+		return nil, nil, 0, nil
+	}
+
+	now := metav1.NewMicroTime(e.now())
+	cooldownSecs := int32(e.cooldown.Seconds())
+	name := types.NamespacedName{Namespace: pod.Namespace, Name: evictionFallbackLeaseName(key)}
+
+	lease := &coordinationv1.Lease{}
+	err := e.apiReader.Get(ctx, name, lease)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return nil, nil, 0, common.WrapErrorf(err, "unable to get eviction lease")
+		}
+
+		ownerReferences, err := e.leaseOwnerReferences(ctx, pod, key)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       name.Namespace,
+				Name:            name.Name,
+				OwnerReferences: ownerReferences,
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &key,
+				LeaseDurationSeconds: &cooldownSecs,
+				RenewTime:            &now,
+			},
+		}
+		if err = e.client.Create(ctx, lease); err != nil {
+			if kerrors.IsAlreadyExists(err) {
+				// Another replica has just evicted a pod of the workload.
+				return nil, nil, e.cooldown, nil
+			}
+
+			return nil, nil, 0, common.WrapErrorf(err, "unable to create eviction lease")
+		}
+
+		return lease, nil, 0, nil
+	}
+
+	if lease.Spec.RenewTime != nil {
+		if elapsed := now.Sub(lease.Spec.RenewTime.Time); elapsed < e.cooldown {
+			return nil, nil, e.cooldown - elapsed, nil
+		}
+	}
+
+	previous := lease.Spec.RenewTime
+	lease.Spec.LeaseDurationSeconds = &cooldownSecs
+	lease.Spec.RenewTime = &now
+	if err = e.client.Update(ctx, lease); err != nil {
+		if kerrors.IsConflict(err) {
+			// Another replica has just evicted a pod of the workload.
+			return nil, nil, e.cooldown, nil
+		}
+
+		return nil, nil, 0, common.WrapErrorf(err, "unable to update eviction lease")
+	}
+
+	return lease, previous, 0, nil
+}
+
+// unclaim restores the renew time of the supplied claimed Lease to previous, so that the cooldown doesn't apply to a
+// failed eviction.
+func (e *evictionFallback) unclaim(ctx context.Context, lease *coordinationv1.Lease, previous *metav1.MicroTime) {
+	lease.Spec.RenewTime = previous
+	if err := e.client.Update(ctx, lease); err != nil {
+		logging.Errorf(ctx, err, "unable to unclaim eviction lease (cooldown will apply)")
+	}
+}
+
+// evictionFallbackLeaseName returns the name of the eviction Lease of the workload with the supplied key.
+func evictionFallbackLeaseName(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return evictionFallbackLeasePrefix + hex.EncodeToString(sum[:])[:16]
+}
+
+// leaseOwnerReferences returns the owner references of the eviction Lease of the supplied pod's workload (with the
+// supplied key), so that the Lease is garbage collected along with the workload. Since pods of a Deployment are keyed
+// by the Deployment, such Leases are owned by the Deployment (the controlling owner of the pod's ReplicaSet) rather
+// than the ReplicaSet, which may be garbage collected following a rollout.
+func (e *evictionFallback) leaseOwnerReferences(
+	ctx context.Context,
+	pod *v1.Pod,
+	key string,
+) ([]metav1.OwnerReference, error) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return nil, nil
+	}
+
+	if strings.Split(key, "/")[1] == "Deployment" {
+		replicaSet := &metav1.PartialObjectMetadata{}
+		replicaSet.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("ReplicaSet"))
+		replicaSetName := types.NamespacedName{Namespace: pod.Namespace, Name: owner.Name}
+		if err := e.apiReader.Get(ctx, replicaSetName, replicaSet); err != nil {
+			return nil, common.WrapErrorf(err, "unable to get replicaset")
+		}
+
+		if owner = metav1.GetControllerOf(replicaSet); owner == nil {
+			return nil, fmt.Errorf("replicaset '%s' not controlled by a deployment", replicaSetName)
+		}
+	}
+
+	return []metav1.OwnerReference{{
+		APIVersion: owner.APIVersion,
+		Kind:       owner.Kind,
+		Name:       owner.Name,
+		UID:        owner.UID,
+	}}, nil
+}
+
+// evictionFallbackFor returns whether the supplied pod opts into eviction fallback, as indicated by its annotations.
+func evictionFallbackFor(pod *v1.Pod) (bool, error) {
+	value, present := pod.Annotations[kubecommon.AnnotationEvictionFallback]
+	if !present {
+		return false, nil
+	}
+
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf(
+			"'%s' annotation value must be a boolean ('%s')",
+			kubecommon.AnnotationEvictionFallback, value,
+		)
+	}
+
+	return enabled, nil
+}
@@ -0,0 +1,347 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/context/contexttest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestNewEvictionFallback(t *testing.T) {
+	podHelper := kube.NewPodHelper(nil, kube.NewDisabledCircuitBreaker())
+	c := fake.NewClientBuilder().Build()
+	fallback := newEvictionFallback(controllercommon.ControllerConfig{EvictionFallbackCooldownSecs: 1}, podHelper, c, c)
+	assert.Equal(t, podHelper, fallback.podHelper)
+	assert.Equal(t, c, fallback.client)
+	assert.Equal(t, c, fallback.apiReader)
+	assert.Equal(t, time.Second, fallback.cooldown)
+	assert.NotNil(t, fallback.now)
+}
+
+func TestEvictionFallbackEvict(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	nowFunc := func() time.Time { return now }
+	leaseName := types.NamespacedName{
+		Namespace: "namespace",
+		Name:      evictionFallbackLeaseName("namespace/Deployment/deployment"),
+	}
+	config := controllercommon.ControllerConfig{EvictionFallbackCooldownSecs: 60}
+	lease := func(renewTime *time.Time) *coordinationv1.Lease {
+		ret := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Namespace: leaseName.Namespace, Name: leaseName.Name}}
+		if renewTime != nil {
+			renew := metav1.NewMicroTime(*renewTime)
+			ret.Spec.RenewTime = &renew
+		}
+		return ret
+	}
+	renewTimeOf := func(t *testing.T, c client.Client) *time.Time {
+		got := &coordinationv1.Lease{}
+		assert.NoError(t, c.Get(context.Background(), leaseName, got))
+		if got.Spec.RenewTime == nil {
+			return nil
+		}
+		return &got.Spec.RenewTime.Time
+	}
+	ago := func(d time.Duration) *time.Time {
+		ret := now.Add(-d)
+		return &ret
+	}
+	isController := true
+	replicaSet := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "namespace",
+			Name:      "deployment-abc",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "deployment",
+				UID:        "deployment-uid",
+				Controller: &isController,
+			}},
+		},
+	}
+
+	t.Run("NotControlled", func(t *testing.T) {
+		mockPodHelper := kubetest.NewMockPodHelper(nil)
+		c := fake.NewClientBuilder().Build()
+		e := newEvictionFallback(config, mockPodHelper, c, c)
+
+		evicted, _, err := e.Evict(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), &v1.Pod{})
+		assert.EqualError(t, err, "pod not controlled by a workload")
+		assert.False(t, evicted)
+		mockPodHelper.AssertNotCalled(t, "Evict", mock.Anything, mock.Anything)
+	})
+
+	t.Run("NoCooldown", func(t *testing.T) {
+		mockPodHelper := kubetest.NewMockPodHelper(nil)
+		c := fake.NewClientBuilder().Build()
+		e := newEvictionFallback(controllercommon.ControllerConfig{}, mockPodHelper, c, c)
+
+		evicted, _, err := e.Evict(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod1", true),
+		)
+		assert.NoError(t, err)
+		assert.True(t, evicted)
+		assert.True(t, kerrors.IsNotFound(c.Get(context.Background(), leaseName, &coordinationv1.Lease{})))
+	})
+
+	t.Run("UnableToGetLease", func(t *testing.T) {
+		mockPodHelper := kubetest.NewMockPodHelper(nil)
+		c := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{Get: kubetest.InterceptorFuncGetFail()}).Build()
+		e := newEvictionFallback(config, mockPodHelper, c, c)
+
+		evicted, _, err := e.Evict(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod1", true),
+		)
+		assert.ErrorContains(t, err, "unable to get eviction lease")
+		assert.False(t, evicted)
+		mockPodHelper.AssertNotCalled(t, "Evict", mock.Anything, mock.Anything)
+	})
+
+	t.Run("InCooldown", func(t *testing.T) {
+		mockPodHelper := kubetest.NewMockPodHelper(nil)
+		c := fake.NewClientBuilder().WithObjects(lease(ago(20 * time.Second))).Build()
+		e := newEvictionFallback(config, mockPodHelper, c, c)
+		e.now = nowFunc
+
+		evicted, remaining, err := e.Evict(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod1", true),
+		)
+		assert.NoError(t, err)
+		assert.False(t, evicted)
+		assert.Equal(t, 40*time.Second, remaining)
+		mockPodHelper.AssertNotCalled(t, "Evict", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ClaimedByAnotherReplicaUponCreate", func(t *testing.T) {
+		mockPodHelper := kubetest.NewMockPodHelper(nil)
+		c := fake.NewClientBuilder().WithObjects(replicaSet).WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+				return kerrors.NewAlreadyExists(schema.GroupResource{}, obj.GetName())
+			},
+		}).Build()
+		e := newEvictionFallback(config, mockPodHelper, c, c)
+
+		evicted, remaining, err := e.Evict(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod1", true),
+		)
+		assert.NoError(t, err)
+		assert.False(t, evicted)
+		assert.Equal(t, time.Minute, remaining)
+		mockPodHelper.AssertNotCalled(t, "Evict", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ClaimedByAnotherReplicaUponUpdate", func(t *testing.T) {
+		mockPodHelper := kubetest.NewMockPodHelper(nil)
+		c := fake.NewClientBuilder().WithObjects(lease(ago(time.Minute))).WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.UpdateOption) error {
+				return kerrors.NewConflict(schema.GroupResource{}, obj.GetName(), errors.New(""))
+			},
+		}).Build()
+		e := newEvictionFallback(config, mockPodHelper, c, c)
+		e.now = nowFunc
+
+		evicted, remaining, err := e.Evict(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod1", true),
+		)
+		assert.NoError(t, err)
+		assert.False(t, evicted)
+		assert.Equal(t, time.Minute, remaining)
+		mockPodHelper.AssertNotCalled(t, "Evict", mock.Anything, mock.Anything)
+	})
+
+	t.Run("UnableToEvictCreatedLeaseUnclaimed", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithObjects(replicaSet).Build()
+		e := newEvictionFallback(config, kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {
+			m.On("Evict", mock.Anything, mock.Anything).Return(errors.New(""))
+		}), c, c)
+		e.now = nowFunc
+
+		evicted, _, err := e.Evict(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod1", true),
+		)
+		assert.ErrorContains(t, err, "unable to evict pod")
+		assert.False(t, evicted)
+		assert.Nil(t, renewTimeOf(t, c))
+	})
+
+	t.Run("UnableToEvictUpdatedLeaseUnclaimed", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithObjects(lease(ago(time.Minute))).Build()
+		e := newEvictionFallback(config, kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {
+			m.On("Evict", mock.Anything, mock.Anything).Return(errors.New(""))
+		}), c, c)
+		e.now = nowFunc
+
+		evicted, _, err := e.Evict(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod1", true),
+		)
+		assert.ErrorContains(t, err, "unable to evict pod")
+		assert.False(t, evicted)
+		assert.True(t, ago(time.Minute).Equal(*renewTimeOf(t, c)))
+	})
+
+	t.Run("UnableToGetReplicaSet", func(t *testing.T) {
+		mockPodHelper := kubetest.NewMockPodHelper(nil)
+		c := fake.NewClientBuilder().Build()
+		e := newEvictionFallback(config, mockPodHelper, c, c)
+
+		evicted, _, err := e.Evict(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod1", true),
+		)
+		assert.ErrorContains(t, err, "unable to get replicaset")
+		assert.False(t, evicted)
+		mockPodHelper.AssertNotCalled(t, "Evict", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ReplicaSetNotControlled", func(t *testing.T) {
+		mockPodHelper := kubetest.NewMockPodHelper(nil)
+		c := fake.NewClientBuilder().WithObjects(&appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "deployment-abc"},
+		}).Build()
+		e := newEvictionFallback(config, mockPodHelper, c, c)
+
+		evicted, _, err := e.Evict(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod1", true),
+		)
+		assert.EqualError(t, err, "replicaset 'namespace/deployment-abc' not controlled by a deployment")
+		assert.False(t, evicted)
+		mockPodHelper.AssertNotCalled(t, "Evict", mock.Anything, mock.Anything)
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		mockPodHelper := kubetest.NewMockPodHelper(nil)
+		c := fake.NewClientBuilder().WithObjects(lease(ago(time.Minute))).Build()
+		e := newEvictionFallback(config, mockPodHelper, c, c)
+		e.now = nowFunc
+
+		evicted, _, err := e.Evict(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).DryRun(true).Build(),
+			workloadStartupsPod("pod1", true),
+		)
+		assert.NoError(t, err)
+		assert.True(t, evicted)
+		assert.True(t, ago(time.Minute).Equal(*renewTimeOf(t, c)))
+		mockPodHelper.AssertCalled(t, "Evict", mock.Anything, mock.Anything)
+	})
+
+	t.Run("OkLeaseCreated", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithObjects(replicaSet).Build()
+		e := newEvictionFallback(config, kubetest.NewMockPodHelper(nil), c, c)
+		e.now = nowFunc
+
+		evicted, remaining, err := e.Evict(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod1", true),
+		)
+		assert.NoError(t, err)
+		assert.True(t, evicted)
+		assert.Equal(t, time.Duration(0), remaining)
+
+		got := &coordinationv1.Lease{}
+		assert.NoError(t, c.Get(context.Background(), leaseName, got))
+		assert.True(t, now.Equal(got.Spec.RenewTime.Time))
+		assert.Equal(t, int32(60), *got.Spec.LeaseDurationSeconds)
+		assert.Equal(t, "namespace/Deployment/deployment", *got.Spec.HolderIdentity)
+		assert.Equal(
+			t,
+			[]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment", UID: "deployment-uid"}},
+			got.OwnerReferences,
+		)
+	})
+
+	t.Run("OkCooldownElapsed", func(t *testing.T) {
+		mockPodHelper := kubetest.NewMockPodHelper(nil)
+		c := fake.NewClientBuilder().WithObjects(lease(ago(time.Minute))).Build()
+		e := newEvictionFallback(config, mockPodHelper, c, c)
+		e.now = nowFunc
+
+		evicted, _, err := e.Evict(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod1", true),
+		)
+		assert.NoError(t, err)
+		assert.True(t, evicted)
+		assert.True(t, now.Equal(*renewTimeOf(t, c)))
+		mockPodHelper.AssertCalled(t, "Evict", mock.Anything, mock.Anything)
+	})
+}
+
+func TestEvictionFallbackLeaseName(t *testing.T) {
+	got := evictionFallbackLeaseName("namespace/Deployment/deployment")
+	assert.Regexp(t, "^csa-eviction-[0-9a-f]{16}$", got)
+	assert.Equal(t, got, evictionFallbackLeaseName("namespace/Deployment/deployment"))
+	assert.NotEqual(t, got, evictionFallbackLeaseName("namespace/Deployment/other"))
+}
+
+func TestEvictionFallbackFor(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      *string
+		want       bool
+		wantErrMsg string
+	}{
+		{"NotPresent", nil, false, ""},
+		{"NotBool", ptr("test"), false, "'" + kubecommon.AnnotationEvictionFallback + "' annotation value must be a boolean ('test')"},
+		{"False", ptr("false"), false, ""},
+		{"True", ptr("true"), true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{}
+			if tt.value != nil {
+				pod.Annotations = map[string]string{kubecommon.AnnotationEvictionFallback: *tt.value}
+			}
+
+			got, err := evictionFallbackFor(pod)
+			if tt.wantErrMsg != "" {
+				assert.EqualError(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	ccontext "github.com/ExpediaGroup/container-startup-autoscaler/internal/context"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/control/controlcommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/event/eventcommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	metricsscale "github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/scale"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/scale"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+const eventReasonDisabled = "Disabled"
+
+// handBack is the default implementation of podcommon.HandBack.
+type handBack struct {
+	controllerConfig  controllercommon.ControllerConfig
+	recorder          record.EventRecorder
+	podHelper         kubecommon.PodHelper
+	containerHelper   kubecommon.ContainerHelper
+	nodeUpscaleQueue  podcommon.NodeUpscaleQueue
+	podEventPublisher eventcommon.PodEventPublisher
+}
+
+func newHandBack(
+	controllerConfig controllercommon.ControllerConfig,
+	recorder record.EventRecorder,
+	podHelper kubecommon.PodHelper,
+	containerHelper kubecommon.ContainerHelper,
+	nodeUpscaleQueue podcommon.NodeUpscaleQueue,
+	podEventPublisher eventcommon.PodEventPublisher,
+) *handBack {
+	return &handBack{
+		controllerConfig:  controllerConfig,
+		recorder:          recorder,
+		podHelper:         podHelper,
+		containerHelper:   containerHelper,
+		nodeUpscaleQueue:  nodeUpscaleQueue,
+		podEventPublisher: podEventPublisher,
+	}
+}
+
+// IsDisabled returns whether CSA is disabled for the supplied pod i.e. the enabled label is either not present or
+// explicitly 'false'. An enabled label value that can't be parsed is not considered disabled, since this is reported
+// upon during validation.
+func (h *handBack) IsDisabled(pod *v1.Pod) bool {
+	value, present := pod.Labels[kubecommon.LabelEnabled]
+	if !present {
+		return true
+	}
+
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return false
+	}
+
+	return !enabled
+}
+
+// DisabledPod retrieves the pod with the supplied name and returns whether it exists and CSA is disabled for it. The
+// pod is expected to be retrieved directly via the Kube API since it may no longer be present within the informer
+// cache.
+func (h *handBack) DisabledPod(ctx context.Context, name types.NamespacedName) (bool, *v1.Pod, error) {
+	exists, pod, err := h.podHelper.Get(ctx, name)
+	if err != nil {
+		return false, nil, common.WrapErrorf(err, "unable to get pod")
+	}
+
+	if !exists || !h.IsDisabled(pod) {
+		return false, nil, nil
+	}
+
+	return true, pod, nil
+}
+
+// Execute hands back the supplied pod, for which CSA has been disabled. The configured final resources are applied to
+// the target container on a best-effort basis, after which the status annotation is removed. Does nothing if the pod
+// has no status annotation since it's either already been handed back or was never previously reconciled. Returns a
+// HandBackSuppressedError without removing status if commanding the final resources is currently suppressed. The pod is
+// no longer considered as waiting for startup resources on its node, if node upscale priority ordering is enabled.
+func (h *handBack) Execute(ctx context.Context, pod *v1.Pod) error {
+	if h.controllerConfig.NodeUpscalePriorityOrdering {
+		h.nodeUpscaleQueue.Remove(pod)
+	}
+
+	hasStatAnn, statAnn := h.podHelper.HasAnnotation(pod, kubecommon.AnnotationStatus)
+	if !hasStatAnn {
+		logging.Infof(ctx, logging.VDebug, "csa disabled for pod but no status present (nothing to hand back)")
+		return nil
+	}
+
+	// Failure to apply final resources doesn't prevent status from being removed, since CSA will no longer be
+	// reconciling the pod. Status is retained if final resources are suppressed, so that hand back is retried.
+	var msg string
+	finalResources, resizedPod, err := h.applyFinalResources(ctx, pod, statAnn)
+	if errors.As(err, &HandBackSuppressedError{}) {
+		return err
+	}
+	if err != nil {
+		logging.Errorf(ctx, err, "unable to apply final resources (will continue)")
+		msg = "csa disabled - unable to apply final resources"
+	} else {
+		msg = fmt.Sprintf("csa disabled - %s resources commanded", finalResources.HumanReadable())
+		if ccontext.DryRun(ctx) {
+			msg += " (dry run)"
+		}
+		pod = resizedPod
+	}
+
+	removeStatusFunc := func(podToMutate *v1.Pod) (bool, func(*v1.Pod) bool, error) {
+		delete(podToMutate.Annotations, kubecommon.AnnotationStatus)
+		return true, nil, nil
+	}
+
+	_, err = h.podHelper.Patch(
+		ctx,
+		h.podEventPublisher,
+		pod,
+		[]func(*v1.Pod) (bool, func(*v1.Pod) bool, error){removeStatusFunc, startupProtectionRemovalPodMutationFunc()},
+		false,
+	)
+	if err != nil {
+		return common.WrapErrorf(err, "unable to remove status")
+	}
+
+	msg += " and status removed"
+	logging.Infof(ctx, logging.VInfo, msg)
+	h.recorder.Event(pod, v1.EventTypeNormal, eventReasonDisabled, common.CapitalizeFirstChar(msg))
+	return nil
+}
+
+// applyFinalResources commands the configured final resources for the target container of the supplied pod, using
+// the supplied status annotation to determine admitted resources if configured. Returns the resources commanded and
+// the resultant pod, or a HandBackSuppressedError if commanding them is currently suppressed.
+func (h *handBack) applyFinalResources(
+	ctx context.Context,
+	pod *v1.Pod,
+	statAnn string,
+) (podcommon.StateResources, *v1.Pod, error) {
+	scaleConfigs := scale.NewConfigurations(h.podHelper, h.containerHelper)
+	if err := scaleConfigs.StoreFromAnnotationsAll(pod); err != nil {
+		return podcommon.StateResourcesUnknown, nil, common.WrapErrorf(err, "unable to store configuration from annotations")
+	}
+
+	targetContainerName, err := scaleConfigs.TargetContainerName(pod)
+	if err != nil {
+		return podcommon.StateResourcesUnknown, nil, common.WrapErrorf(err, "unable to get target container name")
+	}
+
+	targetContainer, err := h.containerHelper.Get(pod, targetContainerName)
+	if err != nil {
+		return podcommon.StateResourcesUnknown, nil, common.WrapErrorf(err, "unable to get target container")
+	}
+
+	if err = scaleConfigs.ValidateAll(targetContainer); err != nil {
+		return podcommon.StateResourcesUnknown, nil, common.WrapErrorf(err, "unable to validate configuration")
+	}
+
+	finalResources := podcommon.StateResourcesPostStartup
+	if h.controllerConfig.DisabledFinalResources == controllercommon.DisabledFinalResourcesAdmitted {
+		stat, err := podcommon.StatusAnnotationFromString(statAnn)
+		if err != nil {
+			return podcommon.StateResourcesUnknown, nil, common.WrapErrorf(err, "unable to get status annotation from string")
+		}
+
+		if stat.Scale.AdmittedResources == podcommon.StateResourcesStartup ||
+			stat.Scale.AdmittedResources == podcommon.StateResourcesPostStartup {
+			finalResources = stat.Scale.AdmittedResources
+		} else {
+			logging.Infof(ctx, logging.VInfo, "admitted resources not recorded within status (will apply post-startup resources)")
+		}
+	}
+
+	direction := finalResources.Direction()
+	if reason := ccontext.Suppression(ctx).Reason(direction); reason != controlcommon.SuppressionReasonNone {
+		metricsscale.Suppressed(direction, string(reason)).Inc()
+		return podcommon.StateResourcesUnknown, nil, NewHandBackSuppressedError(
+			fmt.Sprintf("%s resources not commanded (%s)", finalResources.HumanReadable(), reason.HumanReadable()),
+		)
+	}
+
+	updates := scale.NewUpdates(scaleConfigs)
+	var resizeFuncs []func(*v1.Pod) (bool, func(*v1.Pod) bool, error)
+	if finalResources == podcommon.StateResourcesStartup {
+		resizeFuncs = updates.StartupPodMutationFuncAll(targetContainer)
+	} else {
+		resizeFuncs = updates.PostStartupPodMutationFuncAll(targetContainer)
+	}
+
+	newPod, err := h.podHelper.Patch(ctx, h.podEventPublisher, pod, resizeFuncs, true)
+	if err != nil {
+		return podcommon.StateResourcesUnknown, nil, common.WrapErrorf(err, "unable to patch container resources")
+	}
+
+	return finalResources, newPod, nil
+}
@@ -0,0 +1,377 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/context/contexttest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/control/controlcommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/event"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/event/eventtest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubetest"
+	metricsscale "github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/scale"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podtest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/scale/scalecommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/component-base/metrics/testutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestNewHandBack(t *testing.T) {
+	config := controllercommon.ControllerConfig{}
+	recorder := &record.FakeRecorder{}
+	podHelper := kube.NewPodHelper(nil, kube.NewDisabledCircuitBreaker())
+	containerHelper := kube.NewContainerHelper()
+	queue := newNodeUpscaleQueue(config, podHelper)
+	publisher := event.DefaultPodEventPublisher
+	h := newHandBack(config, recorder, podHelper, containerHelper, queue, publisher)
+	expected := &handBack{
+		controllerConfig:  config,
+		recorder:          recorder,
+		podHelper:         podHelper,
+		containerHelper:   containerHelper,
+		nodeUpscaleQueue:  queue,
+		podEventPublisher: publisher,
+	}
+	assert.Equal(t, expected, h)
+}
+
+func TestHandBackIsDisabled(t *testing.T) {
+	tests := []struct {
+		name       string
+		labelValue *string
+		want       bool
+	}{
+		{"LabelNotPresent", nil, true},
+		{"LabelFalse", ptr("false"), true},
+		{"LabelTrue", ptr("true"), false},
+		{"LabelUnparsable", ptr("test"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := kubetest.NewPodBuilder().Build()
+			if tt.labelValue == nil {
+				delete(pod.Labels, kubecommon.LabelEnabled)
+			} else {
+				pod.Labels[kubecommon.LabelEnabled] = *tt.labelValue
+			}
+
+			h := newHandBack(controllercommon.ControllerConfig{}, nil, nil, nil, nil, nil)
+			assert.Equal(t, tt.want, h.IsDisabled(pod))
+		})
+	}
+}
+
+func TestHandBackDisabledPod(t *testing.T) {
+	disabledPod := kubetest.NewPodBuilder().AdditionalLabels(map[string]string{kubecommon.LabelEnabled: "false"}).Build()
+
+	tests := []struct {
+		name                    string
+		configPodHelperMockFunc func(*kubetest.MockPodHelper)
+		wantErrMsg              string
+		wantDisabled            bool
+		wantPod                 *v1.Pod
+	}{
+		{
+			"UnableToGetPod",
+			func(m *kubetest.MockPodHelper) {
+				m.On("Get", mock.Anything, mock.Anything).Return(false, &v1.Pod{}, errors.New(""))
+			},
+			"unable to get pod",
+			false,
+			nil,
+		},
+		{
+			"PodDoesntExist",
+			func(m *kubetest.MockPodHelper) {
+				m.On("Get", mock.Anything, mock.Anything).Return(false, &v1.Pod{}, nil)
+			},
+			"",
+			false,
+			nil,
+		},
+		{
+			"PodEnabled",
+			func(m *kubetest.MockPodHelper) {
+				m.On("Get", mock.Anything, mock.Anything).Return(true, kubetest.NewPodBuilder().Build(), nil)
+			},
+			"",
+			false,
+			nil,
+		},
+		{
+			"PodDisabled",
+			func(m *kubetest.MockPodHelper) {
+				m.On("Get", mock.Anything, mock.Anything).Return(true, disabledPod, nil)
+			},
+			"",
+			true,
+			disabledPod,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHandBack(
+				controllercommon.ControllerConfig{},
+				nil,
+				kubetest.NewMockPodHelper(tt.configPodHelperMockFunc),
+				nil,
+				nil,
+				nil,
+			)
+
+			gotDisabled, gotPod, err := h.DisabledPod(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+				types.NamespacedName{},
+			)
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantDisabled, gotDisabled)
+			assert.Equal(t, tt.wantPod, gotPod)
+		})
+	}
+}
+
+func TestHandBackExecute(t *testing.T) {
+	t.Run("NoStatus", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(1)
+		h := newHandBack(
+			controllercommon.ControllerConfig{},
+			recorder,
+			kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {
+				m.On("HasAnnotation", mock.Anything, kubecommon.AnnotationStatus).Return(false, "")
+			}),
+			kube.NewContainerHelper(),
+			nil,
+			eventtest.NewMockPodEventPublisher(nil),
+		)
+
+		err := h.Execute(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), &v1.Pod{})
+		assert.NoError(t, err)
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("NodeUpscaleWaitRemoved", func(t *testing.T) {
+		mockNodeUpscaleQueue := podtest.NewMockNodeUpscaleQueue(func(m *podtest.MockNodeUpscaleQueue) { m.RemoveDefault() })
+		h := newHandBack(
+			controllercommon.ControllerConfig{NodeUpscalePriorityOrdering: true},
+			record.NewFakeRecorder(1),
+			kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {
+				m.On("HasAnnotation", mock.Anything, kubecommon.AnnotationStatus).Return(false, "")
+			}),
+			kube.NewContainerHelper(),
+			mockNodeUpscaleQueue,
+			eventtest.NewMockPodEventPublisher(nil),
+		)
+
+		err := h.Execute(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), &v1.Pod{})
+		assert.NoError(t, err)
+		mockNodeUpscaleQueue.AssertCalled(t, "Remove", mock.Anything)
+	})
+
+	t.Run("Suppressed", func(t *testing.T) {
+		metricsscale.ResetMetrics()
+		pod := handBackPod(podcommon.StateResourcesStartup, nil)
+		client := kubetest.ControllerRuntimeFakeClientWithKubeFake(
+			func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
+			func() interceptor.Funcs { return interceptor.Funcs{} },
+		)
+		recorder := record.NewFakeRecorder(1)
+		h := newHandBack(
+			controllercommon.ControllerConfig{DisabledFinalResources: controllercommon.DisabledFinalResourcesPostStartup},
+			recorder,
+			kube.NewPodHelper(client, kube.NewDisabledCircuitBreaker()),
+			kube.NewContainerHelper(),
+			nil,
+			eventtest.NewMockPodEventPublisher(nil),
+		)
+
+		err := h.Execute(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).
+				Suppression(controlcommon.NewSuppression(true, false, false)).
+				Build(),
+			pod,
+		)
+		assert.ErrorAs(t, err, &HandBackSuppressedError{})
+		assert.ErrorContains(t, err, "post-startup resources not commanded (kill switch active)")
+
+		got := &v1.Pod{}
+		assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}, got))
+		assert.Contains(t, got.Annotations, kubecommon.AnnotationStatus)
+		assert.True(t, got.Spec.Containers[0].Resources.Requests[v1.ResourceCPU].Equal(kubetest.PodCpuStartupEnabled))
+		assert.Empty(t, recorder.Events)
+		value, _ := testutil.GetCounterMetricValue(
+			metricsscale.Suppressed(podcommon.StateResourcesPostStartup.Direction(), string(controlcommon.SuppressionReasonKillSwitch)),
+		)
+		assert.Equal(t, float64(1), value)
+	})
+
+	t.Run("UnableToRemoveStatus", func(t *testing.T) {
+		pod := handBackPod("", nil)
+		h := newHandBack(
+			controllercommon.ControllerConfig{DisabledFinalResources: controllercommon.DisabledFinalResourcesPostStartup},
+			record.NewFakeRecorder(1),
+			kube.NewPodHelper(
+				kubetest.ControllerRuntimeFakeClientWithKubeFake(
+					func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
+					func() interceptor.Funcs { return interceptor.Funcs{Patch: kubetest.InterceptorFuncPatchFail()} },
+				),
+				kube.NewDisabledCircuitBreaker(),
+			),
+			kube.NewContainerHelper(),
+			nil,
+			eventtest.NewMockPodEventPublisher(nil),
+		)
+
+		err := h.Execute(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), pod)
+		assert.ErrorContains(t, err, "unable to remove status")
+	})
+
+	tests := []struct {
+		name                   string
+		disabledFinalResources string
+		admittedResources      podcommon.StateResources
+		additionalAnnotations  map[string]string
+		wantCpuRequests        resource.Quantity
+		wantEventMsg           string
+	}{
+		{
+			"OkPostStartup",
+			controllercommon.DisabledFinalResourcesPostStartup,
+			podcommon.StateResourcesStartup,
+			nil,
+			kubetest.PodCpuPostStartupRequestsEnabled,
+			"Csa disabled - post-startup resources commanded and status removed",
+		},
+		{
+			"OkAdmittedStartup",
+			controllercommon.DisabledFinalResourcesAdmitted,
+			podcommon.StateResourcesStartup,
+			nil,
+			kubetest.PodCpuStartupEnabled,
+			"Csa disabled - startup resources commanded and status removed",
+		},
+		{
+			"OkAdmittedNotRecorded",
+			controllercommon.DisabledFinalResourcesAdmitted,
+			"",
+			nil,
+			kubetest.PodCpuPostStartupRequestsEnabled,
+			"Csa disabled - post-startup resources commanded and status removed",
+		},
+		{
+			"OkUnableToApplyFinalResources",
+			controllercommon.DisabledFinalResourcesPostStartup,
+			"",
+			map[string]string{scalecommon.AnnotationTargetContainerName: "missing"},
+			kubetest.PodCpuStartupEnabled,
+			"Csa disabled - unable to apply final resources and status removed",
+		},
+		{
+			"OkStartupProtectionRemoved",
+			controllercommon.DisabledFinalResourcesPostStartup,
+			podcommon.StateResourcesStartup,
+			map[string]string{"key": "value", kubecommon.AnnotationStartupProtection: "key"},
+			kubetest.PodCpuPostStartupRequestsEnabled,
+			"Csa disabled - post-startup resources commanded and status removed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := handBackPod(tt.admittedResources, tt.additionalAnnotations)
+			client := kubetest.ControllerRuntimeFakeClientWithKubeFake(
+				func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
+				func() interceptor.Funcs { return interceptor.Funcs{} },
+			)
+			recorder := record.NewFakeRecorder(1)
+			h := newHandBack(
+				controllercommon.ControllerConfig{DisabledFinalResources: tt.disabledFinalResources},
+				recorder,
+				kube.NewPodHelper(client, kube.NewDisabledCircuitBreaker()),
+				kube.NewContainerHelper(),
+				nil,
+				eventtest.NewMockPodEventPublisher(nil),
+			)
+
+			err := h.Execute(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), pod)
+			assert.NoError(t, err)
+
+			got := &v1.Pod{}
+			assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}, got))
+			_, gotStatus := got.Annotations[kubecommon.AnnotationStatus]
+			assert.False(t, gotStatus)
+			assert.NotContains(t, got.Annotations, kubecommon.AnnotationStartupProtection)
+			assert.NotContains(t, got.Annotations, "key")
+			assert.True(t, got.Spec.Containers[0].Resources.Requests[v1.ResourceCPU].Equal(tt.wantCpuRequests))
+
+			select {
+			case evt := <-recorder.Events:
+				assert.True(t, strings.HasSuffix(evt, tt.wantEventMsg), evt)
+			default:
+				t.Fatalf("event not recorded")
+			}
+		})
+	}
+}
+
+func handBackPod(
+	admittedResources podcommon.StateResources,
+	additionalAnnotations map[string]string,
+) *v1.Pod {
+	annotations := map[string]string{
+		kubecommon.AnnotationStatus: podcommon.NewStatusAnnotation(
+			"test",
+			podcommon.NewStatusAnnotationScale(
+				[]v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory}, "", "", "", "", admittedResources, false, "", false,
+			),
+			"",
+		).Json(),
+		// Post-startup requests and limits must be equal to pass validation.
+		scalecommon.AnnotationCpuPostStartupLimits:    kubetest.PodAnnotationCpuPostStartupRequests,
+		scalecommon.AnnotationMemoryPostStartupLimits: kubetest.PodAnnotationMemoryPostStartupRequests,
+	}
+	for name, value := range additionalAnnotations {
+		annotations[name] = value
+	}
+
+	return kubetest.NewPodBuilder().
+		ResourcesState(podcommon.StateResourcesStartup).
+		AdditionalLabels(map[string]string{kubecommon.LabelEnabled: "false"}).
+		AdditionalAnnotations(annotations).
+		Build()
+}
+
+func ptr(s string) *string {
+	return &s
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// nodeUpscaleWaiter holds information about a pod waiting for startup resources.
+type nodeUpscaleWaiter struct {
+	name        types.NamespacedName
+	priority    int32
+	created     time.Time
+	firstWaited time.Time
+	lastWaited  time.Time
+}
+
+// nodeUpscaleQueue is the default implementation of podcommon.NodeUpscaleQueue. It maintains an in-memory record of the
+// pods waiting for startup resources on each node, so that they may be upscaled in order of pod priority. Waiters are
+// expected to be periodically requeued; those that haven't waited again within expiry are disregarded.
+type nodeUpscaleQueue struct {
+	podHelper kubecommon.PodHelper
+	tieBreak  string
+	expiry    time.Duration
+	now       func() time.Time
+
+	mutex   sync.Mutex
+	waiters map[string]map[types.NamespacedName]*nodeUpscaleWaiter
+}
+
+func newNodeUpscaleQueue(
+	controllerConfig controllercommon.ControllerConfig,
+	podHelper kubecommon.PodHelper,
+) *nodeUpscaleQueue {
+	// Allow waiters to miss a requeue before being disregarded. Deferred pods are requeued per the configured rate
+	// limiter, so the longest delay it may apply is used.
+	requeueDelay := controllerConfig.RequeueDurationSecsDuration()
+	if controllerConfig.RequeueRateLimiter == controllercommon.RequeueRateLimiterExponential {
+		requeueDelay = controllerConfig.RequeueMaxDelayDuration()
+	}
+	expiry := 2 * requeueDelay
+
+	return &nodeUpscaleQueue{
+		podHelper: podHelper,
+		tieBreak:  controllerConfig.NodeUpscaleTieBreak,
+		expiry:    expiry,
+		now:       time.Now,
+		waiters:   map[string]map[types.NamespacedName]*nodeUpscaleWaiter{},
+	}
+}
+
+// Wait records the supplied pod as waiting for startup resources on its node.
+func (q *nodeUpscaleQueue) Wait(pod *v1.Pod) {
+	if pod.Spec.NodeName == "" {
+		return
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	waiter := q.waiterFor(pod)
+	waiter.lastWaited = q.now()
+	if q.waiters[pod.Spec.NodeName] == nil {
+		q.waiters[pod.Spec.NodeName] = map[types.NamespacedName]*nodeUpscaleWaiter{}
+	}
+	q.waiters[pod.Spec.NodeName][waiter.name] = waiter
+}
+
+// Ahead returns the number of other pods waiting for startup resources on the supplied pod's node that rank ahead of it.
+// Pods rank ahead if they have a higher priority, or the same priority and win the configured tie-break. Waiters that
+// have expired, no longer exist or now hold startup resources (per their status annotation) are removed.
+func (q *nodeUpscaleQueue) Ahead(ctx context.Context, pod *v1.Pod) (int, error) {
+	if pod.Spec.NodeName == "" {
+		return 0, nil
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	waiters := q.waiters[pod.Spec.NodeName]
+	self := q.waiterFor(pod)
+	ahead := 0
+
+	for name, waiter := range waiters {
+		if name == self.name {
+			continue
+		}
+
+		if q.now().Sub(waiter.lastWaited) > q.expiry {
+			delete(waiters, name)
+			continue
+		}
+
+		if !q.ranksAhead(waiter, self) {
+			continue
+		}
+
+		exists, waiterPod, err := q.podHelper.Get(ctx, name)
+		if err != nil {
+			return 0, common.WrapErrorf(err, "unable to get node upscale waiter")
+		}
+
+		if !exists || waiterPod.Spec.NodeName != pod.Spec.NodeName || holdsStartupResources(waiterPod) {
+			delete(waiters, name)
+			continue
+		}
+
+		ahead++
+	}
+
+	return ahead, nil
+}
+
+// Remove removes the supplied pod as waiting for startup resources on its node.
+func (q *nodeUpscaleQueue) Remove(pod *v1.Pod) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	waiters := q.waiters[pod.Spec.NodeName]
+	delete(waiters, types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name})
+	if len(waiters) == 0 {
+		delete(q.waiters, pod.Spec.NodeName)
+	}
+}
+
+// waiterFor returns the existing waiter for the supplied pod, or a new waiter if not waiting. The returned waiter
+// reflects the pod's current priority.
+func (q *nodeUpscaleQueue) waiterFor(pod *v1.Pod) *nodeUpscaleWaiter {
+	name := types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
+	waiter, isWaiting := q.waiters[pod.Spec.NodeName][name]
+	if !isWaiting {
+		waiter = &nodeUpscaleWaiter{
+			name:        name,
+			created:     pod.CreationTimestamp.Time,
+			firstWaited: q.now(),
+		}
+	}
+
+	waiter.priority = 0
+	if pod.Spec.Priority != nil {
+		waiter.priority = *pod.Spec.Priority
+	}
+
+	return waiter
+}
+
+// ranksAhead returns whether waiter a ranks ahead of waiter b, per priority and then the configured tie-break. Pod
+// names are compared as a last resort so that ordering is deterministic.
+func (q *nodeUpscaleQueue) ranksAhead(a *nodeUpscaleWaiter, b *nodeUpscaleWaiter) bool {
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+
+	aTime, bTime := a.firstWaited, b.firstWaited
+	if q.tieBreak == controllercommon.NodeUpscaleTieBreakOldestPod {
+		aTime, bTime = a.created, b.created
+	}
+	if !aTime.Equal(bTime) {
+		return aTime.Before(bTime)
+	}
+
+	return a.name.String() < b.name.String()
+}
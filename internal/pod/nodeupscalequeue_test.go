@@ -0,0 +1,300 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/context/contexttest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubetest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestNewNodeUpscaleQueue(t *testing.T) {
+	podHelper := kube.NewPodHelper(nil, kube.NewDisabledCircuitBreaker())
+
+	tests := []struct {
+		name               string
+		requeueRateLimiter string
+		wantExpiry         time.Duration
+	}{
+		{"Fixed", controllercommon.RequeueRateLimiterFixed, 20 * time.Second},
+		{"Exponential", controllercommon.RequeueRateLimiterExponential, 120 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newNodeUpscaleQueue(
+				controllercommon.ControllerConfig{
+					RequeueDurationSecs: 10,
+					RequeueRateLimiter:  tt.requeueRateLimiter,
+					RequeueMaxDelaySecs: 60,
+					NodeUpscaleTieBreak: controllercommon.NodeUpscaleTieBreakOldestPod,
+				},
+				podHelper,
+			)
+			assert.Equal(t, podHelper, q.podHelper)
+			assert.Equal(t, controllercommon.NodeUpscaleTieBreakOldestPod, q.tieBreak)
+			assert.Equal(t, tt.wantExpiry, q.expiry)
+			assert.NotNil(t, q.now)
+			assert.Empty(t, q.waiters)
+		})
+	}
+}
+
+func TestNodeUpscaleQueueWait(t *testing.T) {
+	t.Run("NotScheduled", func(t *testing.T) {
+		q := newNodeUpscaleQueue(controllercommon.ControllerConfig{}, nil)
+		q.Wait(&v1.Pod{})
+		assert.Empty(t, q.waiters)
+	})
+
+	t.Run("FirstWaitRecorded", func(t *testing.T) {
+		now := time.Now()
+		q := newNodeUpscaleQueue(controllercommon.ControllerConfig{}, nil)
+		q.now = func() time.Time { return now }
+
+		q.Wait(nodeUpscalePod("pod", 10, now.Add(-time.Hour)))
+		assert.Equal(
+			t,
+			&nodeUpscaleWaiter{
+				name:        types.NamespacedName{Namespace: "namespace", Name: "pod"},
+				priority:    10,
+				created:     now.Add(-time.Hour),
+				firstWaited: now,
+				lastWaited:  now,
+			},
+			q.waiters["node"][types.NamespacedName{Namespace: "namespace", Name: "pod"}],
+		)
+	})
+
+	t.Run("SubsequentWaitRefreshed", func(t *testing.T) {
+		now := time.Now()
+		q := newNodeUpscaleQueue(controllercommon.ControllerConfig{}, nil)
+		q.now = func() time.Time { return now }
+		q.Wait(nodeUpscalePod("pod", 10, now))
+
+		q.now = func() time.Time { return now.Add(time.Minute) }
+		q.Wait(nodeUpscalePod("pod", 20, now))
+		waiter := q.waiters["node"][types.NamespacedName{Namespace: "namespace", Name: "pod"}]
+		assert.Equal(t, int32(20), waiter.priority)
+		assert.Equal(t, now, waiter.firstWaited)
+		assert.Equal(t, now.Add(time.Minute), waiter.lastWaited)
+	})
+}
+
+func TestNodeUpscaleQueueAhead(t *testing.T) {
+	now := time.Now()
+	name := func(podName string) types.NamespacedName {
+		return types.NamespacedName{Namespace: "namespace", Name: podName}
+	}
+	newQueue := func(configFunc func(*kubetest.MockPodHelper), waiters ...*v1.Pod) *nodeUpscaleQueue {
+		q := newNodeUpscaleQueue(
+			controllercommon.ControllerConfig{
+				RequeueDurationSecs: 10,
+				NodeUpscaleTieBreak: controllercommon.NodeUpscaleTieBreakLongestWaiting,
+			},
+			kubetest.NewMockPodHelper(configFunc),
+		)
+		q.now = func() time.Time { return now }
+		for _, waiter := range waiters {
+			q.Wait(waiter)
+		}
+		return q
+	}
+
+	t.Run("NotScheduled", func(t *testing.T) {
+		q := newQueue(nil)
+		got, err := q.Ahead(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), &v1.Pod{})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, got)
+	})
+
+	t.Run("UnableToGetWaiter", func(t *testing.T) {
+		q := newQueue(
+			func(m *kubetest.MockPodHelper) {
+				m.On("Get", mock.Anything, mock.Anything).Return(false, &v1.Pod{}, errors.New(""))
+			},
+			nodeUpscalePod("pod1", 10, now),
+		)
+
+		got, err := q.Ahead(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			nodeUpscalePod("pod2", 0, now),
+		)
+		assert.ErrorContains(t, err, "unable to get node upscale waiter")
+		assert.Equal(t, 0, got)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		held := nodeUpscalePod("held", 10, now)
+		scale := podcommon.NewEmptyStatusAnnotationScale([]v1.ResourceName{v1.ResourceCPU})
+		scale.StartupResourcesHeld = true
+		held.Annotations = map[string]string{
+			kubecommon.AnnotationStatus: podcommon.NewStatusAnnotation("test", scale, "").Json(),
+		}
+		moved := nodeUpscalePod("moved", 10, now)
+		moved.Spec.NodeName = "other"
+
+		q := newQueue(
+			func(m *kubetest.MockPodHelper) {
+				m.On("Get", mock.Anything, name("higher")).Return(true, nodeUpscalePod("higher", 10, now), nil)
+				m.On("Get", mock.Anything, name("equal")).Return(true, nodeUpscalePod("equal", 5, now), nil)
+				m.On("Get", mock.Anything, name("deleted")).Return(false, &v1.Pod{}, nil)
+				m.On("Get", mock.Anything, name("held")).Return(true, held, nil)
+				m.On("Get", mock.Anything, name("moved")).Return(true, moved, nil)
+			},
+			nodeUpscalePod("higher", 10, now),
+			nodeUpscalePod("equal", 5, now),
+			nodeUpscalePod("deleted", 10, now),
+			nodeUpscalePod("held", 10, now),
+			nodeUpscalePod("moved", 10, now),
+			nodeUpscalePod("lower", 0, now),
+		)
+		q.Wait(nodeUpscalePod("expired", 10, now))
+		q.waiters["node"][name("expired")].lastWaited = now.Add(-time.Minute)
+		// Pods that start waiting later lose the tie-break.
+		q.now = func() time.Time { return now.Add(time.Second) }
+
+		got, err := q.Ahead(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			nodeUpscalePod("pod", 5, now),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, got)
+		assert.ElementsMatch(
+			t,
+			[]types.NamespacedName{name("higher"), name("equal"), name("lower")},
+			func() []types.NamespacedName {
+				var ret []types.NamespacedName
+				for n := range q.waiters["node"] {
+					ret = append(ret, n)
+				}
+				return ret
+			}(),
+		)
+	})
+}
+
+func TestNodeUpscaleQueueRemove(t *testing.T) {
+	q := newNodeUpscaleQueue(controllercommon.ControllerConfig{}, nil)
+	q.Wait(nodeUpscalePod("pod1", 0, time.Time{}))
+	q.Wait(nodeUpscalePod("pod2", 0, time.Time{}))
+
+	q.Remove(nodeUpscalePod("pod1", 0, time.Time{}))
+	assert.Len(t, q.waiters["node"], 1)
+
+	q.Remove(nodeUpscalePod("pod2", 0, time.Time{}))
+	assert.NotContains(t, q.waiters, "node")
+
+	q.Remove(nodeUpscalePod("pod3", 0, time.Time{}))
+	assert.Empty(t, q.waiters)
+}
+
+func TestNodeUpscaleQueueRanksAhead(t *testing.T) {
+	now := time.Now()
+	newWaiter := func(podName string, priority int32, created time.Time, firstWaited time.Time) *nodeUpscaleWaiter {
+		return &nodeUpscaleWaiter{
+			name:        types.NamespacedName{Namespace: "namespace", Name: podName},
+			priority:    priority,
+			created:     created,
+			firstWaited: firstWaited,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		tieBreak string
+		a        *nodeUpscaleWaiter
+		b        *nodeUpscaleWaiter
+		want     bool
+	}{
+		{
+			"HigherPriority",
+			controllercommon.NodeUpscaleTieBreakLongestWaiting,
+			newWaiter("a", 10, now, now.Add(time.Second)),
+			newWaiter("b", 0, now, now),
+			true,
+		},
+		{
+			"LowerPriority",
+			controllercommon.NodeUpscaleTieBreakLongestWaiting,
+			newWaiter("a", 0, now, now),
+			newWaiter("b", 10, now, now.Add(time.Second)),
+			false,
+		},
+		{
+			"LongestWaitingEarlier",
+			controllercommon.NodeUpscaleTieBreakLongestWaiting,
+			newWaiter("a", 0, now.Add(time.Second), now),
+			newWaiter("b", 0, now, now.Add(time.Second)),
+			true,
+		},
+		{
+			"LongestWaitingLater",
+			controllercommon.NodeUpscaleTieBreakLongestWaiting,
+			newWaiter("a", 0, now, now.Add(time.Second)),
+			newWaiter("b", 0, now.Add(time.Second), now),
+			false,
+		},
+		{
+			"OldestPodEarlier",
+			controllercommon.NodeUpscaleTieBreakOldestPod,
+			newWaiter("a", 0, now, now.Add(time.Second)),
+			newWaiter("b", 0, now.Add(time.Second), now),
+			true,
+		},
+		{
+			"OldestPodLater",
+			controllercommon.NodeUpscaleTieBreakOldestPod,
+			newWaiter("a", 0, now.Add(time.Second), now),
+			newWaiter("b", 0, now, now.Add(time.Second)),
+			false,
+		},
+		{"NameEarlier", controllercommon.NodeUpscaleTieBreakLongestWaiting, newWaiter("a", 0, now, now), newWaiter("b", 0, now, now), true},
+		{"NameLater", controllercommon.NodeUpscaleTieBreakLongestWaiting, newWaiter("b", 0, now, now), newWaiter("a", 0, now, now), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newNodeUpscaleQueue(controllercommon.ControllerConfig{NodeUpscaleTieBreak: tt.tieBreak}, nil)
+			assert.Equal(t, tt.want, q.ranksAhead(tt.a, tt.b))
+		})
+	}
+}
+
+func nodeUpscalePod(name string, priority int32, created time.Time) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "namespace",
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: v1.PodSpec{
+			NodeName: "node",
+			Priority: &priority,
+		},
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
+	"k8s.io/api/core/v1"
+)
+
+const (
+	forceStateStartup     = "startup"
+	forceStatePostStartup = "post-startup"
+)
+
+// overrideFor returns the override indicated by the annotations of the supplied pod. Pausing takes precedence over
+// forcing a state.
+func overrideFor(pod *v1.Pod) (podcommon.Override, error) {
+	if value, present := pod.Annotations[kubecommon.AnnotationPaused]; present {
+		paused, err := strconv.ParseBool(value)
+		if err != nil {
+			return podcommon.OverrideNone, common.WrapErrorf(
+				err,
+				"unable to parse '%s' annotation value ('%s')",
+				kubecommon.AnnotationPaused, value,
+			)
+		}
+
+		if paused {
+			return podcommon.OverridePaused, nil
+		}
+	}
+
+	if value, present := pod.Annotations[kubecommon.AnnotationForceState]; present {
+		switch value {
+		case forceStateStartup:
+			return podcommon.OverrideForceStartup, nil
+		case forceStatePostStartup:
+			return podcommon.OverrideForcePostStartup, nil
+		default:
+			return podcommon.OverrideNone, fmt.Errorf(
+				"'%s' annotation value must be '%s' or '%s' ('%s')",
+				kubecommon.AnnotationForceState, forceStateStartup, forceStatePostStartup, value,
+			)
+		}
+	}
+
+	return podcommon.OverrideNone, nil
+}
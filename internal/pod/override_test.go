@@ -0,0 +1,96 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"testing"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestOverrideFor(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantErrMsg  string
+		want        podcommon.Override
+	}{
+		{
+			"None",
+			nil,
+			"",
+			podcommon.OverrideNone,
+		},
+		{
+			"UnableToParsePaused",
+			map[string]string{kubecommon.AnnotationPaused: "test"},
+			"unable to parse 'csa.expediagroup.com/paused' annotation value ('test')",
+			podcommon.OverrideNone,
+		},
+		{
+			"PausedTrue",
+			map[string]string{kubecommon.AnnotationPaused: "true"},
+			"",
+			podcommon.OverridePaused,
+		},
+		{
+			"PausedFalse",
+			map[string]string{kubecommon.AnnotationPaused: "false"},
+			"",
+			podcommon.OverrideNone,
+		},
+		{
+			"PausedTakesPrecedence",
+			map[string]string{kubecommon.AnnotationPaused: "true", kubecommon.AnnotationForceState: forceStateStartup},
+			"",
+			podcommon.OverridePaused,
+		},
+		{
+			"ForceStateInvalid",
+			map[string]string{kubecommon.AnnotationForceState: "test"},
+			"'csa.expediagroup.com/force-state' annotation value must be 'startup' or 'post-startup' ('test')",
+			podcommon.OverrideNone,
+		},
+		{
+			"ForceStateStartup",
+			map[string]string{kubecommon.AnnotationForceState: forceStateStartup},
+			"",
+			podcommon.OverrideForceStartup,
+		},
+		{
+			"ForceStatePostStartup",
+			map[string]string{kubecommon.AnnotationPaused: "false", kubecommon.AnnotationForceState: forceStatePostStartup},
+			"",
+			podcommon.OverrideForcePostStartup,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := overrideFor(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}})
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
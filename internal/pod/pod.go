@@ -34,6 +34,7 @@ type Pod struct {
 	TargetContainerState  podcommon.TargetContainerState
 	TargetContainerAction podcommon.TargetContainerAction
 	Status                podcommon.Status
+	HandBack              podcommon.HandBack
 	PodHelper             kubecommon.PodHelper
 	ContainerHelper       kubecommon.ContainerHelper
 }
@@ -41,18 +42,38 @@ type Pod struct {
 func NewPod(
 	controllerConfig controllercommon.ControllerConfig,
 	client client.Client,
+	apiReader client.Reader,
+	nodePodReader client.Reader,
 	recorder record.EventRecorder,
+	breaker kubecommon.CircuitBreaker,
 ) *Pod {
-	podHelper := kube.NewPodHelper(client)
+	podHelper := kube.NewPodHelper(client, breaker)
 	containerHelper := kube.NewContainerHelper()
-	stat := newStatus(recorder, podHelper)
+	nodeHelper := kube.NewNodeHelper(client, nodePodReader)
+	namespaceHelper := kube.NewNamespaceHelper(client)
+	stat := newStatus(recorder, podHelper, controllerConfig.StartupEvictionProtectionAnnotationsMap())
+	config := newConfiguration(podHelper, containerHelper)
+	surplus := newStartupSurplus(config, containerHelper, nodeHelper)
+	queue := newNodeUpscaleQueue(controllerConfig, podHelper)
+	fallback := newEvictionFallback(controllerConfig, podHelper, client, apiReader)
+
+	// Hand back requires pods that may no longer be present within the informer cache.
+	uncachedPodHelper := kube.NewPodHelper(kube.NewUncachedClient(client, apiReader), breaker)
+
+	// When node-local, the informer cache only includes pods on the node, so workload pods are listed from the Kube API.
+	startupsPodHelper := podHelper
+	if controllerConfig.NodeName != "" {
+		startupsPodHelper = uncachedPodHelper
+	}
+	startups := newWorkloadStartups(startupsPodHelper, apiReader)
 
 	return &Pod{
-		Configuration:         newConfiguration(podHelper, containerHelper),
-		Validation:            newValidation(stat, podHelper, containerHelper, event.DefaultPodEventPublisher),
+		Configuration:         config,
+		Validation:            newValidation(stat, podHelper, containerHelper, namespaceHelper, event.DefaultPodEventPublisher),
 		TargetContainerState:  newTargetContainerState(podHelper, containerHelper),
-		TargetContainerAction: newTargetContainerAction(controllerConfig, stat, podHelper, event.DefaultPodEventPublisher),
+		TargetContainerAction: newTargetContainerAction(controllerConfig, stat, podHelper, nodeHelper, namespaceHelper, surplus, queue, startups, fallback, event.DefaultPodEventPublisher),
 		Status:                stat,
+		HandBack:              newHandBack(controllerConfig, recorder, uncachedPodHelper, containerHelper, queue, event.DefaultPodEventPublisher),
 		PodHelper:             podHelper,
 		ContainerHelper:       containerHelper,
 	}
@@ -20,18 +20,28 @@ import (
 	"testing"
 
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestNewPod(t *testing.T) {
-	pod := NewPod(controllercommon.ControllerConfig{}, fake.NewClientBuilder().Build(), &record.FakeRecorder{})
+	client := fake.NewClientBuilder().Build()
+	pod := NewPod(
+		controllercommon.ControllerConfig{},
+		client,
+		client,
+		client,
+		&record.FakeRecorder{},
+		kube.NewDisabledCircuitBreaker(),
+	)
 	assert.NotNil(t, pod.Configuration)
 	assert.NotNil(t, pod.Validation)
 	assert.NotNil(t, pod.TargetContainerState)
 	assert.NotNil(t, pod.TargetContainerAction)
 	assert.NotNil(t, pod.Status)
+	assert.NotNil(t, pod.HandBack)
 	assert.NotNil(t, pod.PodHelper)
 	assert.NotNil(t, pod.ContainerHelper)
 }
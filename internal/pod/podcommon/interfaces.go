@@ -18,10 +18,12 @@ package podcommon
 
 import (
 	"context"
+	"time"
 
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/event/eventcommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/scale/scalecommon"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // Configuration performs operations relating to configuration.
@@ -62,6 +64,58 @@ type TargetContainerAction interface {
 	) error
 }
 
+// StartupSurplus performs operations relating to startup surplus (startup resources less post-startup requests).
+type StartupSurplus interface {
+	Reserve(
+		ctx context.Context,
+		pod *v1.Pod,
+		required v1.ResourceList,
+		budget v1.ResourceList,
+	) (bool, v1.ResourceList, error)
+
+	Release(
+		pod *v1.Pod,
+	)
+}
+
+// NodeUpscaleQueue performs operations relating to the ordering of pods waiting for startup resources on each node.
+type NodeUpscaleQueue interface {
+	Wait(
+		pod *v1.Pod,
+	)
+
+	Ahead(
+		ctx context.Context,
+		pod *v1.Pod,
+	) (int, error)
+
+	Remove(
+		pod *v1.Pod,
+	)
+}
+
+// WorkloadStartups performs operations relating to the pods of a workload that hold startup resources.
+type WorkloadStartups interface {
+	Limit(
+		ctx context.Context,
+		pod *v1.Pod,
+	) (int, error)
+
+	Acquire(
+		ctx context.Context,
+		pod *v1.Pod,
+		limit int,
+	) (bool, int, error)
+}
+
+// EvictionFallback performs operations relating to evicting pods for which startup resources can't be enacted.
+type EvictionFallback interface {
+	Evict(
+		ctx context.Context,
+		pod *v1.Pod,
+	) (bool, time.Duration, error)
+}
+
 // Status performs operations relating to controller status.
 type Status interface {
 	Update(
@@ -75,3 +129,20 @@ type Status interface {
 		failReason string,
 	) (*v1.Pod, error)
 }
+
+// HandBack performs operations relating to handing back pods for which CSA has been disabled.
+type HandBack interface {
+	IsDisabled(
+		pod *v1.Pod,
+	) bool
+
+	DisabledPod(
+		ctx context.Context,
+		name types.NamespacedName,
+	) (bool, *v1.Pod, error)
+
+	Execute(
+		ctx context.Context,
+		pod *v1.Pod,
+	) error
+}
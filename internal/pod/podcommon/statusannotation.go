@@ -70,10 +70,15 @@ func StatusAnnotationFromString(s string) (StatusAnnotation, error) {
 
 // StatusAnnotationScale holds scale-related information that's serialized to JSON for status reporting.
 type StatusAnnotationScale struct {
-	EnabledForResources []v1.ResourceName `json:"enabledForResources"`
-	LastCommanded       string            `json:"lastCommanded"`
-	LastEnacted         string            `json:"lastEnacted"`
-	LastFailed          string            `json:"lastFailed"`
+	EnabledForResources      []v1.ResourceName `json:"enabledForResources"`
+	LastCommanded            string            `json:"lastCommanded"`
+	LastEnacted              string            `json:"lastEnacted"`
+	LastFailed               string            `json:"lastFailed"`
+	LastAppliedConfiguration string            `json:"lastAppliedConfiguration"`
+	AdmittedResources        StateResources    `json:"admittedResources"`
+	StartupResourcesHeld     bool              `json:"startupResourcesHeld"`
+	Override                 Override          `json:"override"`
+	DryRun                   bool              `json:"dryRun"`
 }
 
 func NewStatusAnnotationScale(
@@ -81,12 +86,22 @@ func NewStatusAnnotationScale(
 	lastCommanded string,
 	lastEnacted string,
 	lastFailed string,
+	lastAppliedConfiguration string,
+	admittedResources StateResources,
+	startupResourcesHeld bool,
+	override Override,
+	dryRun bool,
 ) StatusAnnotationScale {
 	return StatusAnnotationScale{
 		fixedEnabledForResources(enabledForResources),
 		lastCommanded,
 		lastEnacted,
 		lastFailed,
+		lastAppliedConfiguration,
+		admittedResources,
+		startupResourcesHeld,
+		override,
+		dryRun,
 	}
 }
 
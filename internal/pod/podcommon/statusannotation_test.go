@@ -44,13 +44,13 @@ func TestNewEmptyStatusAnnotation(t *testing.T) {
 func TestStatusAnnotationJson(t *testing.T) {
 	j := NewStatusAnnotation(
 		"status",
-		NewStatusAnnotationScale([]v1.ResourceName{v1.ResourceCPU}, "1", "2", "3"),
+		NewStatusAnnotationScale([]v1.ResourceName{v1.ResourceCPU}, "1", "2", "3", "5", StateResourcesStartup, true, OverridePaused, true),
 		"4",
 	).Json()
 	assert.Equal(
 		t,
 		`{"status":"status",`+
-			`"scale":{"enabledForResources":["cpu"],"lastCommanded":"1","lastEnacted":"2","lastFailed":"3"},`+
+			`"scale":{"enabledForResources":["cpu"],"lastCommanded":"1","lastEnacted":"2","lastFailed":"3","lastAppliedConfiguration":"5","admittedResources":"startup","startupResourcesHeld":true,"override":"paused","dryRun":true},`+
 			`"lastUpdated":"4"}`,
 		j,
 	)
@@ -109,7 +109,7 @@ func TestStatusAnnotationFromString(t *testing.T) {
 	t.Run("Ok", func(t *testing.T) {
 		got, err := StatusAnnotationFromString(
 			`{"status":"status",` +
-				`"scale":{"enabledForResources":["cpu"],"lastCommanded":"1","lastEnacted":"2","lastFailed":"3"},` +
+				`"scale":{"enabledForResources":["cpu"],"lastCommanded":"1","lastEnacted":"2","lastFailed":"3","lastAppliedConfiguration":"5","admittedResources":"startup","startupResourcesHeld":true,"override":"paused","dryRun":true},` +
 				`"lastUpdated":"4"}`,
 		)
 		assert.NoError(t, err)
@@ -117,7 +117,7 @@ func TestStatusAnnotationFromString(t *testing.T) {
 			t,
 			NewStatusAnnotation(
 				"status",
-				NewStatusAnnotationScale([]v1.ResourceName{v1.ResourceCPU}, "1", "2", "3"),
+				NewStatusAnnotationScale([]v1.ResourceName{v1.ResourceCPU}, "1", "2", "3", "5", StateResourcesStartup, true, OverridePaused, true),
 				"4",
 			),
 			got,
@@ -131,12 +131,22 @@ func TestNewStatusAnnotationScale(t *testing.T) {
 		"lastCommanded",
 		"lastEnacted",
 		"lastFailed",
+		"lastAppliedConfiguration",
+		StateResourcesStartup,
+		true,
+		OverridePaused,
+		true,
 	)
 	expected := StatusAnnotationScale{
-		EnabledForResources: []v1.ResourceName{v1.ResourceCPU},
-		LastCommanded:       "lastCommanded",
-		LastEnacted:         "lastEnacted",
-		LastFailed:          "lastFailed",
+		EnabledForResources:      []v1.ResourceName{v1.ResourceCPU},
+		LastCommanded:            "lastCommanded",
+		LastEnacted:              "lastEnacted",
+		LastFailed:               "lastFailed",
+		LastAppliedConfiguration: "lastAppliedConfiguration",
+		AdmittedResources:        StateResourcesStartup,
+		StartupResourcesHeld:     true,
+		Override:                 OverridePaused,
+		DryRun:                   true,
 	}
 	assert.Equal(t, expected, statAnn)
 }
@@ -144,10 +154,12 @@ func TestNewStatusAnnotationScale(t *testing.T) {
 func TestNewEmptyStatusAnnotationScale(t *testing.T) {
 	statAnn := NewEmptyStatusAnnotationScale([]v1.ResourceName{v1.ResourceCPU})
 	expected := StatusAnnotationScale{
-		EnabledForResources: []v1.ResourceName{v1.ResourceCPU},
-		LastCommanded:       "",
-		LastEnacted:         "",
-		LastFailed:          "",
+		EnabledForResources:      []v1.ResourceName{v1.ResourceCPU},
+		LastCommanded:            "",
+		LastEnacted:              "",
+		LastFailed:               "",
+		LastAppliedConfiguration: "",
+		AdmittedResources:        "",
 	}
 	assert.Equal(t, expected, statAnn)
 }
@@ -38,6 +38,13 @@ const (
 	// StatusScaleStateUpFailed indicates scaling up failed.
 	StatusScaleStateUpFailed StatusScaleState = "upfailed"
 
+	// StatusScaleStateUpQuotaExceeded indicates scaling up rejected as a resource quota would be exceeded.
+	StatusScaleStateUpQuotaExceeded StatusScaleState = "upquotaexceeded"
+
+	// StatusScaleStateUpEvicted indicates scaling up abandoned and the pod evicted as startup resources couldn't be
+	// enacted.
+	StatusScaleStateUpEvicted StatusScaleState = "upevicted"
+
 	// StatusScaleStateDownCommanded indicates scaling down commanded.
 	StatusScaleStateDownCommanded StatusScaleState = "downcommanded"
 
@@ -49,12 +56,32 @@ const (
 
 	// StatusScaleStateUnknownCommanded indicates scaling in an unknown direction commanded.
 	StatusScaleStateUnknownCommanded StatusScaleState = "unknowncommanded"
+
+	// StatusScaleStateReconfiguredCommanded indicates scaling commanded as a result of configuration being changed.
+	StatusScaleStateReconfiguredCommanded StatusScaleState = "reconfiguredcommanded"
 )
 
+// IsCommanded returns whether a scale is commanded.
+func (s StatusScaleState) IsCommanded() bool {
+	switch s {
+	case StatusScaleStateUpCommanded,
+		StatusScaleStateDownCommanded,
+		StatusScaleStateUnknownCommanded,
+		StatusScaleStateReconfiguredCommanded:
+		return true
+	}
+
+	return false
+}
+
 // Direction returns the scale direction.
 func (s StatusScaleState) Direction() metricscommon.Direction {
 	switch s {
-	case StatusScaleStateUpCommanded, StatusScaleStateUpEnacted, StatusScaleStateUpFailed:
+	case StatusScaleStateUpCommanded,
+		StatusScaleStateUpEnacted,
+		StatusScaleStateUpFailed,
+		StatusScaleStateUpQuotaExceeded,
+		StatusScaleStateUpEvicted:
 		return metricscommon.DirectionUp
 	case StatusScaleStateDownCommanded, StatusScaleStateDownEnacted, StatusScaleStateDownFailed:
 		return metricscommon.DirectionDown
@@ -23,6 +23,30 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestStatusScaleStateIsCommanded(t *testing.T) {
+	tests := []struct {
+		s    StatusScaleState
+		want bool
+	}{
+		{StatusScaleStateNotApplicable, false},
+		{StatusScaleStateUpCommanded, true},
+		{StatusScaleStateUpEnacted, false},
+		{StatusScaleStateUpFailed, false},
+		{StatusScaleStateUpQuotaExceeded, false},
+		{StatusScaleStateUpEvicted, false},
+		{StatusScaleStateDownCommanded, true},
+		{StatusScaleStateDownEnacted, false},
+		{StatusScaleStateDownFailed, false},
+		{StatusScaleStateUnknownCommanded, true},
+		{StatusScaleStateReconfiguredCommanded, true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.s), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.s.IsCommanded())
+		})
+	}
+}
+
 func TestStatusScaleStateDirection(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -48,6 +72,18 @@ func TestStatusScaleStateDirection(t *testing.T) {
 			"",
 			metricscommon.DirectionUp,
 		},
+		{
+			string(StatusScaleStateUpQuotaExceeded),
+			StatusScaleStateUpQuotaExceeded,
+			"",
+			metricscommon.DirectionUp,
+		},
+		{
+			string(StatusScaleStateUpEvicted),
+			StatusScaleStateUpEvicted,
+			"",
+			metricscommon.DirectionUp,
+		},
 		{
 			string(StatusScaleStateDownCommanded),
 			StatusScaleStateDownCommanded,
@@ -0,0 +1,53 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtest
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"k8s.io/api/core/v1"
+)
+
+type MockEvictionFallback struct {
+	mock.Mock
+}
+
+func NewMockEvictionFallback(configFunc func(*MockEvictionFallback)) *MockEvictionFallback {
+	m := &MockEvictionFallback{}
+	if configFunc != nil {
+		configFunc(m)
+	} else {
+		m.AllDefaults()
+	}
+
+	return m
+}
+
+func (m *MockEvictionFallback) Evict(ctx context.Context, pod *v1.Pod) (bool, time.Duration, error) {
+	args := m.Called(ctx, pod)
+	return args.Bool(0), args.Get(1).(time.Duration), args.Error(2)
+}
+
+func (m *MockEvictionFallback) EvictDefault() {
+	m.On("Evict", mock.Anything, mock.Anything).Return(true, time.Duration(0), nil)
+}
+
+func (m *MockEvictionFallback) AllDefaults() {
+	m.EvictDefault()
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtest
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type MockHandBack struct {
+	mock.Mock
+}
+
+func NewMockHandBack(configFunc func(*MockHandBack)) *MockHandBack {
+	m := &MockHandBack{}
+	if configFunc != nil {
+		configFunc(m)
+	} else {
+		m.AllDefaults()
+	}
+
+	return m
+}
+
+func (m *MockHandBack) IsDisabled(pod *v1.Pod) bool {
+	args := m.Called(pod)
+	return args.Bool(0)
+}
+
+func (m *MockHandBack) DisabledPod(ctx context.Context, name types.NamespacedName) (bool, *v1.Pod, error) {
+	args := m.Called(ctx, name)
+	var pod *v1.Pod
+	if args.Get(1) != nil {
+		pod = args.Get(1).(*v1.Pod)
+	}
+	return args.Bool(0), pod, args.Error(2)
+}
+
+func (m *MockHandBack) Execute(ctx context.Context, pod *v1.Pod) error {
+	args := m.Called(ctx, pod)
+	return args.Error(0)
+}
+
+func (m *MockHandBack) IsDisabledDefault() {
+	m.On("IsDisabled", mock.Anything).Return(false)
+}
+
+func (m *MockHandBack) DisabledPodDefault() {
+	m.On("DisabledPod", mock.Anything, mock.Anything).Return(false, nil, nil)
+}
+
+func (m *MockHandBack) ExecuteDefault() {
+	m.On("Execute", mock.Anything, mock.Anything).Return(nil)
+}
+
+func (m *MockHandBack) AllDefaults() {
+	m.IsDisabledDefault()
+	m.DisabledPodDefault()
+	m.ExecuteDefault()
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtest
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"k8s.io/api/core/v1"
+)
+
+type MockNodeUpscaleQueue struct {
+	mock.Mock
+}
+
+func NewMockNodeUpscaleQueue(configFunc func(*MockNodeUpscaleQueue)) *MockNodeUpscaleQueue {
+	m := &MockNodeUpscaleQueue{}
+	if configFunc != nil {
+		configFunc(m)
+	} else {
+		m.AllDefaults()
+	}
+
+	return m
+}
+
+func (m *MockNodeUpscaleQueue) Wait(pod *v1.Pod) {
+	m.Called(pod)
+}
+
+func (m *MockNodeUpscaleQueue) Ahead(ctx context.Context, pod *v1.Pod) (int, error) {
+	args := m.Called(ctx, pod)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockNodeUpscaleQueue) Remove(pod *v1.Pod) {
+	m.Called(pod)
+}
+
+func (m *MockNodeUpscaleQueue) WaitDefault() {
+	m.On("Wait", mock.Anything).Return()
+}
+
+func (m *MockNodeUpscaleQueue) AheadDefault() {
+	m.On("Ahead", mock.Anything, mock.Anything).Return(0, nil)
+}
+
+func (m *MockNodeUpscaleQueue) RemoveDefault() {
+	m.On("Remove", mock.Anything).Return()
+}
+
+func (m *MockNodeUpscaleQueue) AllDefaults() {
+	m.WaitDefault()
+	m.AheadDefault()
+	m.RemoveDefault()
+}
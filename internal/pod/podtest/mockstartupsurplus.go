@@ -0,0 +1,66 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtest
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"k8s.io/api/core/v1"
+)
+
+type MockStartupSurplus struct {
+	mock.Mock
+}
+
+func NewMockStartupSurplus(configFunc func(*MockStartupSurplus)) *MockStartupSurplus {
+	m := &MockStartupSurplus{}
+	if configFunc != nil {
+		configFunc(m)
+	} else {
+		m.AllDefaults()
+	}
+
+	return m
+}
+
+func (m *MockStartupSurplus) Reserve(
+	ctx context.Context,
+	pod *v1.Pod,
+	required v1.ResourceList,
+	budget v1.ResourceList,
+) (bool, v1.ResourceList, error) {
+	args := m.Called(ctx, pod, required, budget)
+	return args.Bool(0), args.Get(1).(v1.ResourceList), args.Error(2)
+}
+
+func (m *MockStartupSurplus) Release(pod *v1.Pod) {
+	m.Called(pod)
+}
+
+func (m *MockStartupSurplus) ReserveDefault() {
+	m.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, v1.ResourceList{}, nil)
+}
+
+func (m *MockStartupSurplus) ReleaseDefault() {
+	m.On("Release", mock.Anything).Return()
+}
+
+func (m *MockStartupSurplus) AllDefaults() {
+	m.ReserveDefault()
+	m.ReleaseDefault()
+}
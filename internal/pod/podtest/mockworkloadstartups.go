@@ -0,0 +1,62 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtest
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"k8s.io/api/core/v1"
+)
+
+type MockWorkloadStartups struct {
+	mock.Mock
+}
+
+func NewMockWorkloadStartups(configFunc func(*MockWorkloadStartups)) *MockWorkloadStartups {
+	m := &MockWorkloadStartups{}
+	if configFunc != nil {
+		configFunc(m)
+	} else {
+		m.AllDefaults()
+	}
+
+	return m
+}
+
+func (m *MockWorkloadStartups) Limit(ctx context.Context, pod *v1.Pod) (int, error) {
+	args := m.Called(ctx, pod)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockWorkloadStartups) Acquire(ctx context.Context, pod *v1.Pod, limit int) (bool, int, error) {
+	args := m.Called(ctx, pod, limit)
+	return args.Bool(0), args.Int(1), args.Error(2)
+}
+
+func (m *MockWorkloadStartups) LimitDefault() {
+	m.On("Limit", mock.Anything, mock.Anything).Return(0, nil)
+}
+
+func (m *MockWorkloadStartups) AcquireDefault() {
+	m.On("Acquire", mock.Anything, mock.Anything, mock.Anything).Return(true, 0, nil)
+}
+
+func (m *MockWorkloadStartups) AllDefaults() {
+	m.LimitDefault()
+	m.AcquireDefault()
+}
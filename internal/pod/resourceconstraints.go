@@ -0,0 +1,227 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/scale/scalecommon"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// limitRangeViolations returns human-readable descriptions of where the target container's startup or post-startup
+// resources fall outside the constraints of the supplied limit ranges. Pod constraints are evaluated against the sum of
+// all (non-init) containers' resources, with those of the target container substituted.
+func limitRangeViolations(
+	limitRanges []v1.LimitRange,
+	pod *v1.Pod,
+	targetContainerName string,
+	scaleConfigs scalecommon.Configurations,
+) []string {
+	var violations []string
+
+	for _, config := range scaleConfigs.AllEnabledConfigurations() {
+		resourceName := config.ResourceName()
+		resources := config.Resources()
+
+		phases := []struct {
+			name     string
+			requests resource.Quantity
+			limits   resource.Quantity
+		}{
+			{"startup", resources.Startup, resources.Startup},
+			{"post-startup", resources.PostStartupRequests, resources.PostStartupLimits},
+		}
+
+		for _, phase := range phases {
+			podRequests, podLimits := phase.requests.DeepCopy(), phase.limits.DeepCopy()
+			for _, container := range pod.Spec.Containers {
+				if container.Name != targetContainerName {
+					podRequests.Add(container.Resources.Requests[resourceName])
+					podLimits.Add(container.Resources.Limits[resourceName])
+				}
+			}
+
+			for _, limitRange := range limitRanges {
+				for _, item := range limitRange.Spec.Limits {
+					var found []string
+
+					switch item.Type {
+					case v1.LimitTypeContainer:
+						found = limitRangeItemViolations(item, resourceName, phase.requests, phase.limits)
+					case v1.LimitTypePod:
+						found = limitRangeItemViolations(item, resourceName, podRequests, podLimits)
+					}
+
+					for _, violation := range found {
+						violations = append(violations, fmt.Sprintf(
+							"%s %s %s (limit range '%s' %s)",
+							resourceName, phase.name, violation, limitRange.Name, strings.ToLower(string(item.Type)),
+						))
+					}
+				}
+			}
+		}
+	}
+
+	return violations
+}
+
+// limitRangeItemViolations returns human-readable descriptions of where the supplied requests and limits for the
+// supplied resource fall outside the min, max and max limit to request ratio constraints of the supplied limit range
+// item.
+func limitRangeItemViolations(
+	item v1.LimitRangeItem,
+	resourceName v1.ResourceName,
+	requests resource.Quantity,
+	limits resource.Quantity,
+) []string {
+	var violations []string
+
+	if minimum, found := item.Min[resourceName]; found {
+		if requests.Cmp(minimum) < 0 {
+			violations = append(violations, fmt.Sprintf("requests %s below min %s", requests.String(), minimum.String()))
+		}
+		if limits.Cmp(minimum) < 0 {
+			violations = append(violations, fmt.Sprintf("limits %s below min %s", limits.String(), minimum.String()))
+		}
+	}
+
+	if maximum, found := item.Max[resourceName]; found {
+		if requests.Cmp(maximum) > 0 {
+			violations = append(violations, fmt.Sprintf("requests %s above max %s", requests.String(), maximum.String()))
+		}
+		if limits.Cmp(maximum) > 0 {
+			violations = append(violations, fmt.Sprintf("limits %s above max %s", limits.String(), maximum.String()))
+		}
+	}
+
+	if ratio, found := item.MaxLimitRequestRatio[resourceName]; found && !requests.IsZero() {
+		if float64(limits.MilliValue())/float64(requests.MilliValue()) > ratio.AsApproximateFloat64() {
+			violations = append(violations, fmt.Sprintf("limits to requests ratio above max %s", ratio.String()))
+		}
+	}
+
+	return violations
+}
+
+// resourceQuotaShortfalls returns human-readable descriptions of where commanding the target container's startup
+// resources would exceed the headroom (hard less used) of the supplied resource quotas that apply to the pod. Only
+// increases from the target container's current requests and limits are considered.
+func resourceQuotaShortfalls(
+	quotas []v1.ResourceQuota,
+	pod *v1.Pod,
+	targetContainer *v1.Container,
+	scaleConfigs scalecommon.Configurations,
+) []string {
+	var shortfalls []string
+
+	for _, quota := range quotas {
+		if !resourceQuotaAppliesTo(quota, pod) {
+			continue
+		}
+
+		for _, config := range scaleConfigs.AllEnabledConfigurations() {
+			resourceName := config.ResourceName()
+
+			requestsIncrease := config.Resources().Startup.DeepCopy()
+			requestsIncrease.Sub(targetContainer.Resources.Requests[resourceName])
+			limitsIncrease := config.Resources().Startup.DeepCopy()
+			limitsIncrease.Sub(targetContainer.Resources.Limits[resourceName])
+
+			increases := []struct {
+				quotaResourceName v1.ResourceName
+				increase          resource.Quantity
+			}{
+				{resourceName, requestsIncrease},
+				{v1.ResourceName("requests." + resourceName), requestsIncrease},
+				{v1.ResourceName("limits." + resourceName), limitsIncrease},
+			}
+
+			for _, increase := range increases {
+				hard, found := quota.Status.Hard[increase.quotaResourceName]
+				if !found || increase.increase.Sign() <= 0 {
+					continue
+				}
+
+				available := hard.DeepCopy()
+				available.Sub(quota.Status.Used[increase.quotaResourceName])
+				if increase.increase.Cmp(available) > 0 {
+					shortfalls = append(shortfalls, fmt.Sprintf(
+						"%s %s: %s required, %s available",
+						quota.Name, increase.quotaResourceName, increase.increase.String(), available.String(),
+					))
+				}
+			}
+		}
+	}
+
+	return shortfalls
+}
+
+// resourceQuotaAppliesTo returns whether the supplied resource quota applies to the supplied pod, per its scopes and
+// scope selector.
+func resourceQuotaAppliesTo(quota v1.ResourceQuota, pod *v1.Pod) bool {
+	for _, scope := range quota.Spec.Scopes {
+		requirement := v1.ScopedResourceSelectorRequirement{ScopeName: scope, Operator: v1.ScopeSelectorOpExists}
+		if !resourceQuotaScopeMatches(requirement, pod) {
+			return false
+		}
+	}
+
+	if quota.Spec.ScopeSelector != nil {
+		for _, requirement := range quota.Spec.ScopeSelector.MatchExpressions {
+			if !resourceQuotaScopeMatches(requirement, pod) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// resourceQuotaScopeMatches returns whether the supplied resource quota scope requirement matches the supplied pod.
+// Scopes that aren't evaluated (e.g. cross-namespace pod affinity) are treated as not matching, leaving any rejection
+// to the Kube API.
+func resourceQuotaScopeMatches(requirement v1.ScopedResourceSelectorRequirement, pod *v1.Pod) bool {
+	switch requirement.ScopeName {
+	case v1.ResourceQuotaScopeTerminating:
+		return pod.Spec.ActiveDeadlineSeconds != nil
+	case v1.ResourceQuotaScopeNotTerminating:
+		return pod.Spec.ActiveDeadlineSeconds == nil
+	case v1.ResourceQuotaScopeBestEffort:
+		return false // Pods must be guaranteed (checked upon validation).
+	case v1.ResourceQuotaScopeNotBestEffort:
+		return true
+	case v1.ResourceQuotaScopePriorityClass:
+		switch requirement.Operator {
+		case v1.ScopeSelectorOpExists:
+			return pod.Spec.PriorityClassName != ""
+		case v1.ScopeSelectorOpDoesNotExist:
+			return pod.Spec.PriorityClassName == ""
+		case v1.ScopeSelectorOpIn:
+			return slices.Contains(requirement.Values, pod.Spec.PriorityClassName)
+		case v1.ScopeSelectorOpNotIn:
+			return !slices.Contains(requirement.Values, pod.Spec.PriorityClassName)
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,298 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"testing"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/scale/scaletest"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLimitRangeViolations(t *testing.T) {
+	newLimitRange := func(item v1.LimitRangeItem) []v1.LimitRange {
+		return []v1.LimitRange{{
+			ObjectMeta: metav1.ObjectMeta{Name: "limitrange"},
+			Spec:       v1.LimitRangeSpec{Limits: []v1.LimitRangeItem{item}},
+		}}
+	}
+	pod := &v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "target"},
+				{
+					Name: "other",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("5m")},
+						Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("5m")},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		limitRanges []v1.LimitRange
+		want        []string
+	}{
+		{"NoLimitRanges", nil, nil},
+		{
+			"ContainerWithin",
+			newLimitRange(v1.LimitRangeItem{
+				Type: v1.LimitTypeContainer,
+				Min:  v1.ResourceList{v1.ResourceCPU: resource.MustParse("1m")},
+				Max:  v1.ResourceList{v1.ResourceCPU: resource.MustParse("3m")},
+			}),
+			nil,
+		},
+		{
+			"ContainerBelowMin",
+			newLimitRange(v1.LimitRangeItem{
+				Type: v1.LimitTypeContainer,
+				Min:  v1.ResourceList{v1.ResourceCPU: resource.MustParse("2m")},
+			}),
+			[]string{"cpu post-startup requests 1m below min 2m (limit range 'limitrange' container)"},
+		},
+		{
+			"ContainerAboveMax",
+			newLimitRange(v1.LimitRangeItem{
+				Type: v1.LimitTypeContainer,
+				Max:  v1.ResourceList{v1.ResourceCPU: resource.MustParse("2m")},
+			}),
+			[]string{
+				"cpu startup requests 3m above max 2m (limit range 'limitrange' container)",
+				"cpu startup limits 3m above max 2m (limit range 'limitrange' container)",
+			},
+		},
+		{
+			"ContainerAboveMaxLimitRequestRatio",
+			newLimitRange(v1.LimitRangeItem{
+				Type:                 v1.LimitTypeContainer,
+				MaxLimitRequestRatio: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1.5")},
+			}),
+			[]string{"cpu post-startup limits to requests ratio above max 1500m (limit range 'limitrange' container)"},
+		},
+		{
+			"PodAboveMax",
+			newLimitRange(v1.LimitRangeItem{
+				Type: v1.LimitTypePod,
+				Max:  v1.ResourceList{v1.ResourceCPU: resource.MustParse("7m")},
+			}),
+			[]string{
+				"cpu startup requests 8m above max 7m (limit range 'limitrange' pod)",
+				"cpu startup limits 8m above max 7m (limit range 'limitrange' pod)",
+			},
+		},
+		{
+			"OtherType",
+			newLimitRange(v1.LimitRangeItem{
+				Type: v1.LimitTypePersistentVolumeClaim,
+				Max:  v1.ResourceList{v1.ResourceCPU: resource.MustParse("1m")},
+			}),
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, limitRangeViolations(tt.limitRanges, pod, "target", scaletest.NewMockConfigurations(nil)))
+		})
+	}
+}
+
+func TestResourceQuotaShortfalls(t *testing.T) {
+	newQuota := func(scopes []v1.ResourceQuotaScope, hard v1.ResourceList, used v1.ResourceList) []v1.ResourceQuota {
+		return []v1.ResourceQuota{{
+			ObjectMeta: metav1.ObjectMeta{Name: "quota"},
+			Spec:       v1.ResourceQuotaSpec{Scopes: scopes},
+			Status:     v1.ResourceQuotaStatus{Hard: hard, Used: used},
+		}}
+	}
+	container := &v1.Container{
+		Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1m")},
+			Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("2m")},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		quotas    []v1.ResourceQuota
+		container *v1.Container
+		want      []string
+	}{
+		{"NoQuotas", nil, container, nil},
+		{
+			"Sufficient",
+			newQuota(
+				nil,
+				v1.ResourceList{"requests.cpu": resource.MustParse("10m"), "limits.cpu": resource.MustParse("10m")},
+				v1.ResourceList{"requests.cpu": resource.MustParse("8m"), "limits.cpu": resource.MustParse("9m")},
+			),
+			container,
+			nil,
+		},
+		{
+			"InsufficientRequests",
+			newQuota(
+				nil,
+				v1.ResourceList{"requests.cpu": resource.MustParse("10m")},
+				v1.ResourceList{"requests.cpu": resource.MustParse("9m")},
+			),
+			container,
+			[]string{"quota requests.cpu: 2m required, 1m available"},
+		},
+		{
+			"InsufficientUnprefixedRequests",
+			newQuota(
+				nil,
+				v1.ResourceList{v1.ResourceCPU: resource.MustParse("10m")},
+				v1.ResourceList{v1.ResourceCPU: resource.MustParse("9m")},
+			),
+			container,
+			[]string{"quota cpu: 2m required, 1m available"},
+		},
+		{
+			"InsufficientLimits",
+			newQuota(
+				nil,
+				v1.ResourceList{"limits.cpu": resource.MustParse("10m")},
+				v1.ResourceList{"limits.cpu": resource.MustParse("10m")},
+			),
+			container,
+			[]string{"quota limits.cpu: 1m required, 0 available"},
+		},
+		{
+			"NoIncrease",
+			newQuota(
+				nil,
+				v1.ResourceList{"requests.cpu": resource.MustParse("10m")},
+				v1.ResourceList{"requests.cpu": resource.MustParse("10m")},
+			),
+			&v1.Container{
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("3m")},
+					Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("3m")},
+				},
+			},
+			nil,
+		},
+		{
+			"NotApplicable",
+			newQuota(
+				[]v1.ResourceQuotaScope{v1.ResourceQuotaScopeBestEffort},
+				v1.ResourceList{"requests.cpu": resource.MustParse("10m")},
+				v1.ResourceList{"requests.cpu": resource.MustParse("10m")},
+			),
+			container,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, resourceQuotaShortfalls(tt.quotas, &v1.Pod{}, tt.container, scaletest.NewMockConfigurations(nil)))
+		})
+	}
+}
+
+func TestResourceQuotaAppliesTo(t *testing.T) {
+	activeDeadlineSeconds := int64(1)
+	priorityClassSelector := func(operator v1.ScopeSelectorOperator, values ...string) *v1.ScopeSelector {
+		return &v1.ScopeSelector{MatchExpressions: []v1.ScopedResourceSelectorRequirement{{
+			ScopeName: v1.ResourceQuotaScopePriorityClass,
+			Operator:  operator,
+			Values:    values,
+		}}}
+	}
+
+	tests := []struct {
+		name  string
+		quota v1.ResourceQuotaSpec
+		pod   *v1.Pod
+		want  bool
+	}{
+		{"Unscoped", v1.ResourceQuotaSpec{}, &v1.Pod{}, true},
+		{
+			"TerminatingTrue",
+			v1.ResourceQuotaSpec{Scopes: []v1.ResourceQuotaScope{v1.ResourceQuotaScopeTerminating}},
+			&v1.Pod{Spec: v1.PodSpec{ActiveDeadlineSeconds: &activeDeadlineSeconds}},
+			true,
+		},
+		{
+			"TerminatingFalse",
+			v1.ResourceQuotaSpec{Scopes: []v1.ResourceQuotaScope{v1.ResourceQuotaScopeTerminating}},
+			&v1.Pod{},
+			false,
+		},
+		{
+			"NotTerminatingTrue",
+			v1.ResourceQuotaSpec{Scopes: []v1.ResourceQuotaScope{v1.ResourceQuotaScopeNotTerminating}},
+			&v1.Pod{},
+			true,
+		},
+		{
+			"BestEffort",
+			v1.ResourceQuotaSpec{Scopes: []v1.ResourceQuotaScope{v1.ResourceQuotaScopeBestEffort}},
+			&v1.Pod{},
+			false,
+		},
+		{
+			"NotBestEffort",
+			v1.ResourceQuotaSpec{Scopes: []v1.ResourceQuotaScope{v1.ResourceQuotaScopeNotBestEffort}},
+			&v1.Pod{},
+			true,
+		},
+		{
+			"CrossNamespacePodAffinity",
+			v1.ResourceQuotaSpec{Scopes: []v1.ResourceQuotaScope{v1.ResourceQuotaScopeCrossNamespacePodAffinity}},
+			&v1.Pod{},
+			false,
+		},
+		{
+			"PriorityClassExists",
+			v1.ResourceQuotaSpec{ScopeSelector: priorityClassSelector(v1.ScopeSelectorOpExists)},
+			&v1.Pod{Spec: v1.PodSpec{PriorityClassName: "high"}},
+			true,
+		},
+		{
+			"PriorityClassDoesNotExist",
+			v1.ResourceQuotaSpec{ScopeSelector: priorityClassSelector(v1.ScopeSelectorOpDoesNotExist)},
+			&v1.Pod{Spec: v1.PodSpec{PriorityClassName: "high"}},
+			false,
+		},
+		{
+			"PriorityClassIn",
+			v1.ResourceQuotaSpec{ScopeSelector: priorityClassSelector(v1.ScopeSelectorOpIn, "high")},
+			&v1.Pod{Spec: v1.PodSpec{PriorityClassName: "high"}},
+			true,
+		},
+		{
+			"PriorityClassNotIn",
+			v1.ResourceQuotaSpec{ScopeSelector: priorityClassSelector(v1.ScopeSelectorOpNotIn, "high")},
+			&v1.Pod{Spec: v1.PodSpec{PriorityClassName: "high"}},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, resourceQuotaAppliesTo(v1.ResourceQuota{Spec: tt.quota}, tt.pod))
+		})
+	}
+}
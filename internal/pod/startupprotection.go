@@ -0,0 +1,113 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	ccontext "github.com/ExpediaGroup/container-startup-autoscaler/internal/context"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
+	"k8s.io/api/core/v1"
+)
+
+// startupProtectionPodMutationFunc returns a function that applies the supplied startup eviction protection
+// annotations to a pod while it requires protection (see startupProtectionRequired), and removes them otherwise.
+// Annotations already present on the pod are left untouched. The keys of those applied are recorded within the startup
+// protection annotation, so that only these are later removed. Must follow the status mutation within the same patch.
+func startupProtectionPodMutationFunc(
+	ctx context.Context,
+	annotations map[string]string,
+) func(*v1.Pod) (bool, func(*v1.Pod) bool, error) {
+	return func(podToMutate *v1.Pod) (bool, func(*v1.Pod) bool, error) {
+		if len(annotations) == 0 || ccontext.DryRun(ctx) || !startupProtectionRequired(podToMutate) {
+			return removeStartupProtection(podToMutate), nil, nil
+		}
+
+		if podToMutate.Annotations == nil {
+			podToMutate.Annotations = map[string]string{}
+		}
+
+		applied := appliedStartupProtectionKeys(podToMutate)
+		shouldPatch := false
+		for key, value := range annotations {
+			if _, present := podToMutate.Annotations[key]; present {
+				continue
+			}
+
+			podToMutate.Annotations[key] = value
+			applied = append(applied, key)
+			shouldPatch = true
+		}
+
+		if shouldPatch {
+			slices.Sort(applied)
+			podToMutate.Annotations[kubecommon.AnnotationStartupProtection] = strings.Join(applied, ",")
+		}
+
+		return shouldPatch, nil, nil
+	}
+}
+
+// startupProtectionRemovalPodMutationFunc returns a function that removes any startup eviction protection annotations
+// applied to a pod.
+func startupProtectionRemovalPodMutationFunc() func(*v1.Pod) (bool, func(*v1.Pod) bool, error) {
+	return func(podToMutate *v1.Pod) (bool, func(*v1.Pod) bool, error) {
+		return removeStartupProtection(podToMutate), nil, nil
+	}
+}
+
+// removeStartupProtection removes the startup eviction protection annotations applied to the supplied pod, along with
+// the startup protection annotation itself. Returns whether the pod was mutated.
+func removeStartupProtection(pod *v1.Pod) bool {
+	if _, present := pod.Annotations[kubecommon.AnnotationStartupProtection]; !present {
+		return false
+	}
+
+	for _, key := range appliedStartupProtectionKeys(pod) {
+		delete(pod.Annotations, key)
+	}
+	delete(pod.Annotations, kubecommon.AnnotationStartupProtection)
+
+	return true
+}
+
+// appliedStartupProtectionKeys returns the keys of the startup eviction protection annotations applied to the supplied
+// pod, per the startup protection annotation.
+func appliedStartupProtectionKeys(pod *v1.Pod) []string {
+	value := pod.Annotations[kubecommon.AnnotationStartupProtection]
+	if value == "" {
+		return nil
+	}
+
+	return strings.Split(value, ",")
+}
+
+// startupProtectionRequired returns whether the status annotation of the supplied pod indicates that its target
+// container holds startup resources, or that a commanded scale is yet to be enacted (so that protection remains until
+// post-startup resources are enacted). Returns false if status isn't present or can't be unmarshalled.
+func startupProtectionRequired(pod *v1.Pod) bool {
+	stat, err := podcommon.StatusAnnotationFromString(pod.Annotations[kubecommon.AnnotationStatus])
+	if err != nil {
+		return false
+	}
+
+	scaleInProgress := stat.Scale.LastCommanded != "" && stat.Scale.LastEnacted == "" && stat.Scale.LastFailed == ""
+	return stat.Scale.StartupResourcesHeld || scaleInProgress
+}
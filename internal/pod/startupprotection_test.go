@@ -0,0 +1,213 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"testing"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/context/contexttest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStartupProtectionPodMutationFunc(t *testing.T) {
+	protection := map[string]string{"key1": "value1", "key2": "value2"}
+	heldStatus := func(held bool) string {
+		return podcommon.NewStatusAnnotation(
+			"",
+			podcommon.NewStatusAnnotationScale(nil, "", "", "", "", "", held, "", false),
+			"",
+		).Json()
+	}
+
+	tests := []struct {
+		name            string
+		annotations     map[string]string
+		dryRun          bool
+		podAnnotations  map[string]string
+		wantShouldPatch bool
+		wantAnnotations map[string]string
+	}{
+		{
+			"NotEnabledNothingApplied",
+			nil,
+			false,
+			map[string]string{kubecommon.AnnotationStatus: heldStatus(true)},
+			false,
+			map[string]string{kubecommon.AnnotationStatus: heldStatus(true)},
+		},
+		{
+			"NotEnabledRemoved",
+			nil,
+			false,
+			map[string]string{
+				kubecommon.AnnotationStatus:            heldStatus(true),
+				kubecommon.AnnotationStartupProtection: "key1",
+				"key1":                                 "value1",
+			},
+			true,
+			map[string]string{kubecommon.AnnotationStatus: heldStatus(true)},
+		},
+		{
+			"NotHeldRemoved",
+			protection,
+			false,
+			map[string]string{
+				kubecommon.AnnotationStatus:            heldStatus(false),
+				kubecommon.AnnotationStartupProtection: "key1,key2",
+				"key1":                                 "value1",
+				"key2":                                 "value2",
+				"other":                                "value",
+			},
+			true,
+			map[string]string{kubecommon.AnnotationStatus: heldStatus(false), "other": "value"},
+		},
+		{
+			"NoStatusNothingApplied",
+			protection,
+			false,
+			nil,
+			false,
+			nil,
+		},
+		{
+			"DryRunNothingApplied",
+			protection,
+			true,
+			map[string]string{kubecommon.AnnotationStatus: heldStatus(true)},
+			false,
+			map[string]string{kubecommon.AnnotationStatus: heldStatus(true)},
+		},
+		{
+			"HeldApplied",
+			protection,
+			false,
+			map[string]string{kubecommon.AnnotationStatus: heldStatus(true)},
+			true,
+			map[string]string{
+				kubecommon.AnnotationStatus:            heldStatus(true),
+				kubecommon.AnnotationStartupProtection: "key1,key2",
+				"key1":                                 "value1",
+				"key2":                                 "value2",
+			},
+		},
+		{
+			"HeldExistingNotApplied",
+			protection,
+			false,
+			map[string]string{kubecommon.AnnotationStatus: heldStatus(true), "key1": "existing"},
+			true,
+			map[string]string{
+				kubecommon.AnnotationStatus:            heldStatus(true),
+				kubecommon.AnnotationStartupProtection: "key2",
+				"key1":                                 "existing",
+				"key2":                                 "value2",
+			},
+		},
+		{
+			"HeldAlreadyApplied",
+			protection,
+			false,
+			map[string]string{
+				kubecommon.AnnotationStatus:            heldStatus(true),
+				kubecommon.AnnotationStartupProtection: "key1,key2",
+				"key1":                                 "value1",
+				"key2":                                 "value2",
+			},
+			false,
+			map[string]string{
+				kubecommon.AnnotationStatus:            heldStatus(true),
+				kubecommon.AnnotationStartupProtection: "key1,key2",
+				"key1":                                 "value1",
+				"key2":                                 "value2",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: tt.podAnnotations}}
+			gotShouldPatch, gotWaitFunc, err := startupProtectionPodMutationFunc(
+				contexttest.NewCtxBuilder(contexttest.NewCtxConfig()).DryRun(tt.dryRun).Build(),
+				tt.annotations,
+			)(pod)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantShouldPatch, gotShouldPatch)
+			assert.Nil(t, gotWaitFunc)
+			assert.Equal(t, tt.wantAnnotations, pod.Annotations)
+		})
+	}
+}
+
+func TestStartupProtectionRemovalPodMutationFunc(t *testing.T) {
+	t.Run("NotApplied", func(t *testing.T) {
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"key1": "value1"}}}
+		gotShouldPatch, gotWaitFunc, err := startupProtectionRemovalPodMutationFunc()(pod)
+		assert.NoError(t, err)
+		assert.False(t, gotShouldPatch)
+		assert.Nil(t, gotWaitFunc)
+		assert.Equal(t, map[string]string{"key1": "value1"}, pod.Annotations)
+	})
+
+	t.Run("Applied", func(t *testing.T) {
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			kubecommon.AnnotationStartupProtection: "key1",
+			"key1":                                 "value1",
+			"key2":                                 "value2",
+		}}}
+		gotShouldPatch, gotWaitFunc, err := startupProtectionRemovalPodMutationFunc()(pod)
+		assert.NoError(t, err)
+		assert.True(t, gotShouldPatch)
+		assert.Nil(t, gotWaitFunc)
+		assert.Equal(t, map[string]string{"key2": "value2"}, pod.Annotations)
+	})
+}
+
+func TestStartupProtectionRequired(t *testing.T) {
+	tests := []struct {
+		name          string
+		held          bool
+		lastCommanded string
+		lastEnacted   string
+		lastFailed    string
+		want          bool
+	}{
+		{"Held", true, "", "", "", true},
+		{"NotHeldScaleInProgress", false, "commanded", "", "", true},
+		{"NotHeldScaleEnacted", false, "commanded", "enacted", "", false},
+		{"NotHeldScaleFailed", false, "commanded", "", "failed", false},
+		{"NotHeldNotCommanded", false, "", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				kubecommon.AnnotationStatus: podcommon.NewStatusAnnotation(
+					"",
+					podcommon.NewStatusAnnotationScale(nil, tt.lastCommanded, tt.lastEnacted, tt.lastFailed, "", "", tt.held, "", false),
+					"",
+				).Json(),
+			}}}
+			assert.Equal(t, tt.want, startupProtectionRequired(pod))
+		})
+	}
+
+	t.Run("NoStatus", func(t *testing.T) {
+		assert.False(t, startupProtectionRequired(&v1.Pod{}))
+	})
+}
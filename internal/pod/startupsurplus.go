@@ -0,0 +1,234 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/scale/scalecommon"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// startupSurplus is the default implementation of podcommon.StartupSurplus. It maintains in-memory reservations of the
+// startup surplus of pods for which startup resources are being commanded, keyed by node, so that pods on the same node
+// that are reconciled concurrently can't together exceed the node startup surplus budget. Reservations are per
+// controller replica, which is why the budget can't be combined with sharding.
+type startupSurplus struct {
+	configuration   podcommon.Configuration
+	containerHelper kubecommon.ContainerHelper
+	nodeHelper      kubecommon.NodeHelper
+
+	mutex        sync.Mutex
+	reservations map[string]map[types.UID]v1.ResourceList
+}
+
+func newStartupSurplus(
+	configuration podcommon.Configuration,
+	containerHelper kubecommon.ContainerHelper,
+	nodeHelper kubecommon.NodeHelper,
+) *startupSurplus {
+	return &startupSurplus{
+		configuration:   configuration,
+		containerHelper: containerHelper,
+		nodeHelper:      nodeHelper,
+		reservations:    map[string]map[types.UID]v1.ResourceList{},
+	}
+}
+
+// Reserve reserves the supplied required startup surplus for the supplied pod on its node, unless doing so would exceed
+// the supplied budget given the startup surplus already used by other pods on the node. Returns whether reserved, along
+// with the startup surplus used by other pods. Used surplus comprises that reserved by other pods, and that currently
+// commanded for other non-terminated pods without a reservation. Reservations are held until released, or until their
+// pod is no longer on the node.
+func (s *startupSurplus) Reserve(
+	ctx context.Context,
+	pod *v1.Pod,
+	required v1.ResourceList,
+	budget v1.ResourceList,
+) (bool, v1.ResourceList, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	nodeName := pod.Spec.NodeName
+	reservations := s.reservations[nodeName]
+	onNode := map[types.UID]bool{}
+	used, err := s.commandedOnNode(ctx, pod, func(nodePod *v1.Pod) bool {
+		onNode[nodePod.UID] = true
+		_, reserved := reservations[nodePod.UID]
+		return !reserved
+	})
+	if err != nil {
+		return false, nil, err
+	}
+
+	for uid, reserved := range reservations {
+		if uid == pod.UID {
+			continue
+		}
+
+		if !onNode[uid] {
+			delete(reservations, uid)
+			continue
+		}
+
+		addResources(used, reserved)
+	}
+
+	for resourceName, limit := range budget {
+		requiredQuantity, hasRequired := required[resourceName]
+		if !hasRequired {
+			continue
+		}
+
+		total := used[resourceName]
+		total.Add(requiredQuantity)
+		if total.Cmp(limit) > 0 {
+			return false, used, nil
+		}
+	}
+
+	if reservations == nil {
+		reservations = map[types.UID]v1.ResourceList{}
+		s.reservations[nodeName] = reservations
+	}
+	reservations[pod.UID] = required
+	return true, used, nil
+}
+
+// Release releases the startup surplus reserved for the supplied pod, if any.
+func (s *startupSurplus) Release(pod *v1.Pod) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	reservations := s.reservations[pod.Spec.NodeName]
+	delete(reservations, pod.UID)
+	if len(reservations) == 0 {
+		delete(s.reservations, pod.Spec.NodeName)
+	}
+}
+
+// commandedOnNode returns the total startup surplus currently commanded for the other non-terminated pods scheduled on
+// the supplied pod's node, for which include returns true. A pod's startup surplus is counted for each enabled resource
+// whose target container requests currently match its startup resources. Pods whose configuration is invalid are
+// ignored.
+func (s *startupSurplus) commandedOnNode(
+	ctx context.Context,
+	pod *v1.Pod,
+	include func(*v1.Pod) bool,
+) (v1.ResourceList, error) {
+	pods, err := s.nodeHelper.CachedPods(ctx, pod.Spec.NodeName)
+	if err != nil {
+		return nil, common.WrapErrorf(err, "unable to get pods on node")
+	}
+
+	ret := v1.ResourceList{}
+	for i := range pods {
+		nodePod := &pods[i]
+		if nodePod.UID == pod.UID || nodePod.Status.Phase == v1.PodSucceeded || nodePod.Status.Phase == v1.PodFailed {
+			continue
+		}
+
+		if !include(nodePod) {
+			continue
+		}
+
+		scaleConfigs, ctr, err := s.configure(nodePod)
+		if err != nil {
+			logging.Infof(
+				ctx, logging.VDebug,
+				"unable to determine startup surplus for pod '%s/%s' on node (will ignore): %s",
+				nodePod.Namespace, nodePod.Name, err,
+			)
+			continue
+		}
+
+		for _, config := range scaleConfigs.AllEnabledConfigurations() {
+			resourceName := config.ResourceName()
+			if !ctr.Resources.Requests[resourceName].Equal(config.Resources().Startup) {
+				continue
+			}
+
+			if surplus, hasSurplus := configSurplus(config); hasSurplus {
+				addResources(ret, v1.ResourceList{resourceName: surplus})
+			}
+		}
+	}
+
+	return ret, nil
+}
+
+// configure returns the validated scale configurations and target container of the supplied pod.
+func (s *startupSurplus) configure(pod *v1.Pod) (scalecommon.Configurations, *v1.Container, error) {
+	scaleConfigs, err := s.configuration.Configure(pod)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	targetContainerName, err := scaleConfigs.TargetContainerName(pod)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctr, err := s.containerHelper.Get(pod, targetContainerName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = scaleConfigs.ValidateAll(ctr); err != nil {
+		return nil, nil, err
+	}
+
+	return scaleConfigs, ctr, nil
+}
+
+// startupSurplusOf returns the startup surplus of each enabled resource within the supplied scale configurations.
+// Resources without a surplus aren't present.
+func startupSurplusOf(scaleConfigs scalecommon.Configurations) v1.ResourceList {
+	ret := v1.ResourceList{}
+
+	for _, config := range scaleConfigs.AllEnabledConfigurations() {
+		if surplus, hasSurplus := configSurplus(config); hasSurplus {
+			ret[config.ResourceName()] = surplus
+		}
+	}
+
+	return ret
+}
+
+// configSurplus returns the startup surplus of the supplied configuration and whether it's greater than zero.
+func configSurplus(config scalecommon.Configuration) (resource.Quantity, bool) {
+	resources := config.Resources()
+	surplus := resources.Startup.DeepCopy()
+	surplus.Sub(resources.PostStartupRequests)
+	return surplus, surplus.Sign() > 0
+}
+
+// addResources adds each quantity within toAdd to the corresponding quantity within total.
+func addResources(total v1.ResourceList, toAdd v1.ResourceList) {
+	for resourceName, quantity := range toAdd {
+		sum := total[resourceName]
+		sum.Add(quantity)
+		total[resourceName] = sum
+	}
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/context/contexttest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubetest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/scale/scalecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/scale/scaletest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestNewStartupSurplus(t *testing.T) {
+	podHelper := kube.NewPodHelper(nil, kube.NewDisabledCircuitBreaker())
+	containerHelper := kube.NewContainerHelper()
+	nodeHelper := kube.NewNodeHelper(nil, nil)
+	config := newConfiguration(podHelper, containerHelper)
+	surplus := newStartupSurplus(config, containerHelper, nodeHelper)
+	expected := &startupSurplus{
+		configuration:   config,
+		containerHelper: containerHelper,
+		nodeHelper:      nodeHelper,
+		reservations:    map[string]map[types.UID]v1.ResourceList{},
+	}
+	assert.Equal(t, expected, surplus)
+}
+
+func TestStartupSurplusReserve(t *testing.T) {
+	newNodePod := func(uid string, resourcesState podcommon.StateResources, phase v1.PodPhase) v1.Pod {
+		// Post-startup requests must equal limits to pass validation.
+		pod := kubetest.NewPodBuilder().
+			EnabledResourcesAll().
+			ResourcesState(resourcesState).
+			AdditionalAnnotations(map[string]string{
+				scalecommon.AnnotationCpuPostStartupLimits:    kubetest.PodAnnotationCpuPostStartupRequests,
+				scalecommon.AnnotationMemoryPostStartupLimits: kubetest.PodAnnotationMemoryPostStartupRequests,
+			}).
+			Build()
+		pod.UID = types.UID(uid)
+		pod.Spec.NodeName = "node"
+		pod.Status.Phase = phase
+		return *pod
+	}
+	newPod := func(uid string) *v1.Pod {
+		return &v1.Pod{ObjectMeta: metav1.ObjectMeta{UID: types.UID(uid)}, Spec: v1.PodSpec{NodeName: "node"}}
+	}
+	cpu := func(quantity string) v1.ResourceList {
+		return v1.ResourceList{v1.ResourceCPU: resource.MustParse(quantity)}
+	}
+	podHelper := kube.NewPodHelper(nil, kube.NewDisabledCircuitBreaker())
+	containerHelper := kube.NewContainerHelper()
+
+	t.Run("UnableToGetPodsOnNode", func(t *testing.T) {
+		s := newStartupSurplus(
+			newConfiguration(podHelper, containerHelper),
+			containerHelper,
+			kubetest.NewMockNodeHelper(func(m *kubetest.MockNodeHelper) {
+				m.On("CachedPods", mock.Anything, mock.Anything).Return([]v1.Pod(nil), errors.New(""))
+			}),
+		)
+
+		reserved, used, err := s.Reserve(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			newPod("self"),
+			cpu("2m"),
+			cpu("10m"),
+		)
+		assert.False(t, reserved)
+		assert.Nil(t, used)
+		assert.ErrorContains(t, err, "unable to get pods on node")
+		assert.Empty(t, s.reservations)
+	})
+
+	t.Run("Commanded", func(t *testing.T) {
+		invalid := newNodePod("invalid", podcommon.StateResourcesStartup, v1.PodRunning)
+		invalid.Annotations[scalecommon.AnnotationTargetContainerName] = "missing"
+
+		s := newStartupSurplus(
+			newConfiguration(podHelper, containerHelper),
+			containerHelper,
+			kubetest.NewMockNodeHelper(func(m *kubetest.MockNodeHelper) {
+				m.On("CachedPods", mock.Anything, "node").Return(
+					[]v1.Pod{
+						newNodePod("self", podcommon.StateResourcesStartup, v1.PodRunning),
+						newNodePod("startup1", podcommon.StateResourcesStartup, v1.PodRunning),
+						newNodePod("startup2", podcommon.StateResourcesStartup, v1.PodPending),
+						newNodePod("postStartup", podcommon.StateResourcesPostStartup, v1.PodRunning),
+						newNodePod("succeeded", podcommon.StateResourcesStartup, v1.PodSucceeded),
+						newNodePod("failed", podcommon.StateResourcesStartup, v1.PodFailed),
+						invalid,
+					},
+					nil,
+				)
+			}),
+		)
+
+		reserved, used, err := s.Reserve(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			newPod("self"),
+			cpu("2m"),
+			cpu("6m"),
+		)
+		assert.NoError(t, err)
+		assert.True(t, reserved)
+		assert.True(t, resource.MustParse("4m").Equal(used[v1.ResourceCPU]))
+		assert.True(t, resource.MustParse("4M").Equal(used[v1.ResourceMemory]))
+		assert.Equal(t, cpu("2m"), s.reservations["node"]["self"])
+	})
+
+	t.Run("Exceeded", func(t *testing.T) {
+		s := newStartupSurplus(
+			newConfiguration(podHelper, containerHelper),
+			containerHelper,
+			kubetest.NewMockNodeHelper(func(m *kubetest.MockNodeHelper) {
+				m.On("CachedPods", mock.Anything, "node").Return(
+					[]v1.Pod{newNodePod("startup", podcommon.StateResourcesStartup, v1.PodRunning)},
+					nil,
+				)
+			}),
+		)
+
+		reserved, used, err := s.Reserve(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			newPod("self"),
+			cpu("2m"),
+			cpu("3m"),
+		)
+		assert.NoError(t, err)
+		assert.False(t, reserved)
+		assert.True(t, resource.MustParse("2m").Equal(used[v1.ResourceCPU]))
+		assert.Empty(t, s.reservations)
+	})
+
+	t.Run("ReservedNotCountedTwice", func(t *testing.T) {
+		s := newStartupSurplus(
+			newConfiguration(podHelper, containerHelper),
+			containerHelper,
+			kubetest.NewMockNodeHelper(func(m *kubetest.MockNodeHelper) {
+				m.On("CachedPods", mock.Anything, "node").Return(
+					[]v1.Pod{newNodePod("startup", podcommon.StateResourcesStartup, v1.PodRunning)},
+					nil,
+				)
+			}),
+		)
+		s.reservations["node"] = map[types.UID]v1.ResourceList{"startup": cpu("2m")}
+
+		reserved, used, err := s.Reserve(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			newPod("self"),
+			cpu("2m"),
+			cpu("4m"),
+		)
+		assert.NoError(t, err)
+		assert.True(t, reserved)
+		assert.True(t, resource.MustParse("2m").Equal(used[v1.ResourceCPU]))
+	})
+
+	t.Run("ReservationsOfPodsNoLongerOnNodeRemoved", func(t *testing.T) {
+		s := newStartupSurplus(
+			newConfiguration(podHelper, containerHelper),
+			containerHelper,
+			kubetest.NewMockNodeHelper(func(m *kubetest.MockNodeHelper) {
+				m.On("CachedPods", mock.Anything, "node").Return(
+					[]v1.Pod{newNodePod("postStartup", podcommon.StateResourcesPostStartup, v1.PodRunning)},
+					nil,
+				)
+			}),
+		)
+		s.reservations["node"] = map[types.UID]v1.ResourceList{"postStartup": cpu("2m"), "deleted": cpu("2m")}
+
+		reserved, used, err := s.Reserve(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			newPod("self"),
+			cpu("2m"),
+			cpu("4m"),
+		)
+		assert.NoError(t, err)
+		assert.True(t, reserved)
+		assert.True(t, resource.MustParse("2m").Equal(used[v1.ResourceCPU]))
+		assert.NotContains(t, s.reservations["node"], types.UID("deleted"))
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		var nodePods []v1.Pod
+		for i := 0; i < 10; i++ {
+			nodePods = append(nodePods, newNodePod(strconv.Itoa(i), podcommon.StateResourcesPostStartup, v1.PodRunning))
+		}
+		s := newStartupSurplus(
+			newConfiguration(podHelper, containerHelper),
+			containerHelper,
+			kubetest.NewMockNodeHelper(func(m *kubetest.MockNodeHelper) {
+				m.On("CachedPods", mock.Anything, "node").Return(nodePods, nil)
+			}),
+		)
+
+		ctx := contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build()
+		var reservedCount atomic.Int32
+		var wg sync.WaitGroup
+		for i := range nodePods {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				reserved, _, err := s.Reserve(
+					ctx,
+					newPod(strconv.Itoa(i)),
+					cpu("2m"),
+					cpu("5m"),
+				)
+				assert.NoError(t, err)
+				if reserved {
+					reservedCount.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(2), reservedCount.Load())
+		assert.Len(t, s.reservations["node"], 2)
+	})
+}
+
+func TestStartupSurplusRelease(t *testing.T) {
+	s := newStartupSurplus(nil, nil, nil)
+	s.reservations["node"] = map[types.UID]v1.ResourceList{"pod1": {}, "pod2": {}}
+	pod := func(uid string) *v1.Pod {
+		return &v1.Pod{ObjectMeta: metav1.ObjectMeta{UID: types.UID(uid)}, Spec: v1.PodSpec{NodeName: "node"}}
+	}
+
+	s.Release(pod("pod1"))
+	assert.Equal(t, map[types.UID]v1.ResourceList{"pod2": {}}, s.reservations["node"])
+
+	s.Release(pod("pod2"))
+	assert.Empty(t, s.reservations)
+
+	s.Release(pod("notReserved"))
+	assert.Empty(t, s.reservations)
+}
+
+func TestStartupSurplusOf(t *testing.T) {
+	t.Run("Surplus", func(t *testing.T) {
+		got := startupSurplusOf(scaletest.NewMockConfigurations(nil))
+		assert.Len(t, got, 1)
+		assert.True(t, resource.MustParse("2m").Equal(got[v1.ResourceCPU]))
+	})
+
+	t.Run("NoSurplus", func(t *testing.T) {
+		got := startupSurplusOf(scaletest.NewMockConfigurations(func(m *scaletest.MockConfigurations) {
+			m.On("AllEnabledConfigurations").Return([]scalecommon.Configuration{})
+		}))
+		assert.Empty(t, got)
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	ccontext "github.com/ExpediaGroup/container-startup-autoscaler/internal/context"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/event/eventcommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
@@ -42,23 +43,27 @@ const (
 
 // status is the default implementation of podcommon.Status.
 type status struct {
-	recorder  record.EventRecorder
-	podHelper kubecommon.PodHelper
+	recorder                     record.EventRecorder
+	podHelper                    kubecommon.PodHelper
+	startupProtectionAnnotations map[string]string
 }
 
 func newStatus(
 	recorder record.EventRecorder,
 	podHelper kubecommon.PodHelper,
+	startupProtectionAnnotations map[string]string,
 ) *status {
 	return &status{
-		recorder:  recorder,
-		podHelper: podHelper,
+		recorder:                     recorder,
+		podHelper:                    podHelper,
+		startupProtectionAnnotations: startupProtectionAnnotations,
 	}
 }
 
 // Update updates controller status by applying mutations to the supplied pod. The supplied pod is never mutated.
-// Under specific circumstances, a pause is observed after the patch is applied to allow Kubelet time to react. Returns
-// the new server representation of the pod.
+// Any startup eviction protection annotations are applied or removed within the same patch, according to the updated
+// status. Under specific circumstances, a pause is observed after the patch is applied to allow Kubelet time to react.
+// Returns the new server representation of the pod.
 func (s *status) Update(
 	ctx context.Context,
 	podEventPublisher eventcommon.PodEventPublisher,
@@ -70,7 +75,9 @@ func (s *status) Update(
 	failReason string,
 ) (*v1.Pod, error) {
 	if (statusScaleState == podcommon.StatusScaleStateUpFailed ||
-		statusScaleState == podcommon.StatusScaleStateDownFailed) &&
+		statusScaleState == podcommon.StatusScaleStateDownFailed ||
+		statusScaleState == podcommon.StatusScaleStateUpQuotaExceeded ||
+		statusScaleState == podcommon.StatusScaleStateUpEvicted) &&
 		strings.TrimSpace(failReason) == "" {
 
 		panic(errors.New("failReason not provided for failed scale state"))
@@ -89,7 +96,10 @@ func (s *status) Update(
 		ctx,
 		podEventPublisher,
 		pod,
-		[]func(*v1.Pod) (bool, func(*v1.Pod) bool, error){mutatePodFunc},
+		[]func(*v1.Pod) (bool, func(*v1.Pod) bool, error){
+			mutatePodFunc,
+			startupProtectionPodMutationFunc(ctx, s.startupProtectionAnnotations),
+		},
 		false,
 	)
 	if err != nil {
@@ -119,6 +129,39 @@ func (s *status) podMutationFunc(
 			statScale.LastFailed = lastFailed
 		}
 
+		// The last applied configuration is only recorded when resources are enacted, otherwise the current value is
+		// preserved. This allows later detection of configuration changes, including those commanded but never enacted.
+		statScale.LastAppliedConfiguration = currentStat.Scale.LastAppliedConfiguration
+
+		// The admitted resources are recorded upon first determining the target container's resources, before any scale
+		// is commanded. These may be later applied when CSA is disabled for the pod.
+		statScale.AdmittedResources = currentStat.Scale.AdmittedResources
+		if statScale.AdmittedResources == "" &&
+			(states.Resources == podcommon.StateResourcesStartup || states.Resources == podcommon.StateResourcesPostStartup) {
+			statScale.AdmittedResources = states.Resources
+		}
+
+		// Whether the target container holds startup resources is tracked so that concurrent startups per workload can be
+		// limited. It's set upon commanding or enacting scales, otherwise the current value is preserved.
+		statScale.StartupResourcesHeld = currentStat.Scale.StartupResourcesHeld
+		switch {
+		case scaleState.IsCommanded():
+			statScale.StartupResourcesHeld = s.commandedDirection(scaleState, states) == metricscommon.DirectionUp
+		case scaleState == podcommon.StatusScaleStateUpEnacted:
+			statScale.StartupResourcesHeld = true
+		case scaleState == podcommon.StatusScaleStateDownEnacted:
+			statScale.StartupResourcesHeld = false
+		}
+
+		// Reflect any override so that it's visible within status. Invalid overrides are reported upon during validation.
+		statScale.Override, _ = overrideFor(podToMutate)
+
+		// Mark status as dry-run so that it's clear resources weren't actually commanded.
+		statScale.DryRun = ccontext.DryRun(ctx)
+		if statScale.DryRun && scaleState.IsCommanded() {
+			metricsscale.DryRunCommanded(s.commandedDirection(scaleState, states)).Inc()
+		}
+
 		switch scaleState {
 		case podcommon.StatusScaleStateNotApplicable:
 			if gotStatAnn { // Preserve current status.
@@ -142,7 +185,17 @@ func (s *status) podMutationFunc(
 			metricsscale.CommandedUnknownRes().Inc()
 			s.normalEvent(podToMutate, eventReasonScaling, status)
 
+		case podcommon.StatusScaleStateReconfiguredCommanded:
+			setTimestamps(s.formattedNow(timeFormatMilli), "", "")
+			if currentStat.Scale.LastFailed != "" {
+				shouldWaitNoConditions = true
+			}
+
+			metricsscale.CommandedReconfigured().Inc()
+			s.normalEvent(podToMutate, eventReasonReconfigured, status)
+
 		case podcommon.StatusScaleStateDownEnacted, podcommon.StatusScaleStateUpEnacted:
+			statScale.LastAppliedConfiguration = scaleConfigs.String()
 			if currentStat.Scale.LastCommanded == "" {
 				// Detected enacted but wasn't previously commanded. This happens if container resources are already
 				// correctly applied for the desired state e.g. admitting a pod with startup resources already applied.
@@ -167,6 +220,24 @@ func (s *status) podMutationFunc(
 				s.warningEvent(podToMutate, eventReasonScaling, status)
 			}
 
+		case podcommon.StatusScaleStateUpQuotaExceeded:
+			// Resources weren't accepted by the Kube API so aren't commanded - preserve current timestamps.
+			if gotStatAnn {
+				setTimestamps(currentStat.Scale.LastCommanded, currentStat.Scale.LastEnacted, currentStat.Scale.LastFailed)
+			}
+
+			metricsscale.Failure(scaleState.Direction(), failReason).Inc()
+			s.warningEvent(podToMutate, eventReasonQuotaExceeded, status)
+
+		case podcommon.StatusScaleStateUpEvicted:
+			// The pod is being evicted so startup resources will never be enacted - preserve current timestamps.
+			if gotStatAnn {
+				setTimestamps(currentStat.Scale.LastCommanded, currentStat.Scale.LastEnacted, currentStat.Scale.LastFailed)
+			}
+
+			metricsscale.Evicted(failReason).Inc()
+			s.warningEvent(podToMutate, eventReasonEvicted, status)
+
 		default:
 			panic(fmt.Errorf("scaleState '%s' not supported", scaleState))
 		}
@@ -183,6 +254,22 @@ func (s *status) podMutationFunc(
 	}
 }
 
+// commandedDirection returns the direction of the supplied commanded scale state. Scales commanded upon encountering
+// unknown resources or changed configuration don't indicate a direction, so it's determined from whether the target
+// container is started.
+func (s *status) commandedDirection(scaleState podcommon.StatusScaleState, states podcommon.States) metricscommon.Direction {
+	switch scaleState {
+	case podcommon.StatusScaleStateUnknownCommanded, podcommon.StatusScaleStateReconfiguredCommanded:
+		if states.Started.Bool() {
+			return metricscommon.DirectionDown
+		}
+
+		return metricscommon.DirectionUp
+	}
+
+	return scaleState.Direction()
+}
+
 // currentOrEmptyStatus returns either the current unmarshalled status or an empty status depending on whether the
 // status annotation is present and whether it can be unmarshalled. It also returns a boolean indicating whether the
 // status annotation was present in the first place.
@@ -43,17 +43,19 @@ const timeoutOverride = 10 * time.Millisecond
 
 func TestNewStatus(t *testing.T) {
 	recorder := &record.FakeRecorder{}
-	podHelper := kube.NewPodHelper(nil)
+	podHelper := kube.NewPodHelper(nil, kube.NewDisabledCircuitBreaker())
+	annotations := map[string]string{"key": "value"}
 	expected := &status{
-		recorder:  recorder,
-		podHelper: podHelper,
+		recorder:                     recorder,
+		podHelper:                    podHelper,
+		startupProtectionAnnotations: annotations,
 	}
-	assert.Equal(t, expected, newStatus(recorder, podHelper))
+	assert.Equal(t, expected, newStatus(recorder, podHelper, annotations))
 }
 
 func TestStatusUpdateCore(t *testing.T) {
 	t.Run("NoFailReasonPanics", func(t *testing.T) {
-		s := newStatus(nil, nil)
+		s := newStatus(nil, nil, nil)
 
 		fun := func() {
 			_, _ = s.Update(
@@ -78,7 +80,9 @@ func TestStatusUpdateCore(t *testing.T) {
 					func() *kubefake.Clientset { return kubefake.NewClientset() },
 					func() interceptor.Funcs { return interceptor.Funcs{Patch: kubetest.InterceptorFuncPatchFail()} },
 				),
+				kube.NewDisabledCircuitBreaker(),
 			),
+			nil,
 		)
 
 		got, err := s.Update(
@@ -104,7 +108,9 @@ func TestStatusUpdateCore(t *testing.T) {
 					func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
 					func() interceptor.Funcs { return interceptor.Funcs{} },
 				),
+				kube.NewDisabledCircuitBreaker(),
 			),
+			nil,
 		)
 
 		got, err := s.Update(
@@ -139,7 +145,9 @@ func TestStatusUpdateCore(t *testing.T) {
 					func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
 					func() interceptor.Funcs { return interceptor.Funcs{} },
 				),
+				kube.NewDisabledCircuitBreaker(),
 			),
+			nil,
 		)
 
 		got, err := s.Update(
@@ -175,7 +183,9 @@ func TestStatusUpdateCore(t *testing.T) {
 					},
 					func() interceptor.Funcs { return interceptor.Funcs{} },
 				),
+				kube.NewDisabledCircuitBreaker(),
 			),
+			nil,
 		)
 
 		previousStat := podcommon.NewStatusAnnotation(
@@ -299,6 +309,23 @@ func TestStatusUpdateScaleStatus(t *testing.T) {
 				assert.Equal(t, float64(1), metricVal)
 			},
 		},
+		{
+			"StatusScaleStateReconfiguredCommanded",
+			args{
+				kubetest.NewPodBuilder().Build(),
+				podcommon.StatusScaleStateReconfiguredCommanded,
+				"",
+			},
+			"",
+			true,
+			false,
+			false,
+			"Normal Reconfigured Test",
+			func(t *testing.T) {
+				metricVal, _ := testutil.GetCounterMetricValue(scale.CommandedReconfigured())
+				assert.Equal(t, float64(1), metricVal)
+			},
+		},
 		{
 			"StatusScaleStateEnactedLastCommandedEmpty",
 			args{
@@ -398,6 +425,48 @@ func TestStatusUpdateScaleStatus(t *testing.T) {
 				assert.Equal(t, float64(0), failureMetricVal)
 			},
 		},
+		{
+			"StatusScaleStateUpQuotaExceeded",
+			args{
+				kubetest.NewPodBuilder().
+					AdditionalAnnotations(map[string]string{kubecommon.AnnotationStatus: statusAnnotationString(true, false, false)}).
+					Build(),
+				podcommon.StatusScaleStateUpQuotaExceeded,
+				"failReason",
+			},
+			"",
+			true,
+			false,
+			false,
+			"Warning QuotaExceeded Test",
+			func(t *testing.T) {
+				durationMetricVal, _ := testutil.GetHistogramMetricCount(scale.Duration(metricscommon.DirectionUp, metricscommon.OutcomeFailure))
+				assert.Equal(t, uint64(0), durationMetricVal)
+				failureMetricVal, _ := testutil.GetCounterMetricValue(scale.Failure(metricscommon.DirectionUp, "failReason"))
+				assert.Equal(t, float64(1), failureMetricVal)
+			},
+		},
+		{
+			"StatusScaleStateUpEvicted",
+			args{
+				kubetest.NewPodBuilder().
+					AdditionalAnnotations(map[string]string{kubecommon.AnnotationStatus: statusAnnotationString(true, false, false)}).
+					Build(),
+				podcommon.StatusScaleStateUpEvicted,
+				"failReason",
+			},
+			"",
+			true,
+			false,
+			false,
+			"Warning Evicted Test",
+			func(t *testing.T) {
+				durationMetricVal, _ := testutil.GetHistogramMetricCount(scale.Duration(metricscommon.DirectionUp, metricscommon.OutcomeFailure))
+				assert.Equal(t, uint64(0), durationMetricVal)
+				evictedMetricVal, _ := testutil.GetCounterMetricValue(scale.Evicted("failReason"))
+				assert.Equal(t, float64(1), evictedMetricVal)
+			},
+		},
 		{
 			"StatusScaleStateNotSupported",
 			args{
@@ -425,7 +494,9 @@ func TestStatusUpdateScaleStatus(t *testing.T) {
 						func() *kubefake.Clientset { return kubefake.NewClientset(kubetest.NewPodBuilder().Build()) },
 						func() interceptor.Funcs { return interceptor.Funcs{} },
 					),
+					kube.NewDisabledCircuitBreaker(),
 				),
+				nil,
 			)
 			ctx := contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).
 				TimeoutOverride(timeoutOverride).
@@ -497,6 +568,313 @@ func TestStatusUpdateScaleStatus(t *testing.T) {
 	}
 }
 
+func TestStatusUpdateLastAppliedConfiguration(t *testing.T) {
+	tests := []struct {
+		name       string
+		scaleState podcommon.StatusScaleState
+		failReason string
+		want       string
+	}{
+		{"NotApplicablePreserved", podcommon.StatusScaleStateNotApplicable, "", "previous"},
+		{"UpCommandedPreserved", podcommon.StatusScaleStateUpCommanded, "", "previous"},
+		{"UnknownCommandedPreserved", podcommon.StatusScaleStateUnknownCommanded, "", "previous"},
+		{"ReconfiguredCommandedPreserved", podcommon.StatusScaleStateReconfiguredCommanded, "", "previous"},
+		{"UpEnactedRecorded", podcommon.StatusScaleStateUpEnacted, "", "current"},
+		{"UpFailedPreserved", podcommon.StatusScaleStateUpFailed, "failReason", "previous"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newStatus(
+				record.NewFakeRecorder(1),
+				kube.NewPodHelper(
+					kubetest.ControllerRuntimeFakeClientWithKubeFake(
+						func() *kubefake.Clientset { return kubefake.NewClientset(kubetest.NewPodBuilder().Build()) },
+						func() interceptor.Funcs { return interceptor.Funcs{} },
+					),
+					kube.NewDisabledCircuitBreaker(),
+				),
+				nil,
+			)
+			previousStat := podcommon.NewStatusAnnotation(
+				"previous",
+				podcommon.NewStatusAnnotationScale([]v1.ResourceName{v1.ResourceCPU}, "", "", "", "previous", "", false, "", false),
+				"",
+			).Json()
+
+			got, err := s.Update(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).TimeoutOverride(timeoutOverride).Build(),
+				eventtest.NewMockPodEventPublisher(nil),
+				kubetest.NewPodBuilder().AdditionalAnnotations(map[string]string{kubecommon.AnnotationStatus: previousStat}).Build(),
+				"test",
+				podcommon.States{Resources: podcommon.StateResourcesStartup},
+				tt.scaleState,
+				scaletest.NewMockConfigurations(func(m *scaletest.MockConfigurations) {
+					m.AllEnabledConfigsResourceNamesDefault()
+					m.On("String").Return("current")
+				}),
+				tt.failReason,
+			)
+			assert.NoError(t, err)
+
+			stat := &podcommon.StatusAnnotation{}
+			_ = json.Unmarshal([]byte(got.Annotations[kubecommon.AnnotationStatus]), stat)
+			assert.Equal(t, tt.want, stat.Scale.LastAppliedConfiguration)
+		})
+	}
+}
+
+func TestStatusUpdateStartupResourcesHeld(t *testing.T) {
+	tests := []struct {
+		name       string
+		previous   bool
+		scaleState podcommon.StatusScaleState
+		started    podcommon.StateBool
+		failReason string
+		want       bool
+	}{
+		{"NotApplicablePreserved", true, podcommon.StatusScaleStateNotApplicable, podcommon.StateBoolFalse, "", true},
+		{"UpCommandedHeld", false, podcommon.StatusScaleStateUpCommanded, podcommon.StateBoolFalse, "", true},
+		{"DownCommandedReleased", true, podcommon.StatusScaleStateDownCommanded, podcommon.StateBoolTrue, "", false},
+		{"UnknownCommandedNotStartedHeld", false, podcommon.StatusScaleStateUnknownCommanded, podcommon.StateBoolFalse, "", true},
+		{"UnknownCommandedStartedReleased", true, podcommon.StatusScaleStateUnknownCommanded, podcommon.StateBoolTrue, "", false},
+		{"ReconfiguredCommandedNotStartedHeld", false, podcommon.StatusScaleStateReconfiguredCommanded, podcommon.StateBoolFalse, "", true},
+		{"UpEnactedHeld", false, podcommon.StatusScaleStateUpEnacted, podcommon.StateBoolFalse, "", true},
+		{"DownEnactedReleased", true, podcommon.StatusScaleStateDownEnacted, podcommon.StateBoolTrue, "", false},
+		{"UpFailedPreserved", true, podcommon.StatusScaleStateUpFailed, podcommon.StateBoolFalse, "failReason", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newStatus(
+				record.NewFakeRecorder(1),
+				kube.NewPodHelper(
+					kubetest.ControllerRuntimeFakeClientWithKubeFake(
+						func() *kubefake.Clientset { return kubefake.NewClientset(kubetest.NewPodBuilder().Build()) },
+						func() interceptor.Funcs { return interceptor.Funcs{} },
+					),
+					kube.NewDisabledCircuitBreaker(),
+				),
+				nil,
+			)
+			previousStat := podcommon.NewStatusAnnotation(
+				"previous",
+				podcommon.NewStatusAnnotationScale([]v1.ResourceName{v1.ResourceCPU}, "", "", "", "", "", tt.previous, "", false),
+				"",
+			).Json()
+
+			got, err := s.Update(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).TimeoutOverride(timeoutOverride).Build(),
+				eventtest.NewMockPodEventPublisher(nil),
+				kubetest.NewPodBuilder().AdditionalAnnotations(map[string]string{kubecommon.AnnotationStatus: previousStat}).Build(),
+				"test",
+				podcommon.States{Started: tt.started, Resources: podcommon.StateResourcesStartup},
+				tt.scaleState,
+				scaletest.NewMockConfigurations(func(m *scaletest.MockConfigurations) {
+					m.AllEnabledConfigsResourceNamesDefault()
+					m.On("String").Return("current")
+				}),
+				tt.failReason,
+			)
+			assert.NoError(t, err)
+
+			stat := &podcommon.StatusAnnotation{}
+			_ = json.Unmarshal([]byte(got.Annotations[kubecommon.AnnotationStatus]), stat)
+			assert.Equal(t, tt.want, stat.Scale.StartupResourcesHeld)
+		})
+	}
+}
+
+func TestStatusUpdateStartupEvictionProtection(t *testing.T) {
+	tests := []struct {
+		name           string
+		previous       bool
+		previousAnns   map[string]string
+		scaleState     podcommon.StatusScaleState
+		wantAnns       map[string]string
+		wantAbsentAnns []string
+	}{
+		{
+			"Applied",
+			false,
+			nil,
+			podcommon.StatusScaleStateUpCommanded,
+			map[string]string{"key": "value", kubecommon.AnnotationStartupProtection: "key"},
+			nil,
+		},
+		{
+			"Removed",
+			true,
+			map[string]string{"key": "value", kubecommon.AnnotationStartupProtection: "key"},
+			podcommon.StatusScaleStateDownEnacted,
+			nil,
+			[]string{"key", kubecommon.AnnotationStartupProtection},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newStatus(
+				record.NewFakeRecorder(1),
+				kube.NewPodHelper(
+					kubetest.ControllerRuntimeFakeClientWithKubeFake(
+						func() *kubefake.Clientset { return kubefake.NewClientset(kubetest.NewPodBuilder().Build()) },
+						func() interceptor.Funcs { return interceptor.Funcs{} },
+					),
+					kube.NewDisabledCircuitBreaker(),
+				),
+				map[string]string{"key": "value"},
+			)
+			previousStat := podcommon.NewStatusAnnotation(
+				"previous",
+				podcommon.NewStatusAnnotationScale([]v1.ResourceName{v1.ResourceCPU}, "1", "", "", "", "", tt.previous, "", false),
+				"",
+			).Json()
+			previousAnns := map[string]string{kubecommon.AnnotationStatus: previousStat}
+			for key, value := range tt.previousAnns {
+				previousAnns[key] = value
+			}
+
+			got, err := s.Update(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).TimeoutOverride(timeoutOverride).Build(),
+				eventtest.NewMockPodEventPublisher(nil),
+				kubetest.NewPodBuilder().AdditionalAnnotations(previousAnns).Build(),
+				"test",
+				podcommon.States{Resources: podcommon.StateResourcesStartup},
+				tt.scaleState,
+				scaletest.NewMockConfigurations(func(m *scaletest.MockConfigurations) {
+					m.AllEnabledConfigsResourceNamesDefault()
+					m.On("String").Return("current")
+				}),
+				"",
+			)
+			assert.NoError(t, err)
+			for key, value := range tt.wantAnns {
+				assert.Equal(t, value, got.Annotations[key])
+			}
+			for _, key := range tt.wantAbsentAnns {
+				assert.NotContains(t, got.Annotations, key)
+			}
+		})
+	}
+}
+
+func TestStatusUpdateOverride(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        podcommon.Override
+	}{
+		{"None", nil, podcommon.OverrideNone},
+		{"Paused", map[string]string{kubecommon.AnnotationPaused: "true"}, podcommon.OverridePaused},
+		{"ForceStartup", map[string]string{kubecommon.AnnotationForceState: "startup"}, podcommon.OverrideForceStartup},
+		{"Invalid", map[string]string{kubecommon.AnnotationForceState: "test"}, podcommon.OverrideNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := kubetest.NewPodBuilder().AdditionalAnnotations(tt.annotations).Build()
+			s := newStatus(
+				record.NewFakeRecorder(1),
+				kube.NewPodHelper(
+					kubetest.ControllerRuntimeFakeClientWithKubeFake(
+						func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
+						func() interceptor.Funcs { return interceptor.Funcs{} },
+					),
+					kube.NewDisabledCircuitBreaker(),
+				),
+				nil,
+			)
+
+			got, err := s.Update(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).TimeoutOverride(timeoutOverride).Build(),
+				eventtest.NewMockPodEventPublisher(nil),
+				pod,
+				"test",
+				podcommon.States{},
+				podcommon.StatusScaleStateNotApplicable,
+				scaletest.NewMockConfigurations(nil),
+				"",
+			)
+			assert.NoError(t, err)
+
+			stat := &podcommon.StatusAnnotation{}
+			_ = json.Unmarshal([]byte(got.Annotations[kubecommon.AnnotationStatus]), stat)
+			assert.Equal(t, tt.want, stat.Scale.Override)
+		})
+	}
+}
+
+func TestStatusUpdateDryRun(t *testing.T) {
+	tests := []struct {
+		name          string
+		dryRun        bool
+		scaleState    podcommon.StatusScaleState
+		states        podcommon.States
+		wantDirection metricscommon.Direction
+	}{
+		{"NotDryRun", false, podcommon.StatusScaleStateUpCommanded, podcommon.States{}, ""},
+		{"DryRunNotCommanded", true, podcommon.StatusScaleStateNotApplicable, podcommon.States{}, ""},
+		{"DryRunUpCommanded", true, podcommon.StatusScaleStateUpCommanded, podcommon.States{}, metricscommon.DirectionUp},
+		{"DryRunDownCommanded", true, podcommon.StatusScaleStateDownCommanded, podcommon.States{}, metricscommon.DirectionDown},
+		{
+			"DryRunUnknownCommandedNotStarted",
+			true,
+			podcommon.StatusScaleStateUnknownCommanded,
+			podcommon.States{Started: podcommon.StateBoolFalse},
+			metricscommon.DirectionUp,
+		},
+		{
+			"DryRunReconfiguredCommandedStarted",
+			true,
+			podcommon.StatusScaleStateReconfiguredCommanded,
+			podcommon.States{Started: podcommon.StateBoolTrue},
+			metricscommon.DirectionDown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scale.ResetMetrics()
+			pod := kubetest.NewPodBuilder().Build()
+			s := newStatus(
+				record.NewFakeRecorder(1),
+				kube.NewPodHelper(
+					kubetest.ControllerRuntimeFakeClientWithKubeFake(
+						func() *kubefake.Clientset { return kubefake.NewClientset(pod) },
+						func() interceptor.Funcs { return interceptor.Funcs{} },
+					),
+					kube.NewDisabledCircuitBreaker(),
+				),
+				nil,
+			)
+
+			got, err := s.Update(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).
+					TimeoutOverride(timeoutOverride).
+					DryRun(tt.dryRun).
+					Build(),
+				eventtest.NewMockPodEventPublisher(nil),
+				pod,
+				"test",
+				tt.states,
+				tt.scaleState,
+				scaletest.NewMockConfigurations(nil),
+				"",
+			)
+			assert.NoError(t, err)
+
+			stat := &podcommon.StatusAnnotation{}
+			_ = json.Unmarshal([]byte(got.Annotations[kubecommon.AnnotationStatus]), stat)
+			assert.Equal(t, tt.dryRun, stat.Scale.DryRun)
+
+			for _, direction := range []metricscommon.Direction{metricscommon.DirectionUp, metricscommon.DirectionDown} {
+				want := float64(0)
+				if direction == tt.wantDirection {
+					want = 1
+				}
+				value, _ := testutil.GetCounterMetricValue(scale.DryRunCommanded(direction))
+				assert.Equal(t, want, value)
+			}
+		})
+	}
+}
+
 func TestStatusUpdateDurationMetric(t *testing.T) {
 	type args struct {
 		commanded string
@@ -559,7 +937,7 @@ func TestStatusUpdateDurationMetric(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := newStatus(&record.FakeRecorder{}, nil)
+			s := newStatus(&record.FakeRecorder{}, nil, nil)
 			buffer := &bytes.Buffer{}
 			ctx := contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(buffer)).Build()
 			s.updateDurationMetric(
@@ -581,7 +959,7 @@ func fullStatusAnnotationString() string {
 }
 
 func statusAnnotationString(lastCommanded bool, lastEnacted bool, lastFailed bool) string {
-	now := newStatus(&record.FakeRecorder{}, nil).formattedNow(timeFormatMilli)
+	now := newStatus(&record.FakeRecorder{}, nil, nil).formattedNow(timeFormatMilli)
 
 	lastCommandedString, lastEnactedString, lastFailedString := "", "", ""
 
@@ -599,7 +977,17 @@ func statusAnnotationString(lastCommanded bool, lastEnacted bool, lastFailed boo
 
 	return podcommon.NewStatusAnnotation(
 		"test",
-		podcommon.NewStatusAnnotationScale([]v1.ResourceName{v1.ResourceCPU}, lastCommandedString, lastEnactedString, lastFailedString),
+		podcommon.NewStatusAnnotationScale(
+			[]v1.ResourceName{v1.ResourceCPU},
+			lastCommandedString,
+			lastEnactedString,
+			lastFailedString,
+			"",
+			"",
+			false,
+			"",
+			false,
+		),
 		now,
 	).Json()
 }
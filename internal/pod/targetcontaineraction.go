@@ -20,25 +20,79 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	ccontext "github.com/ExpediaGroup/container-startup-autoscaler/internal/context"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/control/controlcommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/event/eventcommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	metricsscale "github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/scale"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/scale"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/scale/scalecommon"
 	"k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
-const eventReasonScaling = "Scaling"
+const (
+	eventReasonScaling       = "Scaling"
+	eventReasonReconfigured  = "Reconfigured"
+	eventReasonQuotaExceeded = "QuotaExceeded"
+	eventReasonEvicted       = "Evicted"
+)
+
+// failReasonResourceQuota is the scale failure metric reason used when startup resources are rejected by the Kube API as
+// a resource quota would be exceeded.
+const failReasonResourceQuota = "resource_quota"
+
+const (
+	// evictionReasonInfeasible is the evicted metric reason used when a pod is evicted as startup resources are
+	// infeasible.
+	evictionReasonInfeasible = "infeasible"
+
+	// evictionReasonDeferred is the evicted metric reason used when a pod is evicted as startup resources have been
+	// deferred for longer than the eviction fallback deferred timeout.
+	evictionReasonDeferred = "deferred"
+)
+
+const (
+	// suppressedReasonInsufficientNodeCapacity is the scale suppressed metric reason used when startup resources aren't
+	// commanded due to insufficient node capacity.
+	suppressedReasonInsufficientNodeCapacity = "insufficient_node_capacity"
+
+	// suppressedReasonStartupSurplusBudget is the scale suppressed metric reason used when startup resources aren't
+	// commanded as the node startup surplus budget would be exceeded.
+	suppressedReasonStartupSurplusBudget = "startup_surplus_budget"
+
+	// suppressedReasonInsufficientResourceQuota is the scale suppressed metric reason used when startup resources aren't
+	// commanded as a resource quota within the pod's namespace would be exceeded.
+	suppressedReasonInsufficientResourceQuota = "insufficient_resource_quota"
+
+	// suppressedReasonNodeUpscaleOrdering is the scale suppressed metric reason used when startup resources aren't
+	// commanded as other pods waiting for startup resources on the same node rank ahead.
+	suppressedReasonNodeUpscaleOrdering = "node_upscale_ordering"
+
+	// suppressedReasonWorkloadStartupLimit is the scale suppressed metric reason used when startup resources aren't
+	// commanded as the maximum number of pods of the same workload concurrently holding them has been reached.
+	suppressedReasonWorkloadStartupLimit = "workload_startup_limit"
+)
 
 // targetContainerAction is the default implementation of podcommon.TargetContainerAction.
 type targetContainerAction struct {
 	controllerConfig  controllercommon.ControllerConfig
 	status            podcommon.Status
 	podHelper         kubecommon.PodHelper
+	nodeHelper        kubecommon.NodeHelper
+	namespaceHelper   kubecommon.NamespaceHelper
+	startupSurplus    podcommon.StartupSurplus
+	nodeUpscaleQueue  podcommon.NodeUpscaleQueue
+	workloadStartups  podcommon.WorkloadStartups
+	evictionFallback  podcommon.EvictionFallback
 	podEventPublisher eventcommon.PodEventPublisher
 }
 
@@ -46,12 +100,24 @@ func newTargetContainerAction(
 	controllerConfig controllercommon.ControllerConfig,
 	status podcommon.Status,
 	podHelper kubecommon.PodHelper,
+	nodeHelper kubecommon.NodeHelper,
+	namespaceHelper kubecommon.NamespaceHelper,
+	startupSurplus podcommon.StartupSurplus,
+	nodeUpscaleQueue podcommon.NodeUpscaleQueue,
+	workloadStartups podcommon.WorkloadStartups,
+	evictionFallback podcommon.EvictionFallback,
 	podEventPublisher eventcommon.PodEventPublisher,
 ) *targetContainerAction {
 	return &targetContainerAction{
 		controllerConfig:  controllerConfig,
 		status:            status,
 		podHelper:         podHelper,
+		nodeHelper:        nodeHelper,
+		namespaceHelper:   namespaceHelper,
+		startupSurplus:    startupSurplus,
+		nodeUpscaleQueue:  nodeUpscaleQueue,
+		workloadStartups:  workloadStartups,
+		evictionFallback:  evictionFallback,
 		podEventPublisher: podEventPublisher,
 	}
 }
@@ -72,6 +138,23 @@ func (a *targetContainerAction) Execute(
 		panic(fmt.Errorf("unsupported readiness probe state '%s'", states.ReadinessProbe))
 	}
 
+	// Overrides are honored before any other action is determined.
+	override, err := overrideFor(pod)
+	if err != nil {
+		return common.WrapErrorf(err, "unable to determine override")
+	}
+
+	switch override {
+	case podcommon.OverridePaused:
+		a.stopWaitingForNodeUpscale(pod)
+		return a.pausedAction(ctx, states, pod, scaleConfigs)
+	case podcommon.OverrideForceStartup:
+		return a.forcedAction(ctx, states, pod, targetContainer, scaleConfigs, podcommon.StateResourcesStartup)
+	case podcommon.OverrideForcePostStartup:
+		a.stopWaitingForNodeUpscale(pod)
+		return a.forcedAction(ctx, states, pod, targetContainer, scaleConfigs, podcommon.StateResourcesPostStartup)
+	}
+
 	if states.Container != podcommon.StateContainerRunning {
 		return a.containerNotRunningAction(ctx, states, pod, scaleConfigs)
 	}
@@ -84,7 +167,12 @@ func (a *targetContainerAction) Execute(
 		return a.readyUnknownAction(ctx)
 	}
 
-	if states.Resources == podcommon.StateResourcesUnknown && !a.controllerConfig.ScaleWhenUnknownResources {
+	// Unknown resources may result from configuration annotations being changed on a running pod, in which case the
+	// resources that fit the current started state are re-commanded.
+	isReconfigured := states.Resources == podcommon.StateResourcesUnknown && a.isReconfigured(ctx, pod, scaleConfigs)
+
+	if states.Resources == podcommon.StateResourcesUnknown && !isReconfigured &&
+		!a.controllerConfig.CurrentScaleWhenUnknownResources() {
 		return a.resUnknownAction(ctx, states, pod, targetContainer, scaleConfigs)
 	}
 
@@ -117,6 +205,11 @@ func (a *targetContainerAction) Execute(
 		panic(errors.New("neither startup probe or readiness probe present"))
 	}
 
+	// Started containers no longer require startup resources, so mustn't hold up other pods on the node.
+	if isStarted {
+		a.stopWaitingForNodeUpscale(pod)
+	}
+
 	switch states.Resources {
 	case podcommon.StateResourcesStartup:
 		if !isStarted {
@@ -131,6 +224,13 @@ func (a *targetContainerAction) Execute(
 		return a.startedWithPostStartupResAction(ctx, states, pod, targetContainer, scaleConfigs)
 
 	case podcommon.StateResourcesUnknown:
+		if isReconfigured {
+			if !isStarted {
+				return a.notStartedReconfiguredAction(ctx, states, pod, targetContainer, scaleConfigs)
+			}
+			return a.startedReconfiguredAction(ctx, states, pod, targetContainer, scaleConfigs)
+		}
+
 		if !isStarted {
 			return a.notStartedWithUnknownResAction(ctx, states, pod, targetContainer, scaleConfigs)
 		}
@@ -140,6 +240,81 @@ func (a *targetContainerAction) Execute(
 	panic(errors.New("no action to invoke"))
 }
 
+// pausedAction only logs and updates status since CSA actions are paused for the pod.
+func (a *targetContainerAction) pausedAction(
+	ctx context.Context,
+	states podcommon.States,
+	pod *v1.Pod,
+	scaleConfigs scalecommon.Configurations,
+) error {
+	a.updateStatusAndLogInfo(
+		ctx,
+		logging.VInfo,
+		pod,
+		"csa paused (no action taken)",
+		states,
+		podcommon.StatusScaleStateNotApplicable,
+		scaleConfigs,
+		"",
+	)
+	return nil
+}
+
+// forcedAction commands the supplied forced resources regardless of the target container's state, unless they're
+// already applied - in which case, conditions are examined to determine whether they've been enacted.
+func (a *targetContainerAction) forcedAction(
+	ctx context.Context,
+	states podcommon.States,
+	pod *v1.Pod,
+	targetContainer *v1.Container,
+	scaleConfigs scalecommon.Configurations,
+	forcedResources podcommon.StateResources,
+) error {
+	if states.Resources == forcedResources {
+		return a.processConfigEnacted(ctx, states, pod, targetContainer, scaleConfigs)
+	}
+
+	if forcedResources != podcommon.StateResourcesStartup && forcedResources != podcommon.StateResourcesPostStartup {
+		panic(fmt.Errorf("unsupported forced resources '%s'", forcedResources))
+	}
+
+	if a.isSuppressed(ctx, states, pod, scaleConfigs, forcedResources) {
+		return nil
+	}
+
+	var newPod *v1.Pod
+	var scaleState podcommon.StatusScaleState
+	var err error
+
+	if forcedResources == podcommon.StateResourcesStartup {
+		var commanded bool
+		newPod, commanded, err = a.commandStartupResources(ctx, states, pod, targetContainer, scaleConfigs)
+		if !commanded {
+			return err
+		}
+		scaleState = podcommon.StatusScaleStateUpCommanded
+	} else {
+		resizeFuncs := scale.NewUpdates(scaleConfigs).PostStartupPodMutationFuncAll(targetContainer)
+		newPod, err = a.podHelper.Patch(ctx, a.podEventPublisher, pod, resizeFuncs, true)
+		if err != nil {
+			return common.WrapErrorf(err, "unable to patch container resources")
+		}
+		scaleState = podcommon.StatusScaleStateDownCommanded
+	}
+
+	a.updateStatusAndLogInfo(
+		ctx,
+		logging.VInfo,
+		newPod,
+		fmt.Sprintf("%s resources commanded (forced)", forcedResources.HumanReadable()),
+		states,
+		scaleState,
+		scaleConfigs,
+		"",
+	)
+	return nil
+}
+
 // containerNotRunningAction only logs and updates status since the target container isn't currently running.
 func (a *targetContainerAction) containerNotRunningAction(
 	ctx context.Context,
@@ -186,7 +361,8 @@ func (a *targetContainerAction) resUnknownAction(
 }
 
 // notStartedWithStartupResAction examines conditions and provides relevant feedback since the container is not ready
-// with startup resources applied (although those resources might not yet be enacted).
+// with startup resources applied (although those resources might not yet be enacted). If those resources can't be
+// enacted, the pod may be evicted per eviction fallback.
 func (a *targetContainerAction) notStartedWithStartupResAction(
 	ctx context.Context,
 	states podcommon.States,
@@ -194,7 +370,13 @@ func (a *targetContainerAction) notStartedWithStartupResAction(
 	targetContainer *v1.Container,
 	scaleConfigs scalecommon.Configurations,
 ) error {
-	return a.processConfigEnacted(ctx, states, pod, targetContainer, scaleConfigs)
+	err := a.processConfigEnacted(ctx, states, pod, targetContainer, scaleConfigs)
+
+	if states.Resize.State == podcommon.StateResizeInfeasible || states.Resize.State == podcommon.StateResizeDeferred {
+		return a.evictionFallbackAction(ctx, states, pod, scaleConfigs, err)
+	}
+
+	return err
 }
 
 // notStartedWithPostStartupResAction commands startup resources since the container is not ready but with post-startup
@@ -207,10 +389,13 @@ func (a *targetContainerAction) notStartedWithPostStartupResAction(
 	targetContainer *v1.Container,
 	scaleConfigs scalecommon.Configurations,
 ) error {
-	resizeFuncs := scale.NewUpdates(scaleConfigs).StartupPodMutationFuncAll(targetContainer)
-	newPod, err := a.podHelper.Patch(ctx, a.podEventPublisher, pod, resizeFuncs, true)
-	if err != nil {
-		return common.WrapErrorf(err, "unable to patch container resources")
+	if a.isSuppressed(ctx, states, pod, scaleConfigs, podcommon.StateResourcesStartup) {
+		return nil
+	}
+
+	newPod, commanded, err := a.commandStartupResources(ctx, states, pod, targetContainer, scaleConfigs)
+	if !commanded {
+		return err
 	}
 
 	a.updateStatusAndLogInfo(
@@ -235,6 +420,10 @@ func (a *targetContainerAction) startedWithStartupResAction(
 	targetContainer *v1.Container,
 	scaleConfigs scalecommon.Configurations,
 ) error {
+	if a.isSuppressed(ctx, states, pod, scaleConfigs, podcommon.StateResourcesPostStartup) {
+		return nil
+	}
+
 	resizeFuncs := scale.NewUpdates(scaleConfigs).PostStartupPodMutationFuncAll(targetContainer)
 	newPod, err := a.podHelper.Patch(ctx, a.podEventPublisher, pod, resizeFuncs, true)
 	if err != nil {
@@ -276,10 +465,13 @@ func (a *targetContainerAction) notStartedWithUnknownResAction(
 	targetContainer *v1.Container,
 	scaleConfigs scalecommon.Configurations,
 ) error {
-	resizeFuncs := scale.NewUpdates(scaleConfigs).StartupPodMutationFuncAll(targetContainer)
-	newPod, err := a.podHelper.Patch(ctx, a.podEventPublisher, pod, resizeFuncs, true)
-	if err != nil {
-		return common.WrapErrorf(err, "unable to patch container resources")
+	if a.isSuppressed(ctx, states, pod, scaleConfigs, podcommon.StateResourcesStartup) {
+		return nil
+	}
+
+	newPod, commanded, err := a.commandStartupResources(ctx, states, pod, targetContainer, scaleConfigs)
+	if !commanded {
+		return err
 	}
 
 	a.updateStatusAndLogInfo(
@@ -305,6 +497,10 @@ func (a *targetContainerAction) startedWithUnknownResAction(
 	targetContainer *v1.Container,
 	scaleConfigs scalecommon.Configurations,
 ) error {
+	if a.isSuppressed(ctx, states, pod, scaleConfigs, podcommon.StateResourcesPostStartup) {
+		return nil
+	}
+
 	resizeFuncs := scale.NewUpdates(scaleConfigs).PostStartupPodMutationFuncAll(targetContainer)
 	newPod, err := a.podHelper.Patch(ctx, a.podEventPublisher, pod, resizeFuncs, true)
 	if err != nil {
@@ -324,6 +520,71 @@ func (a *targetContainerAction) startedWithUnknownResAction(
 	return nil
 }
 
+// notStartedReconfiguredAction commands startup resources since the container is not ready but with resources applied
+// that don't match the current configuration, which has changed since resources were last applied. Happens if
+// configuration annotations are changed on a running pod.
+func (a *targetContainerAction) notStartedReconfiguredAction(
+	ctx context.Context,
+	states podcommon.States,
+	pod *v1.Pod,
+	targetContainer *v1.Container,
+	scaleConfigs scalecommon.Configurations,
+) error {
+	if a.isSuppressed(ctx, states, pod, scaleConfigs, podcommon.StateResourcesStartup) {
+		return nil
+	}
+
+	newPod, commanded, err := a.commandStartupResources(ctx, states, pod, targetContainer, scaleConfigs)
+	if !commanded {
+		return err
+	}
+
+	a.updateStatusAndLogInfo(
+		ctx,
+		logging.VInfo,
+		newPod,
+		"startup resources commanded (configuration changed)",
+		states,
+		podcommon.StatusScaleStateReconfiguredCommanded,
+		scaleConfigs,
+		"",
+	)
+	return nil
+}
+
+// startedReconfiguredAction commands post-startup resources since the container is ready but with resources applied
+// that don't match the current configuration, which has changed since resources were last applied. Happens if
+// configuration annotations are changed on a running pod.
+func (a *targetContainerAction) startedReconfiguredAction(
+	ctx context.Context,
+	states podcommon.States,
+	pod *v1.Pod,
+	targetContainer *v1.Container,
+	scaleConfigs scalecommon.Configurations,
+) error {
+	if a.isSuppressed(ctx, states, pod, scaleConfigs, podcommon.StateResourcesPostStartup) {
+		return nil
+	}
+
+	resizeFuncs := scale.NewUpdates(scaleConfigs).PostStartupPodMutationFuncAll(targetContainer)
+	newPod, err := a.podHelper.Patch(ctx, a.podEventPublisher, pod, resizeFuncs, true)
+	if err != nil {
+		return common.WrapErrorf(err, "unable to patch container resources")
+	}
+
+	a.updateStatusAndLogInfo(
+		ctx,
+		logging.VInfo,
+		newPod,
+		"post-startup resources commanded (configuration changed)",
+		states,
+		podcommon.StatusScaleStateReconfiguredCommanded,
+		scaleConfigs,
+		"",
+	)
+	return nil
+}
+
 // processConfigEnacted examines conditions to determine if the previously commanded resources have been enacted.
 // Some unfavourable conditions will yield an error. Logging and status are updated appropriately.
 func (a *targetContainerAction) processConfigEnacted(
@@ -422,11 +683,545 @@ func (a *targetContainerAction) processConfigEnacted(
 		scaleState = podcommon.StatusScaleStateUpEnacted
 	}
 
+	// Enacted resources are reflected in the pod's requests, so any reserved startup surplus is no longer required.
+	a.releaseStartupSurplus(pod)
 	msg := states.Resources.HumanReadable() + " resources enacted"
 	a.updateStatusAndLogInfo(ctx, logging.VInfo, pod, msg, states, scaleState, scaleConfigs, "")
 	return nil
 }
 
+// evictionFallbackAction evicts the pod if it opts into eviction fallback and startup resources are infeasible, or have
+// been deferred for longer than the eviction fallback deferred timeout, so that it's recreated by its workload where
+// startup resources may be enacted. enactedErr is the error yielded upon examining whether startup resources are
+// enacted, which is returned if eviction fallback doesn't apply. Otherwise, an error is returned so that the pod is
+// requeued if it's yet to be evicted.
+func (a *targetContainerAction) evictionFallbackAction(
+	ctx context.Context,
+	states podcommon.States,
+	pod *v1.Pod,
+	scaleConfigs scalecommon.Configurations,
+	enactedErr error,
+) error {
+	enabled, _ := evictionFallbackFor(pod) // Invalid values are reported upon during validation.
+	if !enabled {
+		return enactedErr
+	}
+
+	if workloadKeyFor(pod) == "" {
+		logging.Infof(ctx, logging.VInfo, "eviction fallback not applicable as pod not controlled by a workload")
+		return enactedErr
+	}
+
+	reason := evictionReasonInfeasible
+	if states.Resize.State == podcommon.StateResizeDeferred {
+		reason = evictionReasonDeferred
+
+		deferredFor, err := a.commandedFor(pod)
+		if err != nil {
+			logging.Errorf(ctx, err, "unable to determine how long startup resources deferred (will not evict)")
+			return enactedErr
+		}
+
+		if remaining := a.controllerConfig.EvictionFallbackDeferredTimeoutDuration() - deferredFor; remaining > 0 {
+			return NewEvictionFallbackPendingError(
+				fmt.Sprintf("startup resources deferred - will evict in %s if not enacted", remaining.Round(time.Second)),
+			)
+		}
+	}
+
+	// Evicting is more disruptive than resizing, so is suppressed in the same way as startup resources.
+	if a.isSuppressed(ctx, states, pod, scaleConfigs, podcommon.StateResourcesStartup) {
+		return nil
+	}
+
+	evicted, cooldownRemaining, err := a.evictionFallback.Evict(ctx, pod)
+	if err != nil {
+		msg := "unable to evict pod"
+		if kube.IsEvictionBlockedError(err) {
+			msg = "eviction blocked by pod disruption budget"
+		}
+
+		logging.Errorf(ctx, err, "%s (will requeue)", msg)
+		return NewEvictionFallbackPendingError(fmt.Sprintf("startup resources %s - %s", reason, msg))
+	}
+
+	if !evicted {
+		return NewEvictionFallbackPendingError(fmt.Sprintf(
+			"startup resources %s - another pod of workload recently evicted (%s cooldown remaining)",
+			reason, cooldownRemaining.Round(time.Second),
+		))
+	}
+
+	msg := fmt.Sprintf("startup resources %s - pod evicted (eviction fallback)", reason)
+	if ccontext.DryRun(ctx) {
+		msg += " (dry run)"
+	}
+
+	a.updateStatusAndLogInfo(ctx, logging.VInfo, pod, msg, states, podcommon.StatusScaleStateUpEvicted, scaleConfigs, reason)
+	return nil
+}
+
+// commandedFor returns how long ago resources were last commanded, as recorded within the status annotation.
+func (a *targetContainerAction) commandedFor(pod *v1.Pod) (time.Duration, error) {
+	stat, err := podcommon.StatusAnnotationFromString(pod.Annotations[kubecommon.AnnotationStatus])
+	if err != nil {
+		return 0, common.WrapErrorf(err, "unable to get status annotation")
+	}
+
+	commanded, err := time.Parse(timeFormatMilli, stat.Scale.LastCommanded)
+	if err != nil {
+		return 0, common.WrapErrorf(err, "unable to parse last commanded time '%s'", stat.Scale.LastCommanded)
+	}
+
+	return time.Since(commanded), nil
+}
+
+// isSuppressed returns whether commanding the supplied resources is currently suppressed by the kill switch or a
+// blackout window. If so, logging, status and metrics are updated appropriately.
+func (a *targetContainerAction) isSuppressed(
+	ctx context.Context,
+	states podcommon.States,
+	pod *v1.Pod,
+	scaleConfigs scalecommon.Configurations,
+	resources podcommon.StateResources,
+) bool {
+	direction := resources.Direction()
+	reason := ccontext.Suppression(ctx).Reason(direction)
+	if reason == controlcommon.SuppressionReasonNone {
+		return false
+	}
+
+	a.updateStatusAndLogInfo(
+		ctx,
+		logging.VInfo,
+		pod,
+		fmt.Sprintf("%s resources not commanded - suppressed (%s)", resources.HumanReadable(), reason.HumanReadable()),
+		states,
+		podcommon.StatusScaleStateNotApplicable,
+		scaleConfigs,
+		"",
+	)
+	metricsscale.Suppressed(direction, string(reason)).Inc()
+	return true
+}
+
+// commandStartupResources commands startup resources once all upscale gates pass, and returns the patched pod along
+// with whether startup resources were commanded. Where a gate doesn't pass or the Kube API rejects startup resources
+// since a resource quota would be exceeded, status is updated and any error dictating a requeue is returned. Any
+// reserved startup surplus is released if startup resources can't be commanded.
+func (a *targetContainerAction) commandStartupResources(
+	ctx context.Context,
+	states podcommon.States,
+	pod *v1.Pod,
+	targetContainer *v1.Container,
+	scaleConfigs scalecommon.Configurations,
+) (*v1.Pod, bool, error) {
+	if passed, err := a.upscaleGates(ctx, states, pod, targetContainer, scaleConfigs); !passed {
+		return nil, false, err
+	}
+
+	resizeFuncs := scale.NewUpdates(scaleConfigs).StartupPodMutationFuncAll(targetContainer)
+	newPod, err := a.podHelper.Patch(ctx, a.podEventPublisher, pod, resizeFuncs, true)
+	if err != nil {
+		a.releaseStartupSurplus(pod)
+		if kube.IsResourceQuotaExceededError(err) {
+			return nil, false, a.startupResourceQuotaExceeded(ctx, states, pod, scaleConfigs, err)
+		}
+
+		return nil, false, common.WrapErrorf(err, "unable to patch container resources")
+	}
+
+	return newPod, true, nil
+}
+
+// upscaleGates examines, in order, the gates that must pass before startup resources are commanded: resource quota
+// headroom, node capacity, node startup surplus budget, node upscale ordering and workload startup limit. Returns
+// whether all gates pass and, if not, the error of the first gate that doesn't pass (nil if that gate doesn't dictate a
+// requeue). Startup surplus reserved by the budget gate is released if a subsequent gate doesn't pass.
+func (a *targetContainerAction) upscaleGates(
+	ctx context.Context,
+	states podcommon.States,
+	pod *v1.Pod,
+	targetContainer *v1.Container,
+	scaleConfigs scalecommon.Configurations,
+) (bool, error) {
+	if insufficient, err := a.isResourceQuotaInsufficient(ctx, states, pod, targetContainer, scaleConfigs); insufficient {
+		// Not blocked by the node, so mustn't hold up other pods on it.
+		a.stopWaitingForNodeUpscale(pod)
+		return false, err
+	}
+
+	if insufficient, err := a.isNodeCapacityInsufficient(ctx, states, pod, targetContainer, scaleConfigs); insufficient {
+		return false, err
+	}
+
+	if exceeded, err := a.isStartupSurplusBudgetExceeded(ctx, states, pod, scaleConfigs); exceeded {
+		return false, err
+	}
+
+	if deferred, err := a.isNodeUpscaleDeferred(ctx, states, pod, scaleConfigs); deferred {
+		a.releaseStartupSurplus(pod)
+		return false, err
+	}
+
+	if reached, err := a.isWorkloadStartupLimitReached(ctx, states, pod, scaleConfigs); reached {
+		a.releaseStartupSurplus(pod)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// releaseStartupSurplus releases any startup surplus reserved for the supplied pod, if the node startup surplus budget
+// is enabled.
+func (a *targetContainerAction) releaseStartupSurplus(pod *v1.Pod) {
+	if len(a.controllerConfig.NodeStartupSurplusBudget()) > 0 {
+		a.startupSurplus.Release(pod)
+	}
+}
+
+// isNodeCapacityInsufficient returns whether the pod's node has insufficient free allocatable capacity to accommodate
+// the increase from the target container's current requests to its startup resources, per the configured node capacity
+// strategy. When insufficient, an error is also returned if the strategy dictates a requeue. Free capacity that can't
+// be determined is treated as sufficient so that startup resources are still commanded on a best-effort basis.
+func (a *targetContainerAction) isNodeCapacityInsufficient(
+	ctx context.Context,
+	states podcommon.States,
+	pod *v1.Pod,
+	targetContainer *v1.Container,
+	scaleConfigs scalecommon.Configurations,
+) (bool, error) {
+	strategy := a.controllerConfig.NodeCapacityStrategy
+	if strategy == controllercommon.NodeCapacityStrategyDisabled || pod.Spec.NodeName == "" {
+		return false, nil
+	}
+
+	free, err := a.nodeHelper.FreeAllocatable(ctx, pod.Spec.NodeName)
+	if err != nil {
+		logging.Errorf(ctx, err, "unable to determine free node capacity (will command regardless)")
+		return false, nil
+	}
+
+	var shortfalls []string
+	for _, config := range scaleConfigs.AllEnabledConfigurations() {
+		resourceName := config.ResourceName()
+		required := config.Resources().Startup.DeepCopy()
+		required.Sub(targetContainer.Resources.Requests[resourceName])
+		available := free[resourceName]
+
+		if required.Cmp(available) > 0 {
+			shortfalls = append(
+				shortfalls,
+				fmt.Sprintf("%s: %s required, %s free", resourceName, required.String(), available.String()),
+			)
+		}
+	}
+
+	if len(shortfalls) == 0 {
+		return false, nil
+	}
+
+	message := fmt.Sprintf(
+		"startup resources not commanded - insufficient node capacity (%s)",
+		strings.Join(shortfalls, ", "),
+	)
+	a.updateStatusAndLogInfo(
+		ctx,
+		logging.VInfo,
+		pod,
+		message,
+		states,
+		podcommon.StatusScaleStateNotApplicable,
+		scaleConfigs,
+		"",
+	)
+	metricsscale.Suppressed(podcommon.StateResourcesStartup.Direction(), suppressedReasonInsufficientNodeCapacity).Inc()
+
+	// Only pods that requeue for node capacity wait their turn per node upscale priority ordering - skipped pods no
+	// longer require startup resources.
+	if strategy == controllercommon.NodeCapacityStrategyRequeue {
+		a.waitForNodeUpscale(pod)
+		return true, NewInsufficientNodeCapacityError(message)
+	}
+
+	a.stopWaitingForNodeUpscale(pod)
+	return true, nil
+}
+
+// isResourceQuotaInsufficient returns whether commanding startup resources would exceed the headroom of a resource
+// quota within the pod's namespace. If so, status is updated and an error is returned so that the pod is requeued until
+// headroom becomes available. Headroom that can't be determined is treated as sufficient, leaving any rejection to the
+// Kube API.
+func (a *targetContainerAction) isResourceQuotaInsufficient(
+	ctx context.Context,
+	states podcommon.States,
+	pod *v1.Pod,
+	targetContainer *v1.Container,
+	scaleConfigs scalecommon.Configurations,
+) (bool, error) {
+	quotas, err := a.namespaceHelper.ResourceQuotas(ctx, pod.Namespace)
+	if err != nil {
+		logging.Errorf(ctx, err, "unable to determine resource quota headroom (will command regardless)")
+		return false, nil
+	}
+
+	shortfalls := resourceQuotaShortfalls(quotas, pod, targetContainer, scaleConfigs)
+	if len(shortfalls) == 0 {
+		return false, nil
+	}
+
+	message := fmt.Sprintf(
+		"startup resources not commanded - insufficient resource quota (%s)",
+		strings.Join(shortfalls, ", "),
+	)
+	a.updateStatusAndLogInfo(
+		ctx,
+		logging.VInfo,
+		pod,
+		message,
+		states,
+		podcommon.StatusScaleStateNotApplicable,
+		scaleConfigs,
+		"",
+	)
+	metricsscale.Suppressed(podcommon.StateResourcesStartup.Direction(), suppressedReasonInsufficientResourceQuota).Inc()
+	return true, NewInsufficientResourceQuotaError(message)
+}
+
+// startupResourceQuotaExceeded updates status upon the Kube API rejecting startup resources since a resource quota
+// would be exceeded, and returns an error so that the pod is requeued until headroom becomes available.
+func (a *targetContainerAction) startupResourceQuotaExceeded(
+	ctx context.Context,
+	states podcommon.States,
+	pod *v1.Pod,
+	scaleConfigs scalecommon.Configurations,
+	err error,
+) error {
+	reason := err.Error()
+	var stat kerrors.APIStatus
+	if errors.As(err, &stat) {
+		reason = stat.Status().Message
+	}
+
+	message := fmt.Sprintf("startup resources rejected - resource quota exceeded (%s)", reason)
+	a.updateStatusAndLogInfo(
+		ctx,
+		logging.VInfo,
+		pod,
+		message,
+		states,
+		podcommon.StatusScaleStateUpQuotaExceeded,
+		scaleConfigs,
+		failReasonResourceQuota,
+	)
+	return NewInsufficientResourceQuotaError(message)
+}
+
+// isStartupSurplusBudgetExceeded returns whether commanding startup resources would exceed the node startup surplus
+// budget, given the startup surplus already used by other pods on the pod's node. If not exceeded, the pod's startup
+// surplus is reserved until released. When exceeded, an error is also returned so that commanding is retried once
+// earlier pods have been commanded post-startup resources. Used surplus that can't be determined is treated as within
+// budget.
+func (a *targetContainerAction) isStartupSurplusBudgetExceeded(
+	ctx context.Context,
+	states podcommon.States,
+	pod *v1.Pod,
+	scaleConfigs scalecommon.Configurations,
+) (bool, error) {
+	budget := a.controllerConfig.NodeStartupSurplusBudget()
+	if len(budget) == 0 || pod.Spec.NodeName == "" {
+		return false, nil
+	}
+
+	required := startupSurplusOf(scaleConfigs)
+	if len(required) == 0 {
+		return false, nil
+	}
+
+	reserved, used, err := a.startupSurplus.Reserve(ctx, pod, required, budget)
+	if err != nil {
+		logging.Errorf(ctx, err, "unable to determine used node startup surplus (will command regardless)")
+		return false, nil
+	}
+
+	var exceeded []string
+	for _, config := range scaleConfigs.AllEnabledConfigurations() {
+		resourceName := config.ResourceName()
+		limit, hasBudget := budget[resourceName]
+		requiredQuantity, hasRequired := required[resourceName]
+		if !hasBudget || !hasRequired {
+			continue
+		}
+
+		usedQuantity := used[resourceName]
+		total := usedQuantity.DeepCopy()
+		total.Add(requiredQuantity)
+		metricsscale.SurplusBudgetUsage(string(resourceName)).Observe(total.AsApproximateFloat64() / limit.AsApproximateFloat64())
+
+		if total.Cmp(limit) > 0 {
+			exceeded = append(
+				exceeded,
+				fmt.Sprintf(
+					"%s: %s required, %s of %s used",
+					resourceName, requiredQuantity.String(), usedQuantity.String(), limit.String(),
+				),
+			)
+		}
+	}
+
+	if reserved {
+		return false, nil
+	}
+
+	message := fmt.Sprintf(
+		"startup resources not commanded - node startup surplus budget exceeded (%s)",
+		strings.Join(exceeded, ", "),
+	)
+	a.updateStatusAndLogInfo(
+		ctx,
+		logging.VInfo,
+		pod,
+		message,
+		states,
+		podcommon.StatusScaleStateNotApplicable,
+		scaleConfigs,
+		"",
+	)
+	metricsscale.Suppressed(podcommon.StateResourcesStartup.Direction(), suppressedReasonStartupSurplusBudget).Inc()
+	a.waitForNodeUpscale(pod)
+	return true, NewStartupSurplusBudgetExceededError(message)
+}
+
+// isNodeUpscaleDeferred returns whether commanding startup resources should be deferred since other pods waiting for
+// startup resources on the pod's node rank ahead of it (when node upscale priority ordering is enabled). If so, the pod
+// is recorded as waiting, status is updated and an error is returned so that the pod is requeued. Otherwise, the pod is
+// no longer recorded as waiting. Failure to determine this is logged and startup resources are commanded regardless.
+func (a *targetContainerAction) isNodeUpscaleDeferred(
+	ctx context.Context,
+	states podcommon.States,
+	pod *v1.Pod,
+	scaleConfigs scalecommon.Configurations,
+) (bool, error) {
+	if !a.controllerConfig.NodeUpscalePriorityOrdering || pod.Spec.NodeName == "" {
+		return false, nil
+	}
+
+	ahead, err := a.nodeUpscaleQueue.Ahead(ctx, pod)
+	if err != nil {
+		logging.Errorf(ctx, err, "unable to determine node upscale order (will command regardless)")
+	}
+	if err != nil || ahead == 0 {
+		a.nodeUpscaleQueue.Remove(pod)
+		return false, nil
+	}
+
+	a.nodeUpscaleQueue.Wait(pod)
+	message := fmt.Sprintf("startup resources not commanded - waiting for %d pod(s) ranked ahead on node", ahead)
+	a.updateStatusAndLogInfo(
+		ctx,
+		logging.VInfo,
+		pod,
+		message,
+		states,
+		podcommon.StatusScaleStateNotApplicable,
+		scaleConfigs,
+		"",
+	)
+	metricsscale.Suppressed(podcommon.StateResourcesStartup.Direction(), suppressedReasonNodeUpscaleOrdering).Inc()
+	return true, NewNodeUpscaleDeferredError(message)
+}
+
+// waitForNodeUpscale records the supplied pod as waiting for startup resources on its node, if node upscale priority
+// ordering is enabled.
+func (a *targetContainerAction) waitForNodeUpscale(pod *v1.Pod) {
+	if a.controllerConfig.NodeUpscalePriorityOrdering {
+		a.nodeUpscaleQueue.Wait(pod)
+	}
+}
+
+// stopWaitingForNodeUpscale removes the supplied pod as waiting for startup resources on its node, if node upscale
+// priority ordering is enabled.
+func (a *targetContainerAction) stopWaitingForNodeUpscale(pod *v1.Pod) {
+	if a.controllerConfig.NodeUpscalePriorityOrdering {
+		a.nodeUpscaleQueue.Remove(pod)
+	}
+}
+
+// isWorkloadStartupLimitReached returns whether commanding startup resources would exceed the maximum number of pods of
+// the same workload concurrently holding startup resources, as indicated by the workload's or pod's annotations. If so,
+// status is updated and an error is returned so that the pod is requeued until a slot is released. Failure to determine
+// this is logged and startup resources are commanded regardless. This must be the last check prior to commanding
+// startup resources as a slot is acquired if the limit isn't reached.
+func (a *targetContainerAction) isWorkloadStartupLimitReached(
+	ctx context.Context,
+	states podcommon.States,
+	pod *v1.Pod,
+	scaleConfigs scalecommon.Configurations,
+) (bool, error) {
+	limit, err := a.workloadStartups.Limit(ctx, pod)
+	if err != nil {
+		logging.Errorf(ctx, err, "unable to determine workload startup limit (will command regardless)")
+		return false, nil
+	}
+
+	if limit == 0 {
+		return false, nil
+	}
+
+	acquired, inUse, err := a.workloadStartups.Acquire(ctx, pod, limit)
+	if err != nil {
+		logging.Errorf(ctx, err, "unable to acquire workload startup slot (will command regardless)")
+		return false, nil
+	}
+
+	if acquired {
+		return false, nil
+	}
+
+	message := fmt.Sprintf(
+		"startup resources not commanded - waiting for workload startup slot (%d/%d in use)",
+		inUse, limit,
+	)
+	a.updateStatusAndLogInfo(
+		ctx,
+		logging.VInfo,
+		pod,
+		message,
+		states,
+		podcommon.StatusScaleStateNotApplicable,
+		scaleConfigs,
+		"",
+	)
+	metricsscale.Suppressed(podcommon.StateResourcesStartup.Direction(), suppressedReasonWorkloadStartupLimit).Inc()
+	return true, NewWorkloadStartupLimitReachedError(message)
+}
+
+// isReconfigured returns whether the configuration last applied to the target container (as recorded within the status
+// annotation) differs from the supplied current configuration. Returns false if no configuration has previously been
+// recorded.
+func (a *targetContainerAction) isReconfigured(
+	ctx context.Context,
+	pod *v1.Pod,
+	scaleConfigs scalecommon.Configurations,
+) bool {
+	statAnn, gotStatAnn := pod.Annotations[kubecommon.AnnotationStatus]
+	if !gotStatAnn {
+		return false
+	}
+
+	stat, err := podcommon.StatusAnnotationFromString(statAnn)
+	if err != nil {
+		logging.Errorf(ctx, err, "unable to get status annotation from string (will ignore)")
+		return false
+	}
+
+	if stat.Scale.LastAppliedConfiguration == "" {
+		return false
+	}
+
+	return stat.Scale.LastAppliedConfiguration != scaleConfigs.String()
+}
+
 // maybeSuffixResizeMessage appends the resize message to the base message if the resize message is not empty.
 func (a *targetContainerAction) maybeSuffixResizeMessage(
 	baseMessage string,
@@ -479,6 +1274,10 @@ func (a *targetContainerAction) updateStatusAndLogInfo(
 	scaleConfigs scalecommon.Configurations,
 	failReason string,
 ) {
+	if scaleState.IsCommanded() && ccontext.DryRun(ctx) {
+		msg += " (dry run)"
+	}
+
 	a.updateStatus(ctx, pod, msg, states, scaleState, scaleConfigs, failReason)
 	logging.Infof(ctx, v, msg)
 }
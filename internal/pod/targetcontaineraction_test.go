@@ -20,32 +20,55 @@ import (
 	"bytes"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/context/contexttest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/control/controlcommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/controller/controllercommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/event"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubetest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	metricsscale "github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/scale"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podtest"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/scale/scaletest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/component-base/metrics/testutil"
 )
 
 func TestNewTargetContainerAction(t *testing.T) {
 	recorder := &record.FakeRecorder{}
 	config := controllercommon.ControllerConfig{}
-	podHelper := kube.NewPodHelper(nil)
-	stat := newStatus(recorder, podHelper)
+	podHelper := kube.NewPodHelper(nil, kube.NewDisabledCircuitBreaker())
+	stat := newStatus(recorder, podHelper, nil)
+	nodeHelper := kube.NewNodeHelper(nil, nil)
+	surplus := newStartupSurplus(nil, nil, nodeHelper)
+	queue := newNodeUpscaleQueue(config, podHelper)
+	namespaceHelper := kube.NewNamespaceHelper(nil)
+	startups := newWorkloadStartups(podHelper, nil)
+	fallback := newEvictionFallback(config, podHelper, nil, nil)
 	publisher := event.DefaultPodEventPublisher
-	action := newTargetContainerAction(config, stat, podHelper, publisher)
+	action := newTargetContainerAction(config, stat, podHelper, nodeHelper, namespaceHelper, surplus, queue, startups, fallback, publisher)
 	expected := &targetContainerAction{
 		controllerConfig:  config,
 		status:            stat,
 		podHelper:         podHelper,
+		nodeHelper:        nodeHelper,
+		namespaceHelper:   namespaceHelper,
+		startupSurplus:    surplus,
+		nodeUpscaleQueue:  queue,
+		workloadStartups:  startups,
+		evictionFallback:  fallback,
 		podEventPublisher: publisher,
 	}
 	assert.Equal(t, expected, action)
@@ -341,6 +364,12 @@ func TestTargetContainerActionExecute(t *testing.T) {
 				podtest.NewMockStatusWithRun(tt.configStatusMockFunc, run),
 				kubetest.NewMockPodHelper(nil),
 				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				podtest.NewMockWorkloadStartups(nil),
+				nil,
+				nil,
 			)
 
 			if tt.wantPanicErrMsg != "" {
@@ -375,6 +404,360 @@ func TestTargetContainerActionExecute(t *testing.T) {
 	}
 }
 
+func TestTargetContainerActionExecuteReconfigured(t *testing.T) {
+	tests := []struct {
+		name                string
+		scaleWhenUnknownRes bool
+		statusAnn           string
+		started             podcommon.StateBool
+		wantErrMsg          string
+		wantLogMsg          string
+	}{
+		{
+			"NoStatusAnnotationResUnknownAction",
+			false,
+			"",
+			podcommon.StateBoolTrue,
+			"unknown resources applied",
+			"",
+		},
+		{
+			"SameConfigurationResUnknownAction",
+			false,
+			reconfiguredStatusAnnotationString(""),
+			podcommon.StateBoolTrue,
+			"unknown resources applied",
+			"",
+		},
+		{
+			"SameConfigurationScaleWhenUnknownRes",
+			true,
+			reconfiguredStatusAnnotationString(""),
+			podcommon.StateBoolTrue,
+			"",
+			"post-startup resources commanded (unknown resources applied)",
+		},
+		{
+			"NotStartedReconfiguredAction",
+			false,
+			reconfiguredStatusAnnotationString("previous"),
+			podcommon.StateBoolFalse,
+			"",
+			"startup resources commanded (configuration changed)",
+		},
+		{
+			"StartedReconfiguredAction",
+			false,
+			reconfiguredStatusAnnotationString("previous"),
+			podcommon.StateBoolTrue,
+			"",
+			"post-startup resources commanded (configuration changed)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTargetContainerAction(
+				controllercommon.ControllerConfig{ScaleWhenUnknownResources: tt.scaleWhenUnknownRes},
+				podtest.NewMockStatus(nil),
+				kubetest.NewMockPodHelper(nil),
+				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				podtest.NewMockWorkloadStartups(nil),
+				nil,
+				nil,
+			)
+
+			pod := &v1.Pod{}
+			if tt.statusAnn != "" {
+				pod.Annotations = map[string]string{kubecommon.AnnotationStatus: tt.statusAnn}
+			}
+
+			buffer := bytes.Buffer{}
+			err := a.Execute(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(&buffer)).Build(),
+				podcommon.States{
+					StartupProbe:   podcommon.StateBoolTrue,
+					ReadinessProbe: podcommon.StateBoolTrue,
+					Container:      podcommon.StateContainerRunning,
+					Started:        tt.started,
+					Ready:          tt.started,
+					Resources:      podcommon.StateResourcesUnknown,
+				},
+				pod,
+				&v1.Container{},
+				scaletest.NewMockConfigurations(nil),
+			)
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantLogMsg != "" {
+				assert.Contains(t, buffer.String(), tt.wantLogMsg)
+			}
+		})
+	}
+}
+
+func TestTargetContainerActionExecuteOverride(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		resources   podcommon.StateResources
+		wantErrMsg  string
+		wantLogMsg  string
+	}{
+		{
+			"UnableToDetermineOverride",
+			map[string]string{kubecommon.AnnotationPaused: "test"},
+			podcommon.StateResourcesPostStartup,
+			"unable to determine override",
+			"",
+		},
+		{
+			"Paused",
+			map[string]string{kubecommon.AnnotationPaused: "true", kubecommon.AnnotationForceState: forceStateStartup},
+			podcommon.StateResourcesPostStartup,
+			"",
+			"csa paused (no action taken)",
+		},
+		{
+			"ForceStartup",
+			map[string]string{kubecommon.AnnotationForceState: forceStateStartup},
+			podcommon.StateResourcesPostStartup,
+			"",
+			"startup resources commanded (forced)",
+		},
+		{
+			"ForcePostStartup",
+			map[string]string{kubecommon.AnnotationForceState: forceStatePostStartup},
+			podcommon.StateResourcesUnknown,
+			"",
+			"post-startup resources commanded (forced)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTargetContainerAction(
+				controllercommon.ControllerConfig{},
+				podtest.NewMockStatus(nil),
+				kubetest.NewMockPodHelper(nil),
+				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				podtest.NewMockWorkloadStartups(nil),
+				nil,
+				nil,
+			)
+
+			buffer := bytes.Buffer{}
+			err := a.Execute(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(&buffer)).Build(),
+				podcommon.States{
+					StartupProbe:   podcommon.StateBoolTrue,
+					ReadinessProbe: podcommon.StateBoolTrue,
+					Container:      podcommon.StateContainerRunning,
+					Started:        podcommon.StateBoolTrue,
+					Ready:          podcommon.StateBoolTrue,
+					Resources:      tt.resources,
+				},
+				&v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}},
+				&v1.Container{},
+				scaletest.NewMockConfigurations(nil),
+			)
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantLogMsg != "" {
+				assert.Contains(t, buffer.String(), tt.wantLogMsg)
+			}
+		})
+	}
+}
+
+func TestTargetContainerActionPausedAction(t *testing.T) {
+	statusUpdated := false
+	configStatusMock := podtest.NewMockStatusWithRun(
+		func(status *podtest.MockStatus, run func()) { status.UpdateDefaultAndRun(run) },
+		func() { statusUpdated = true },
+	)
+	a := newTargetContainerAction(
+		controllercommon.ControllerConfig{},
+		configStatusMock,
+		kubetest.NewMockPodHelper(nil),
+		nil,
+		kubetest.NewMockNamespaceHelper(nil),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	buffer := bytes.Buffer{}
+	_ = a.pausedAction(
+		contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(&buffer)).Build(),
+		podcommon.States{},
+		&v1.Pod{},
+		scaletest.NewMockConfigurations(nil),
+	)
+	assert.Contains(t, buffer.String(), "csa paused (no action taken)")
+	assert.True(t, statusUpdated)
+}
+
+func TestTargetContainerActionForcedAction(t *testing.T) {
+	tests := []struct {
+		name                    string
+		configPodHelperMockFunc func(*kubetest.MockPodHelper)
+		states                  podcommon.States
+		forcedResources         podcommon.StateResources
+		wantPanicErrMsg         string
+		wantErrMsg              string
+		wantStatusUpdate        bool
+		wantLogMsg              string
+	}{
+		{
+			"UnsupportedForcedResources",
+			nil,
+			podcommon.States{Resources: podcommon.StateResourcesStartup},
+			podcommon.StateResourcesUnknown,
+			"unsupported forced resources 'unknown'",
+			"",
+			false,
+			"",
+		},
+		{
+			"UnableToPatchContainerResources",
+			func(m *kubetest.MockPodHelper) {
+				m.On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(&v1.Pod{}, errors.New(""))
+			},
+			podcommon.States{Resources: podcommon.StateResourcesPostStartup},
+			podcommon.StateResourcesStartup,
+			"",
+			"unable to patch container resources",
+			false,
+			"",
+		},
+		{
+			"AlreadyAppliedProcessConfigEnacted",
+			nil,
+			podcommon.States{
+				Resources:       podcommon.StateResourcesStartup,
+				Resize:          podcommon.NewResizeState(podcommon.StateResizeNotStartedOrCompleted, ""),
+				StatusResources: podcommon.StateStatusResourcesContainerResourcesMatch,
+			},
+			podcommon.StateResourcesStartup,
+			"",
+			"",
+			true,
+			"startup resources enacted",
+		},
+		{
+			"OkStartup",
+			nil,
+			podcommon.States{Resources: podcommon.StateResourcesPostStartup},
+			podcommon.StateResourcesStartup,
+			"",
+			"",
+			true,
+			"startup resources commanded (forced)",
+		},
+		{
+			"OkPostStartup",
+			nil,
+			podcommon.States{Resources: podcommon.StateResourcesStartup},
+			podcommon.StateResourcesPostStartup,
+			"",
+			"",
+			true,
+			"post-startup resources commanded (forced)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statusUpdated := false
+			a := newTargetContainerAction(
+				controllercommon.ControllerConfig{},
+				podtest.NewMockStatusWithRun(
+					func(m *podtest.MockStatus, run func()) { m.UpdateDefaultAndRun(run) },
+					func() { statusUpdated = true },
+				),
+				kubetest.NewMockPodHelper(tt.configPodHelperMockFunc),
+				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				podtest.NewMockWorkloadStartups(nil),
+				nil,
+				nil,
+			)
+
+			buffer := bytes.Buffer{}
+			run := func() error {
+				return a.forcedAction(
+					contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(&buffer)).Build(),
+					tt.states,
+					&v1.Pod{},
+					&v1.Container{},
+					scaletest.NewMockConfigurations(nil),
+					tt.forcedResources,
+				)
+			}
+			if tt.wantPanicErrMsg != "" {
+				assert.PanicsWithError(t, tt.wantPanicErrMsg, func() { _ = run() })
+				return
+			}
+
+			err := run()
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantStatusUpdate, statusUpdated)
+			if tt.wantLogMsg != "" {
+				assert.Contains(t, buffer.String(), tt.wantLogMsg)
+			}
+		})
+	}
+
+	t.Run("StartupNotCommandedWhenGated", func(t *testing.T) {
+		mockPodHelper := kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {})
+		a := newTargetContainerAction(
+			controllercommon.ControllerConfig{NodeCapacityStrategy: controllercommon.NodeCapacityStrategyRequeue},
+			podtest.NewMockStatus(nil),
+			mockPodHelper,
+			kubetest.NewMockNodeHelper(func(m *kubetest.MockNodeHelper) {
+				m.On("FreeAllocatable", mock.Anything, mock.Anything).
+					Return(v1.ResourceList{v1.ResourceCPU: resource.MustParse("0")}, nil)
+			}),
+			kubetest.NewMockNamespaceHelper(nil),
+			nil,
+			nil,
+			podtest.NewMockWorkloadStartups(nil),
+			nil,
+			nil,
+		)
+
+		err := a.forcedAction(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			podcommon.States{Resources: podcommon.StateResourcesPostStartup},
+			&v1.Pod{Spec: v1.PodSpec{NodeName: "node"}},
+			&v1.Container{},
+			scaletest.NewMockConfigurations(nil),
+			podcommon.StateResourcesStartup,
+		)
+		assert.ErrorAs(t, err, &InsufficientNodeCapacityError{})
+		mockPodHelper.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 func TestTargetContainerActionContainerNotRunningAction(t *testing.T) {
 	statusUpdated := false
 	configStatusMock := podtest.NewMockStatusWithRun(
@@ -386,6 +769,12 @@ func TestTargetContainerActionContainerNotRunningAction(t *testing.T) {
 		configStatusMock,
 		kubetest.NewMockPodHelper(nil),
 		nil,
+		kubetest.NewMockNamespaceHelper(nil),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
 	buffer := bytes.Buffer{}
@@ -410,6 +799,12 @@ func TestTargetContainerActionStartedUnknownAction(t *testing.T) {
 		configStatusMock,
 		kubetest.NewMockPodHelper(nil),
 		nil,
+		kubetest.NewMockNamespaceHelper(nil),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
 	buffer := bytes.Buffer{}
@@ -429,6 +824,12 @@ func TestTargetContainerActionReadyUnknownAction(t *testing.T) {
 		configStatusMock,
 		kubetest.NewMockPodHelper(nil),
 		nil,
+		kubetest.NewMockNamespaceHelper(nil),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
 	buffer := bytes.Buffer{}
@@ -448,6 +849,12 @@ func TestTargetContainerActionResUnknownAction(t *testing.T) {
 		configStatusMock,
 		kubetest.NewMockPodHelper(nil),
 		nil,
+		kubetest.NewMockNamespaceHelper(nil),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
 	err := a.resUnknownAction(
@@ -468,6 +875,15 @@ func TestTargetContainerActionNotStartedWithStartupResAction(t *testing.T) {
 		wantErr          bool
 		wantStatusUpdate bool
 	}{
+		{
+			"InfeasibleEvictionFallbackNotEnabled",
+			podcommon.States{
+				Resources: podcommon.StateResourcesStartup,
+				Resize:    podcommon.NewResizeState(podcommon.StateResizeInfeasible, ""),
+			},
+			true,
+			true,
+		},
 		{
 			"Ok",
 			podcommon.States{
@@ -489,7 +905,13 @@ func TestTargetContainerActionNotStartedWithStartupResAction(t *testing.T) {
 				),
 				nil,
 				nil,
-			)
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
 
 			err := a.notStartedWithStartupResAction(
 				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
@@ -528,6 +950,15 @@ func TestTargetContainerActionNotStartedWithPostStartupResAction(t *testing.T) {
 			"unable to patch container resources",
 			false,
 		},
+		{
+			"ResourceQuotaExceeded",
+			func(m *kubetest.MockPodHelper) {
+				m.On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(&v1.Pod{}, kerrors.NewForbidden(schema.GroupResource{}, "", errors.New("exceeded quota")))
+			},
+			"insufficient resource quota",
+			true,
+		},
 		{
 			"Ok",
 			nil,
@@ -546,6 +977,12 @@ func TestTargetContainerActionNotStartedWithPostStartupResAction(t *testing.T) {
 				),
 				kubetest.NewMockPodHelper(tt.configPodHelperMockFunc),
 				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				podtest.NewMockWorkloadStartups(nil),
+				nil,
+				nil,
 			)
 
 			err := a.notStartedWithPostStartupResAction(
@@ -603,6 +1040,12 @@ func TestTargetContainerActionStartedWithStartupResAction(t *testing.T) {
 				),
 				kubetest.NewMockPodHelper(tt.configPodHelperMockFunc),
 				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			err := a.startedWithStartupResAction(
@@ -654,6 +1097,12 @@ func TestTargetContainerActionStartedWithPostStartupResAction(t *testing.T) {
 				),
 				nil,
 				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			err := a.startedWithPostStartupResAction(
@@ -693,6 +1142,15 @@ func TestTargetContainerActionNotStartedWithUnknownResAction(t *testing.T) {
 			"unable to patch container resources",
 			false,
 		},
+		{
+			"ResourceQuotaExceeded",
+			func(m *kubetest.MockPodHelper) {
+				m.On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(&v1.Pod{}, kerrors.NewForbidden(schema.GroupResource{}, "", errors.New("exceeded quota")))
+			},
+			"insufficient resource quota",
+			true,
+		},
 		{
 			"Ok",
 			nil,
@@ -711,6 +1169,12 @@ func TestTargetContainerActionNotStartedWithUnknownResAction(t *testing.T) {
 				),
 				kubetest.NewMockPodHelper(tt.configPodHelperMockFunc),
 				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				podtest.NewMockWorkloadStartups(nil),
+				nil,
+				nil,
 			)
 
 			err := a.notStartedWithUnknownResAction(
@@ -768,6 +1232,12 @@ func TestTargetContainerActionStartedWithUnknownResAction(t *testing.T) {
 				),
 				kubetest.NewMockPodHelper(tt.configPodHelperMockFunc),
 				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			err := a.startedWithUnknownResAction(
@@ -791,6 +1261,141 @@ func TestTargetContainerActionStartedWithUnknownResAction(t *testing.T) {
 	}
 }
 
+func TestTargetContainerActionNotStartedReconfiguredAction(t *testing.T) {
+	tests := []struct {
+		name                    string
+		configPodHelperMockFunc func(*kubetest.MockPodHelper)
+		wantErrMsg              string
+		wantStatusUpdate        bool
+	}{
+		{
+			"UnableToPatchContainerResources",
+			func(m *kubetest.MockPodHelper) {
+				m.On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(&v1.Pod{}, errors.New(""))
+			},
+			"unable to patch container resources",
+			false,
+		},
+		{
+			"ResourceQuotaExceeded",
+			func(m *kubetest.MockPodHelper) {
+				m.On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(&v1.Pod{}, kerrors.NewForbidden(schema.GroupResource{}, "", errors.New("exceeded quota")))
+			},
+			"insufficient resource quota",
+			true,
+		},
+		{
+			"Ok",
+			nil,
+			"",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statusUpdated := false
+			a := newTargetContainerAction(
+				controllercommon.ControllerConfig{},
+				podtest.NewMockStatusWithRun(
+					func(m *podtest.MockStatus, run func()) { m.UpdateDefaultAndRun(run) },
+					func() { statusUpdated = true },
+				),
+				kubetest.NewMockPodHelper(tt.configPodHelperMockFunc),
+				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				podtest.NewMockWorkloadStartups(nil),
+				nil,
+				nil,
+			)
+
+			err := a.notStartedReconfiguredAction(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+				podcommon.States{},
+				&v1.Pod{},
+				&v1.Container{},
+				scaletest.NewMockConfigurations(nil),
+			)
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantStatusUpdate {
+				assert.True(t, statusUpdated)
+			} else {
+				assert.False(t, statusUpdated)
+			}
+		})
+	}
+}
+
+func TestTargetContainerActionStartedReconfiguredAction(t *testing.T) {
+	tests := []struct {
+		name                    string
+		configPodHelperMockFunc func(*kubetest.MockPodHelper)
+		wantErrMsg              string
+		wantStatusUpdate        bool
+	}{
+		{
+			"UnableToPatchContainerResources",
+			func(m *kubetest.MockPodHelper) {
+				m.On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(&v1.Pod{}, errors.New(""))
+			},
+			"unable to patch container resources",
+			false,
+		},
+		{
+			"Ok",
+			nil,
+			"",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statusUpdated := false
+			a := newTargetContainerAction(
+				controllercommon.ControllerConfig{},
+				podtest.NewMockStatusWithRun(
+					func(m *podtest.MockStatus, run func()) { m.UpdateDefaultAndRun(run) },
+					func() { statusUpdated = true },
+				),
+				kubetest.NewMockPodHelper(tt.configPodHelperMockFunc),
+				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			err := a.startedReconfiguredAction(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+				podcommon.States{},
+				&v1.Pod{},
+				&v1.Container{},
+				scaletest.NewMockConfigurations(nil),
+			)
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantStatusUpdate {
+				assert.True(t, statusUpdated)
+			} else {
+				assert.False(t, statusUpdated)
+			}
+		})
+	}
+}
+
 func TestTargetContainerActionProcessConfigEnacted(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -976,6 +1581,12 @@ func TestTargetContainerActionProcessConfigEnacted(t *testing.T) {
 				podtest.NewMockStatusWithRun(tt.configStatusMockFunc, func() { statusUpdated = true }),
 				nil,
 				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			if tt.wantPanicErrMsg != "" {
@@ -1010,46 +1621,1275 @@ func TestTargetContainerActionProcessConfigEnacted(t *testing.T) {
 	}
 }
 
-func TestTargetContainerActionContainerResourceConfig(t *testing.T) {
-	a := newTargetContainerAction(
-		controllercommon.ControllerConfig{},
-		nil,
-		nil,
-		nil,
-	)
-
-	mockContainer := kubetest.NewContainerBuilder().Build()
-	mockScaleConfigs := scaletest.NewMockConfigurations(func(m *scaletest.MockConfigurations) {
-		m.On("String").Return("test")
-	})
-	got := a.containerResourceConfig(mockContainer, mockScaleConfigs)
-	assert.Contains(t, got, "target container resources: [")
-	assert.Contains(t, got, "], configurations: [test]")
-}
-
-func TestTargetContainerActionUpdateStatus(t *testing.T) {
-	t.Run("UnableToUpdateStatus", func(t *testing.T) {
-		mockStatus := podtest.NewMockStatus(func(m *podtest.MockStatus) {
-			m.On("Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-				Return(&v1.Pod{}, errors.New(""))
-		})
-		a := newTargetContainerAction(
-			controllercommon.ControllerConfig{},
-			mockStatus,
-			nil,
-			nil,
-		)
+func TestTargetContainerActionEvictionFallbackAction(t *testing.T) {
+	isController := true
+	newPod := func(evictionFallback string, controlled bool, lastCommanded *time.Time) *v1.Pod {
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Annotations: map[string]string{}}}
+		if evictionFallback != "" {
+			pod.Annotations[kubecommon.AnnotationEvictionFallback] = evictionFallback
+		}
+		if controlled {
+			pod.OwnerReferences = []metav1.OwnerReference{{Kind: "StatefulSet", Name: "statefulset", Controller: &isController}}
+		}
+		if lastCommanded != nil {
+			scale := podcommon.NewEmptyStatusAnnotationScale(nil)
+			scale.LastCommanded = lastCommanded.UTC().Format(timeFormatMilli)
+			pod.Annotations[kubecommon.AnnotationStatus] = podcommon.NewStatusAnnotation("", scale, "").Json()
+		}
+		return pod
+	}
+	evictFunc := func(evicted bool, cooldownRemaining time.Duration, err error) func(*podtest.MockEvictionFallback) {
+		return func(m *podtest.MockEvictionFallback) {
+			m.On("Evict", mock.Anything, mock.Anything).Return(evicted, cooldownRemaining, err)
+		}
+	}
+	recently := time.Now().Add(-time.Minute)
+	longAgo := time.Now().Add(-time.Hour)
+	blockedErr := kerrors.NewTooManyRequests("", 0)
+	blockedErr.ErrStatus.Details.Causes = []metav1.StatusCause{{Type: policyv1.DisruptionBudgetCause}}
+	enactedErr := errors.New("enacted")
 
-		buffer := bytes.Buffer{}
-		a.updateStatus(
-			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(&buffer)).Build(),
-			&v1.Pod{},
+	tests := []struct {
+		name                           string
+		resizeState                    podcommon.StateResize
+		pod                            *v1.Pod
+		configEvictionFallbackMockFunc func(*podtest.MockEvictionFallback)
+		wantErrMsg                     string
+		wantPending                    bool
+		wantFailReason                 string
+		wantEvictNotCalled             bool
+	}{
+		{
+			"NotEnabled",
+			podcommon.StateResizeInfeasible,
+			newPod("false", true, nil),
+			evictFunc(true, 0, nil),
+			"enacted",
+			false,
 			"",
-			podcommon.States{},
-			podcommon.StatusScaleStateNotApplicable,
-			scaletest.NewMockConfigurations(nil),
+			true,
+		},
+		{
+			"NotControlled",
+			podcommon.StateResizeInfeasible,
+			newPod("true", false, nil),
+			evictFunc(true, 0, nil),
+			"enacted",
+			false,
 			"",
-		)
-		assert.Contains(t, buffer.String(), "unable to update status")
-	})
+			true,
+		},
+		{
+			"DeferredUnableToDetermineCommanded",
+			podcommon.StateResizeDeferred,
+			newPod("true", true, nil),
+			evictFunc(true, 0, nil),
+			"enacted",
+			false,
+			"",
+			true,
+		},
+		{
+			"DeferredWithinTimeout",
+			podcommon.StateResizeDeferred,
+			newPod("true", true, &recently),
+			evictFunc(true, 0, nil),
+			"startup resources deferred - will evict in",
+			true,
+			"",
+			true,
+		},
+		{
+			"UnableToEvict",
+			podcommon.StateResizeInfeasible,
+			newPod("true", true, nil),
+			evictFunc(false, 0, errors.New("")),
+			"startup resources infeasible - unable to evict pod",
+			true,
+			"",
+			false,
+		},
+		{
+			"EvictionBlocked",
+			podcommon.StateResizeInfeasible,
+			newPod("true", true, nil),
+			evictFunc(false, 0, blockedErr),
+			"startup resources infeasible - eviction blocked by pod disruption budget",
+			true,
+			"",
+			false,
+		},
+		{
+			"InCooldown",
+			podcommon.StateResizeInfeasible,
+			newPod("true", true, nil),
+			evictFunc(false, 30*time.Second, nil),
+			"startup resources infeasible - another pod of workload recently evicted (30s cooldown remaining)",
+			true,
+			"",
+			false,
+		},
+		{
+			"InfeasibleEvicted",
+			podcommon.StateResizeInfeasible,
+			newPod("true", true, nil),
+			evictFunc(true, 0, nil),
+			"",
+			false,
+			evictionReasonInfeasible,
+			false,
+		},
+		{
+			"DeferredEvicted",
+			podcommon.StateResizeDeferred,
+			newPod("true", true, &longAgo),
+			evictFunc(true, 0, nil),
+			"",
+			false,
+			evictionReasonDeferred,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotScaleState podcommon.StatusScaleState
+			var gotFailReason string
+			mockEvictionFallback := podtest.NewMockEvictionFallback(tt.configEvictionFallbackMockFunc)
+			a := newTargetContainerAction(
+				controllercommon.ControllerConfig{EvictionFallbackDeferredTimeoutSecs: 300},
+				podtest.NewMockStatus(func(m *podtest.MockStatus) {
+					m.On("Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+						Run(func(args mock.Arguments) {
+							gotScaleState = args.Get(5).(podcommon.StatusScaleState)
+							gotFailReason = args.Get(7).(string)
+						}).
+						Return(&v1.Pod{}, nil)
+				}),
+				nil,
+				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				nil,
+				mockEvictionFallback,
+				nil,
+			)
+
+			err := a.evictionFallbackAction(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+				podcommon.States{Resize: podcommon.NewResizeState(tt.resizeState, "")},
+				tt.pod,
+				scaletest.NewMockConfigurations(nil),
+				enactedErr,
+			)
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantPending {
+				assert.ErrorAs(t, err, &EvictionFallbackPendingError{})
+			}
+			if tt.wantFailReason != "" {
+				assert.Equal(t, podcommon.StatusScaleStateUpEvicted, gotScaleState)
+				assert.Equal(t, tt.wantFailReason, gotFailReason)
+			} else {
+				assert.Empty(t, gotScaleState)
+			}
+			if tt.wantEvictNotCalled {
+				mockEvictionFallback.AssertNotCalled(t, "Evict", mock.Anything, mock.Anything)
+			}
+		})
+	}
+
+	t.Run("Suppressed", func(t *testing.T) {
+		statusUpdated := false
+		mockEvictionFallback := podtest.NewMockEvictionFallback(evictFunc(true, 0, nil))
+		a := newTargetContainerAction(
+			controllercommon.ControllerConfig{},
+			podtest.NewMockStatusWithRun(
+				func(m *podtest.MockStatus, run func()) { m.UpdateDefaultAndRun(run) },
+				func() { statusUpdated = true },
+			),
+			nil,
+			nil,
+			kubetest.NewMockNamespaceHelper(nil),
+			nil,
+			nil,
+			nil,
+			mockEvictionFallback,
+			nil,
+		)
+
+		err := a.evictionFallbackAction(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).
+				Suppression(controlcommon.NewSuppression(true, false, false)).
+				Build(),
+			podcommon.States{Resize: podcommon.NewResizeState(podcommon.StateResizeInfeasible, "")},
+			newPod("true", true, nil),
+			scaletest.NewMockConfigurations(nil),
+			enactedErr,
+		)
+		assert.NoError(t, err)
+		assert.True(t, statusUpdated)
+		mockEvictionFallback.AssertNotCalled(t, "Evict", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTargetContainerActionCommandedFor(t *testing.T) {
+	a := newTargetContainerAction(controllercommon.ControllerConfig{}, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	t.Run("UnableToGetStatusAnnotation", func(t *testing.T) {
+		_, err := a.commandedFor(&v1.Pod{})
+		assert.ErrorContains(t, err, "unable to get status annotation")
+	})
+
+	t.Run("UnableToParseLastCommanded", func(t *testing.T) {
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			kubecommon.AnnotationStatus: podcommon.NewStatusAnnotation("", podcommon.NewEmptyStatusAnnotationScale(nil), "").Json(),
+		}}}
+
+		_, err := a.commandedFor(pod)
+		assert.ErrorContains(t, err, "unable to parse last commanded time ''")
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		scale := podcommon.NewEmptyStatusAnnotationScale(nil)
+		scale.LastCommanded = time.Now().Add(-time.Minute).UTC().Format(timeFormatMilli)
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			kubecommon.AnnotationStatus: podcommon.NewStatusAnnotation("", scale, "").Json(),
+		}}}
+
+		got, err := a.commandedFor(pod)
+		assert.NoError(t, err)
+		assert.InDelta(t, time.Minute, got, float64(time.Second))
+	})
+}
+
+func TestTargetContainerActionIsSuppressed(t *testing.T) {
+	tests := []struct {
+		name             string
+		suppression      controlcommon.Suppression
+		resources        podcommon.StateResources
+		want             bool
+		wantMetricReason controlcommon.SuppressionReason
+	}{
+		{
+			"NotSuppressed",
+			controlcommon.Suppression{},
+			podcommon.StateResourcesStartup,
+			false,
+			"",
+		},
+		{
+			"NotSuppressedOtherDirection",
+			controlcommon.NewSuppression(false, false, true),
+			podcommon.StateResourcesStartup,
+			false,
+			"",
+		},
+		{
+			"SuppressedKillSwitch",
+			controlcommon.NewSuppression(true, false, false),
+			podcommon.StateResourcesPostStartup,
+			true,
+			controlcommon.SuppressionReasonKillSwitch,
+		},
+		{
+			"SuppressedBlackoutWindow",
+			controlcommon.NewSuppression(false, true, false),
+			podcommon.StateResourcesStartup,
+			true,
+			controlcommon.SuppressionReasonBlackoutWindow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metricsscale.ResetMetrics()
+			statusUpdated := false
+			a := newTargetContainerAction(
+				controllercommon.ControllerConfig{},
+				podtest.NewMockStatusWithRun(
+					func(m *podtest.MockStatus, run func()) { m.UpdateDefaultAndRun(run) },
+					func() { statusUpdated = true },
+				),
+				nil,
+				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			got := a.isSuppressed(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Suppression(tt.suppression).Build(),
+				podcommon.States{},
+				&v1.Pod{},
+				scaletest.NewMockConfigurations(nil),
+				tt.resources,
+			)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want, statusUpdated)
+			if tt.want {
+				value, _ := testutil.GetCounterMetricValue(
+					metricsscale.Suppressed(tt.resources.Direction(), string(tt.wantMetricReason)),
+				)
+				assert.Equal(t, float64(1), value)
+			}
+		})
+	}
+
+	t.Run("PatchNotCalledWhenSuppressed", func(t *testing.T) {
+		mockPodHelper := kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {})
+		a := newTargetContainerAction(
+			controllercommon.ControllerConfig{},
+			podtest.NewMockStatus(nil),
+			mockPodHelper,
+			nil,
+			kubetest.NewMockNamespaceHelper(nil),
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+
+		err := a.notStartedWithPostStartupResAction(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).
+				Suppression(controlcommon.NewSuppression(true, false, false)).
+				Build(),
+			podcommon.States{},
+			&v1.Pod{},
+			&v1.Container{},
+			scaletest.NewMockConfigurations(nil),
+		)
+		assert.NoError(t, err)
+		mockPodHelper.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTargetContainerActionUpscaleGates(t *testing.T) {
+	tests := []struct {
+		name         string
+		quotaUsed    string
+		nodeFree     string
+		reserved     bool
+		ahead        int
+		acquired     bool
+		wantErrAs    error
+		wantCalled   []string
+		wantReleased bool
+	}{
+		{
+			"InsufficientResourceQuota",
+			"9m", "2m", true, 0, true,
+			&InsufficientResourceQuotaError{},
+			[]string{"ResourceQuotas"},
+			false,
+		},
+		{
+			"InsufficientNodeCapacity",
+			"8m", "1m", true, 0, true,
+			&InsufficientNodeCapacityError{},
+			[]string{"ResourceQuotas", "FreeAllocatable"},
+			false,
+		},
+		{
+			"StartupSurplusBudgetExceeded",
+			"8m", "2m", false, 0, true,
+			&StartupSurplusBudgetExceededError{},
+			[]string{"ResourceQuotas", "FreeAllocatable", "Reserve"},
+			false,
+		},
+		{
+			"NodeUpscaleDeferred",
+			"8m", "2m", true, 2, true,
+			&NodeUpscaleDeferredError{},
+			[]string{"ResourceQuotas", "FreeAllocatable", "Reserve", "Ahead"},
+			true,
+		},
+		{
+			"WorkloadStartupLimitReached",
+			"8m", "2m", true, 0, false,
+			&WorkloadStartupLimitReachedError{},
+			[]string{"ResourceQuotas", "FreeAllocatable", "Reserve", "Ahead", "Acquire"},
+			true,
+		},
+		{
+			"AllPass",
+			"8m", "2m", true, 0, true,
+			nil,
+			[]string{"ResourceQuotas", "FreeAllocatable", "Reserve", "Ahead", "Acquire"},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metricsscale.ResetMetrics()
+			mockNamespaceHelper := kubetest.NewMockNamespaceHelper(func(m *kubetest.MockNamespaceHelper) {
+				m.On("ResourceQuotas", mock.Anything, mock.Anything).Return(
+					[]v1.ResourceQuota{{
+						ObjectMeta: metav1.ObjectMeta{Name: "quota"},
+						Status: v1.ResourceQuotaStatus{
+							Hard: v1.ResourceList{"requests.cpu": resource.MustParse("10m")},
+							Used: v1.ResourceList{"requests.cpu": resource.MustParse(tt.quotaUsed)},
+						},
+					}},
+					nil,
+				)
+			})
+			mockNodeHelper := kubetest.NewMockNodeHelper(func(m *kubetest.MockNodeHelper) {
+				m.On("FreeAllocatable", mock.Anything, mock.Anything).
+					Return(v1.ResourceList{v1.ResourceCPU: resource.MustParse(tt.nodeFree)}, nil)
+			})
+			mockStartupSurplus := podtest.NewMockStartupSurplus(func(m *podtest.MockStartupSurplus) {
+				m.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(tt.reserved, v1.ResourceList{}, nil)
+				m.ReleaseDefault()
+			})
+			mockNodeUpscaleQueue := podtest.NewMockNodeUpscaleQueue(func(m *podtest.MockNodeUpscaleQueue) {
+				m.On("Ahead", mock.Anything, mock.Anything).Return(tt.ahead, nil)
+				m.WaitDefault()
+				m.RemoveDefault()
+			})
+			mockWorkloadStartups := podtest.NewMockWorkloadStartups(func(m *podtest.MockWorkloadStartups) {
+				m.On("Limit", mock.Anything, mock.Anything).Return(2, nil)
+				m.On("Acquire", mock.Anything, mock.Anything, mock.Anything).Return(tt.acquired, 2, nil)
+			})
+			a := newTargetContainerAction(
+				controllercommon.ControllerConfig{
+					NodeCapacityStrategy:        controllercommon.NodeCapacityStrategyRequeue,
+					NodeStartupSurplusBudgetCpu: "5m",
+					NodeUpscalePriorityOrdering: true,
+				},
+				podtest.NewMockStatus(nil),
+				nil,
+				mockNodeHelper,
+				mockNamespaceHelper,
+				mockStartupSurplus,
+				mockNodeUpscaleQueue,
+				mockWorkloadStartups,
+				podtest.NewMockEvictionFallback(nil),
+				nil,
+			)
+
+			got, err := a.upscaleGates(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+				podcommon.States{},
+				&v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{kubecommon.AnnotationMaxConcurrentStartups: "2"}},
+					Spec:       v1.PodSpec{NodeName: "node"},
+				},
+				&v1.Container{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1m")},
+						Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("1m")},
+					},
+				},
+				scaletest.NewMockConfigurations(nil),
+			)
+			assert.Equal(t, tt.wantErrAs == nil, got)
+			if tt.wantErrAs != nil {
+				assert.ErrorAs(t, err, tt.wantErrAs)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			called := map[string]bool{}
+			for _, c := range tt.wantCalled {
+				called[c] = true
+			}
+			assertCalled := func(m interface {
+				AssertCalled(mock.TestingT, string, ...interface{}) bool
+				AssertNotCalled(mock.TestingT, string, ...interface{}) bool
+			}, method string, args ...interface{}) {
+				if called[method] {
+					m.AssertCalled(t, method, args...)
+				} else {
+					m.AssertNotCalled(t, method, args...)
+				}
+			}
+			assertCalled(mockNamespaceHelper, "ResourceQuotas", mock.Anything, mock.Anything)
+			assertCalled(mockNodeHelper, "FreeAllocatable", mock.Anything, mock.Anything)
+			assertCalled(mockStartupSurplus, "Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			assertCalled(mockNodeUpscaleQueue, "Ahead", mock.Anything, mock.Anything)
+			assertCalled(mockWorkloadStartups, "Acquire", mock.Anything, mock.Anything, mock.Anything)
+			if tt.wantReleased {
+				mockStartupSurplus.AssertCalled(t, "Release", mock.Anything)
+			} else {
+				mockStartupSurplus.AssertNotCalled(t, "Release", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestTargetContainerActionIsNodeCapacityInsufficient(t *testing.T) {
+	freeFunc := func(cpu string) func(*kubetest.MockNodeHelper) {
+		return func(m *kubetest.MockNodeHelper) {
+			m.On("FreeAllocatable", mock.Anything, mock.Anything).
+				Return(v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)}, nil)
+		}
+	}
+
+	tests := []struct {
+		name                         string
+		strategy                     string
+		nodeName                     string
+		configNodeHelperMockFunc     func(*kubetest.MockNodeHelper)
+		want                         bool
+		wantErr                      bool
+		wantStatusUpdated            bool
+		wantFreeAllocatableNotCalled bool
+	}{
+		{
+			"Disabled",
+			controllercommon.NodeCapacityStrategyDisabled,
+			"node",
+			freeFunc("0"),
+			false,
+			false,
+			false,
+			true,
+		},
+		{
+			"NotScheduled",
+			controllercommon.NodeCapacityStrategySkip,
+			"",
+			freeFunc("0"),
+			false,
+			false,
+			false,
+			true,
+		},
+		{
+			"UnableToDetermineFree",
+			controllercommon.NodeCapacityStrategySkip,
+			"node",
+			func(m *kubetest.MockNodeHelper) {
+				m.On("FreeAllocatable", mock.Anything, mock.Anything).Return(v1.ResourceList(nil), errors.New(""))
+			},
+			false,
+			false,
+			false,
+			false,
+		},
+		{
+			"Sufficient",
+			controllercommon.NodeCapacityStrategySkip,
+			"node",
+			freeFunc("2m"),
+			false,
+			false,
+			false,
+			false,
+		},
+		{
+			"InsufficientSkip",
+			controllercommon.NodeCapacityStrategySkip,
+			"node",
+			freeFunc("1m"),
+			true,
+			false,
+			true,
+			false,
+		},
+		{
+			"InsufficientRequeue",
+			controllercommon.NodeCapacityStrategyRequeue,
+			"node",
+			freeFunc("1m"),
+			true,
+			true,
+			true,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metricsscale.ResetMetrics()
+			statusUpdated := false
+			mockNodeHelper := kubetest.NewMockNodeHelper(tt.configNodeHelperMockFunc)
+			a := newTargetContainerAction(
+				controllercommon.ControllerConfig{NodeCapacityStrategy: tt.strategy},
+				podtest.NewMockStatusWithRun(
+					func(m *podtest.MockStatus, run func()) { m.UpdateDefaultAndRun(run) },
+					func() { statusUpdated = true },
+				),
+				nil,
+				mockNodeHelper,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			got, err := a.isNodeCapacityInsufficient(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+				podcommon.States{},
+				&v1.Pod{Spec: v1.PodSpec{NodeName: tt.nodeName}},
+				&v1.Container{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: kubetest.PodCpuPostStartupRequestsEnabled},
+					},
+				},
+				scaletest.NewMockConfigurations(nil),
+			)
+			assert.Equal(t, tt.want, got)
+			if tt.wantErr {
+				assert.ErrorAs(t, err, &InsufficientNodeCapacityError{})
+				assert.ErrorContains(t, err, "cpu: 2m required, 1m free")
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantStatusUpdated, statusUpdated)
+			if tt.wantFreeAllocatableNotCalled {
+				mockNodeHelper.AssertNotCalled(t, "FreeAllocatable", mock.Anything, mock.Anything)
+			}
+			if tt.want {
+				value, _ := testutil.GetCounterMetricValue(
+					metricsscale.Suppressed(podcommon.StateResourcesStartup.Direction(), suppressedReasonInsufficientNodeCapacity),
+				)
+				assert.Equal(t, float64(1), value)
+			}
+		})
+	}
+
+	t.Run("PatchNotCalledWhenInsufficient", func(t *testing.T) {
+		mockPodHelper := kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {})
+		a := newTargetContainerAction(
+			controllercommon.ControllerConfig{NodeCapacityStrategy: controllercommon.NodeCapacityStrategySkip},
+			podtest.NewMockStatus(nil),
+			mockPodHelper,
+			kubetest.NewMockNodeHelper(freeFunc("0")),
+			kubetest.NewMockNamespaceHelper(nil),
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+
+		err := a.notStartedWithPostStartupResAction(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			podcommon.States{},
+			&v1.Pod{Spec: v1.PodSpec{NodeName: "node"}},
+			&v1.Container{},
+			scaletest.NewMockConfigurations(nil),
+		)
+		assert.NoError(t, err)
+		mockPodHelper.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTargetContainerActionIsResourceQuotaInsufficient(t *testing.T) {
+	quotasFunc := func(used string) func(*kubetest.MockNamespaceHelper) {
+		return func(m *kubetest.MockNamespaceHelper) {
+			m.On("ResourceQuotas", mock.Anything, mock.Anything).Return(
+				[]v1.ResourceQuota{{
+					ObjectMeta: metav1.ObjectMeta{Name: "quota"},
+					Status: v1.ResourceQuotaStatus{
+						Hard: v1.ResourceList{"requests.cpu": resource.MustParse("10m")},
+						Used: v1.ResourceList{"requests.cpu": resource.MustParse(used)},
+					},
+				}},
+				nil,
+			)
+		}
+	}
+
+	tests := []struct {
+		name                          string
+		configNamespaceHelperMockFunc func(*kubetest.MockNamespaceHelper)
+		want                          bool
+	}{
+		{
+			"UnableToGetResourceQuotas",
+			func(m *kubetest.MockNamespaceHelper) {
+				m.On("ResourceQuotas", mock.Anything, mock.Anything).Return([]v1.ResourceQuota{}, errors.New(""))
+			},
+			false,
+		},
+		{"NoResourceQuotas", nil, false},
+		{"Sufficient", quotasFunc("8m"), false},
+		{"Insufficient", quotasFunc("9m"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metricsscale.ResetMetrics()
+			statusUpdated := false
+			a := newTargetContainerAction(
+				controllercommon.ControllerConfig{},
+				podtest.NewMockStatusWithRun(
+					func(m *podtest.MockStatus, run func()) { m.UpdateDefaultAndRun(run) },
+					func() { statusUpdated = true },
+				),
+				nil,
+				nil,
+				kubetest.NewMockNamespaceHelper(tt.configNamespaceHelperMockFunc),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			got, err := a.isResourceQuotaInsufficient(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+				podcommon.States{},
+				&v1.Pod{},
+				&v1.Container{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1m")},
+						Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("1m")},
+					},
+				},
+				scaletest.NewMockConfigurations(nil),
+			)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want, statusUpdated)
+			if tt.want {
+				assert.ErrorAs(t, err, &InsufficientResourceQuotaError{})
+				assert.ErrorContains(t, err, "insufficient resource quota (quota requests.cpu: 2m required, 1m available)")
+				value, _ := testutil.GetCounterMetricValue(
+					metricsscale.Suppressed(podcommon.StateResourcesStartup.Direction(), suppressedReasonInsufficientResourceQuota),
+				)
+				assert.Equal(t, float64(1), value)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTargetContainerActionStartupResourceQuotaExceeded(t *testing.T) {
+	var gotScaleState podcommon.StatusScaleState
+	var gotFailReason string
+	a := newTargetContainerAction(
+		controllercommon.ControllerConfig{},
+		podtest.NewMockStatus(func(m *podtest.MockStatus) {
+			m.On("Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					gotScaleState = args.Get(5).(podcommon.StatusScaleState)
+					gotFailReason = args.Get(7).(string)
+				}).
+				Return(&v1.Pod{}, nil)
+		}),
+		nil,
+		nil,
+		kubetest.NewMockNamespaceHelper(nil),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	err := a.startupResourceQuotaExceeded(
+		contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+		podcommon.States{},
+		&v1.Pod{},
+		scaletest.NewMockConfigurations(nil),
+		kerrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "pod", errors.New("exceeded quota: quota")),
+	)
+	assert.ErrorAs(t, err, &InsufficientResourceQuotaError{})
+	assert.ErrorContains(
+		t,
+		err,
+		"startup resources rejected - resource quota exceeded (pods \"pod\" is forbidden: exceeded quota: quota)",
+	)
+	assert.Equal(t, podcommon.StatusScaleStateUpQuotaExceeded, gotScaleState)
+	assert.Equal(t, failReasonResourceQuota, gotFailReason)
+}
+
+func TestTargetContainerActionIsStartupSurplusBudgetExceeded(t *testing.T) {
+	reserveFunc := func(reserved bool, cpu string) func(*podtest.MockStartupSurplus) {
+		return func(m *podtest.MockStartupSurplus) {
+			m.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(reserved, v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)}, nil)
+		}
+	}
+
+	tests := []struct {
+		name                          string
+		budgetCpu                     string
+		nodeName                      string
+		configStartupSurplusMockFunc  func(*podtest.MockStartupSurplus)
+		want                          bool
+		wantReserveNotCalled          bool
+		wantSurplusBudgetUsageSamples uint64
+	}{
+		{"NoBudget", "", "node", reserveFunc(true, "0"), false, true, 0},
+		{"NotScheduled", "5m", "", reserveFunc(true, "0"), false, true, 0},
+		{
+			"UnableToDetermineUsed",
+			"5m",
+			"node",
+			func(m *podtest.MockStartupSurplus) {
+				m.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, v1.ResourceList(nil), errors.New(""))
+			},
+			false,
+			false,
+			0,
+		},
+		{"WithinBudget", "5m", "node", reserveFunc(true, "3m"), false, false, 1},
+		{"Exceeded", "5m", "node", reserveFunc(false, "4m"), true, false, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metricsscale.ResetMetrics()
+			statusUpdated := false
+			mockStartupSurplus := podtest.NewMockStartupSurplus(tt.configStartupSurplusMockFunc)
+			a := newTargetContainerAction(
+				controllercommon.ControllerConfig{NodeStartupSurplusBudgetCpu: tt.budgetCpu},
+				podtest.NewMockStatusWithRun(
+					func(m *podtest.MockStatus, run func()) { m.UpdateDefaultAndRun(run) },
+					func() { statusUpdated = true },
+				),
+				nil,
+				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				mockStartupSurplus,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			got, err := a.isStartupSurplusBudgetExceeded(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+				podcommon.States{},
+				&v1.Pod{Spec: v1.PodSpec{NodeName: tt.nodeName}},
+				scaletest.NewMockConfigurations(nil),
+			)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want, statusUpdated)
+			if tt.want {
+				assert.ErrorAs(t, err, &StartupSurplusBudgetExceededError{})
+				assert.ErrorContains(t, err, "cpu: 2m required, 4m of 5m used")
+				value, _ := testutil.GetCounterMetricValue(
+					metricsscale.Suppressed(podcommon.StateResourcesStartup.Direction(), suppressedReasonStartupSurplusBudget),
+				)
+				assert.Equal(t, float64(1), value)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantReserveNotCalled {
+				mockStartupSurplus.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+			samples, _ := testutil.GetHistogramMetricCount(metricsscale.SurplusBudgetUsage(string(v1.ResourceCPU)))
+			assert.Equal(t, tt.wantSurplusBudgetUsageSamples, samples)
+		})
+	}
+}
+
+func TestTargetContainerActionIsNodeUpscaleDeferred(t *testing.T) {
+	aheadFunc := func(ahead int, err error) func(*podtest.MockNodeUpscaleQueue) {
+		return func(m *podtest.MockNodeUpscaleQueue) {
+			m.On("Ahead", mock.Anything, mock.Anything).Return(ahead, err)
+			m.WaitDefault()
+			m.RemoveDefault()
+		}
+	}
+
+	tests := []struct {
+		name                           string
+		priorityOrdering               bool
+		nodeName                       string
+		configNodeUpscaleQueueMockFunc func(*podtest.MockNodeUpscaleQueue)
+		want                           bool
+		wantAheadNotCalled             bool
+		wantRemoved                    bool
+	}{
+		{"NotEnabled", false, "node", aheadFunc(1, nil), false, true, false},
+		{"NotScheduled", true, "", aheadFunc(1, nil), false, true, false},
+		{"UnableToDetermineAhead", true, "node", aheadFunc(0, errors.New("")), false, false, true},
+		{"NoneAhead", true, "node", aheadFunc(0, nil), false, false, true},
+		{"Deferred", true, "node", aheadFunc(2, nil), true, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metricsscale.ResetMetrics()
+			statusUpdated := false
+			mockNodeUpscaleQueue := podtest.NewMockNodeUpscaleQueue(tt.configNodeUpscaleQueueMockFunc)
+			a := newTargetContainerAction(
+				controllercommon.ControllerConfig{NodeUpscalePriorityOrdering: tt.priorityOrdering},
+				podtest.NewMockStatusWithRun(
+					func(m *podtest.MockStatus, run func()) { m.UpdateDefaultAndRun(run) },
+					func() { statusUpdated = true },
+				),
+				nil,
+				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				mockNodeUpscaleQueue,
+				nil,
+				nil,
+				nil,
+			)
+
+			got, err := a.isNodeUpscaleDeferred(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+				podcommon.States{},
+				&v1.Pod{Spec: v1.PodSpec{NodeName: tt.nodeName}},
+				scaletest.NewMockConfigurations(nil),
+			)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want, statusUpdated)
+			if tt.want {
+				assert.ErrorAs(t, err, &NodeUpscaleDeferredError{})
+				assert.ErrorContains(t, err, "waiting for 2 pod(s) ranked ahead on node")
+				mockNodeUpscaleQueue.AssertCalled(t, "Wait", mock.Anything)
+				value, _ := testutil.GetCounterMetricValue(
+					metricsscale.Suppressed(podcommon.StateResourcesStartup.Direction(), suppressedReasonNodeUpscaleOrdering),
+				)
+				assert.Equal(t, float64(1), value)
+			} else {
+				assert.NoError(t, err)
+				mockNodeUpscaleQueue.AssertNotCalled(t, "Wait", mock.Anything)
+			}
+			if tt.wantAheadNotCalled {
+				mockNodeUpscaleQueue.AssertNotCalled(t, "Ahead", mock.Anything, mock.Anything)
+			}
+			if tt.wantRemoved {
+				mockNodeUpscaleQueue.AssertCalled(t, "Remove", mock.Anything)
+			} else {
+				mockNodeUpscaleQueue.AssertNotCalled(t, "Remove", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestTargetContainerActionWaitForNodeUpscale(t *testing.T) {
+	t.Run("NotEnabled", func(t *testing.T) {
+		mockNodeUpscaleQueue := podtest.NewMockNodeUpscaleQueue(nil)
+		a := newTargetContainerAction(controllercommon.ControllerConfig{}, nil, nil, nil, kubetest.NewMockNamespaceHelper(nil), nil, mockNodeUpscaleQueue, nil, nil, nil)
+		a.waitForNodeUpscale(&v1.Pod{})
+		mockNodeUpscaleQueue.AssertNotCalled(t, "Wait", mock.Anything)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		mockNodeUpscaleQueue := podtest.NewMockNodeUpscaleQueue(nil)
+		a := newTargetContainerAction(
+			controllercommon.ControllerConfig{NodeUpscalePriorityOrdering: true},
+			nil,
+			nil,
+			nil,
+			kubetest.NewMockNamespaceHelper(nil),
+			nil,
+			mockNodeUpscaleQueue,
+			nil,
+			nil,
+			nil,
+		)
+		a.waitForNodeUpscale(&v1.Pod{})
+		mockNodeUpscaleQueue.AssertCalled(t, "Wait", mock.Anything)
+	})
+}
+
+func TestTargetContainerActionStopWaitingForNodeUpscale(t *testing.T) {
+	t.Run("NotEnabled", func(t *testing.T) {
+		mockNodeUpscaleQueue := podtest.NewMockNodeUpscaleQueue(nil)
+		a := newTargetContainerAction(controllercommon.ControllerConfig{}, nil, nil, nil, kubetest.NewMockNamespaceHelper(nil), nil, mockNodeUpscaleQueue, nil, nil, nil)
+		a.stopWaitingForNodeUpscale(&v1.Pod{})
+		mockNodeUpscaleQueue.AssertNotCalled(t, "Remove", mock.Anything)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		mockNodeUpscaleQueue := podtest.NewMockNodeUpscaleQueue(nil)
+		a := newTargetContainerAction(
+			controllercommon.ControllerConfig{NodeUpscalePriorityOrdering: true},
+			nil,
+			nil,
+			nil,
+			kubetest.NewMockNamespaceHelper(nil),
+			nil,
+			mockNodeUpscaleQueue,
+			nil,
+			nil,
+			nil,
+		)
+		a.stopWaitingForNodeUpscale(&v1.Pod{})
+		mockNodeUpscaleQueue.AssertCalled(t, "Remove", mock.Anything)
+	})
+}
+
+func TestTargetContainerActionExecuteNodeUpscaleOrdering(t *testing.T) {
+	now := time.Now()
+	startedStates := podcommon.States{
+		StartupProbe:    podcommon.StateBoolTrue,
+		ReadinessProbe:  podcommon.StateBoolTrue,
+		Container:       podcommon.StateContainerRunning,
+		Started:         podcommon.StateBoolTrue,
+		Ready:           podcommon.StateBoolTrue,
+		Resources:       podcommon.StateResourcesPostStartup,
+		Resize:          podcommon.NewResizeState(podcommon.StateResizeNotStartedOrCompleted, ""),
+		StatusResources: podcommon.StateStatusResourcesContainerResourcesMatch,
+	}
+	paused := nodeUpscalePod("high", 10, now)
+	paused.Annotations = map[string]string{kubecommon.AnnotationPaused: "true"}
+
+	tests := []struct {
+		name       string
+		highStates podcommon.States
+		highPod    *v1.Pod
+	}{
+		{"HighPriorityWaiterStartsOnPostStartupResources", startedStates, nodeUpscalePod("high", 10, now)},
+		{"HighPriorityWaiterPaused", podcommon.States{StartupProbe: podcommon.StateBoolTrue, ReadinessProbe: podcommon.StateBoolTrue}, paused},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := newNodeUpscaleQueue(
+				controllercommon.ControllerConfig{RequeueDurationSecs: 10},
+				kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {
+					m.On("Get", mock.Anything, mock.Anything).Return(true, tt.highPod, nil)
+				}),
+			)
+			a := newTargetContainerAction(
+				controllercommon.ControllerConfig{NodeUpscalePriorityOrdering: true},
+				podtest.NewMockStatus(func(m *podtest.MockStatus) { m.UpdateDefault() }),
+				kubetest.NewMockPodHelper(nil),
+				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				queue,
+				nil,
+				nil,
+				nil,
+			)
+			ctx := contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build()
+			low := nodeUpscalePod("low", 0, now)
+			queue.Wait(tt.highPod)
+
+			deferred, err := a.isNodeUpscaleDeferred(ctx, podcommon.States{}, low, scaletest.NewMockConfigurations(nil))
+			assert.True(t, deferred)
+			assert.ErrorAs(t, err, &NodeUpscaleDeferredError{})
+
+			err = a.Execute(ctx, tt.highStates, tt.highPod, &v1.Container{}, scaletest.NewMockConfigurations(nil))
+			assert.NoError(t, err)
+
+			deferred, err = a.isNodeUpscaleDeferred(ctx, podcommon.States{}, low, scaletest.NewMockConfigurations(nil))
+			assert.False(t, deferred)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTargetContainerActionIsWorkloadStartupLimitReached(t *testing.T) {
+	workloadStartupsFunc := func(limit int, limitErr error, acquired bool, acquireErr error) func(*podtest.MockWorkloadStartups) {
+		return func(m *podtest.MockWorkloadStartups) {
+			m.On("Limit", mock.Anything, mock.Anything).Return(limit, limitErr)
+			m.On("Acquire", mock.Anything, mock.Anything, mock.Anything).Return(acquired, 2, acquireErr)
+		}
+	}
+
+	tests := []struct {
+		name                           string
+		configWorkloadStartupsMockFunc func(*podtest.MockWorkloadStartups)
+		want                           bool
+		wantAcquireNotCalled           bool
+	}{
+		{"NoLimit", workloadStartupsFunc(0, nil, false, nil), false, true},
+		{"UnableToDetermineLimit", workloadStartupsFunc(0, errors.New(""), false, nil), false, true},
+		{"UnableToAcquire", workloadStartupsFunc(2, nil, false, errors.New("")), false, false},
+		{"Acquired", workloadStartupsFunc(2, nil, true, nil), false, false},
+		{"Reached", workloadStartupsFunc(2, nil, false, nil), true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metricsscale.ResetMetrics()
+			statusUpdated := false
+			mockWorkloadStartups := podtest.NewMockWorkloadStartups(tt.configWorkloadStartupsMockFunc)
+			a := newTargetContainerAction(
+				controllercommon.ControllerConfig{},
+				podtest.NewMockStatusWithRun(
+					func(m *podtest.MockStatus, run func()) { m.UpdateDefaultAndRun(run) },
+					func() { statusUpdated = true },
+				),
+				nil,
+				nil,
+				kubetest.NewMockNamespaceHelper(nil),
+				nil,
+				nil,
+				mockWorkloadStartups,
+				podtest.NewMockEvictionFallback(nil),
+				nil,
+			)
+
+			got, err := a.isWorkloadStartupLimitReached(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+				podcommon.States{},
+				&v1.Pod{},
+				scaletest.NewMockConfigurations(nil),
+			)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want, statusUpdated)
+			if tt.want {
+				assert.ErrorAs(t, err, &WorkloadStartupLimitReachedError{})
+				assert.ErrorContains(t, err, "waiting for workload startup slot (2/2 in use)")
+				value, _ := testutil.GetCounterMetricValue(
+					metricsscale.Suppressed(podcommon.StateResourcesStartup.Direction(), suppressedReasonWorkloadStartupLimit),
+				)
+				assert.Equal(t, float64(1), value)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantAcquireNotCalled {
+				mockWorkloadStartups.AssertNotCalled(t, "Acquire", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestTargetContainerActionIsReconfigured(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{
+			"NoStatusAnnotation",
+			nil,
+			false,
+		},
+		{
+			"UnableToGetStatusAnnotationFromString",
+			map[string]string{kubecommon.AnnotationStatus: "test"},
+			false,
+		},
+		{
+			"NoLastAppliedConfiguration",
+			map[string]string{kubecommon.AnnotationStatus: reconfiguredStatusAnnotationString("")},
+			false,
+		},
+		{
+			"SameConfiguration",
+			map[string]string{kubecommon.AnnotationStatus: reconfiguredStatusAnnotationString("test")},
+			false,
+		},
+		{
+			"DifferentConfiguration",
+			map[string]string{kubecommon.AnnotationStatus: reconfiguredStatusAnnotationString("previous")},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTargetContainerAction(controllercommon.ControllerConfig{}, nil, nil, nil, kubetest.NewMockNamespaceHelper(nil), nil, nil, nil, nil, nil)
+			mockScaleConfigs := scaletest.NewMockConfigurations(func(m *scaletest.MockConfigurations) {
+				m.On("String").Return("test")
+			})
+
+			got := a.isReconfigured(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+				&v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}},
+				mockScaleConfigs,
+			)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTargetContainerActionContainerResourceConfig(t *testing.T) {
+	a := newTargetContainerAction(
+		controllercommon.ControllerConfig{},
+		nil,
+		nil,
+		nil,
+		kubetest.NewMockNamespaceHelper(nil),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	mockContainer := kubetest.NewContainerBuilder().Build()
+	mockScaleConfigs := scaletest.NewMockConfigurations(func(m *scaletest.MockConfigurations) {
+		m.On("String").Return("test")
+	})
+	got := a.containerResourceConfig(mockContainer, mockScaleConfigs)
+	assert.Contains(t, got, "target container resources: [")
+	assert.Contains(t, got, "], configurations: [test]")
+}
+
+func TestTargetContainerActionUpdateStatus(t *testing.T) {
+	t.Run("UnableToUpdateStatus", func(t *testing.T) {
+		mockStatus := podtest.NewMockStatus(func(m *podtest.MockStatus) {
+			m.On("Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(&v1.Pod{}, errors.New(""))
+		})
+		a := newTargetContainerAction(
+			controllercommon.ControllerConfig{},
+			mockStatus,
+			nil,
+			nil,
+			kubetest.NewMockNamespaceHelper(nil),
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+
+		buffer := bytes.Buffer{}
+		a.updateStatus(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(&buffer)).Build(),
+			&v1.Pod{},
+			"",
+			podcommon.States{},
+			podcommon.StatusScaleStateNotApplicable,
+			scaletest.NewMockConfigurations(nil),
+			"",
+		)
+		assert.Contains(t, buffer.String(), "unable to update status")
+	})
+}
+
+func TestTargetContainerActionUpdateStatusAndLogInfo(t *testing.T) {
+	tests := []struct {
+		name       string
+		dryRun     bool
+		scaleState podcommon.StatusScaleState
+		wantMsg    string
+	}{
+		{"NotDryRun", false, podcommon.StatusScaleStateUpCommanded, "test"},
+		{"DryRunNotCommanded", true, podcommon.StatusScaleStateUpEnacted, "test"},
+		{"DryRunCommanded", true, podcommon.StatusScaleStateUpCommanded, "test (dry run)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStatus := podtest.NewMockStatus(nil)
+			a := newTargetContainerAction(controllercommon.ControllerConfig{}, mockStatus, nil, nil, kubetest.NewMockNamespaceHelper(nil), nil, nil, nil, nil, nil)
+
+			buffer := bytes.Buffer{}
+			a.updateStatusAndLogInfo(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(&buffer)).DryRun(tt.dryRun).Build(),
+				logging.VInfo,
+				&v1.Pod{},
+				"test",
+				podcommon.States{},
+				tt.scaleState,
+				scaletest.NewMockConfigurations(nil),
+				"",
+			)
+			mockStatus.AssertCalled(
+				t, "Update", mock.Anything, mock.Anything, mock.Anything, tt.wantMsg,
+				mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			)
+			assert.Contains(t, buffer.String(), tt.wantMsg)
+		})
+	}
+}
+
+func reconfiguredStatusAnnotationString(lastAppliedConfiguration string) string {
+	return podcommon.NewStatusAnnotation(
+		"test",
+		podcommon.NewStatusAnnotationScale([]v1.ResourceName{v1.ResourceCPU}, "", "", "", lastAppliedConfiguration, "", false, "", false),
+		"",
+	).Json()
 }
@@ -33,7 +33,7 @@ import (
 )
 
 func TestNewTargetContainerState(t *testing.T) {
-	pHelper := kube.NewPodHelper(nil)
+	pHelper := kube.NewPodHelper(nil, kube.NewDisabledCircuitBreaker())
 	cHelper := kube.NewContainerHelper()
 	state := newTargetContainerState(pHelper, cHelper)
 	expected := targetContainerState{
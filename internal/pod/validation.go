@@ -19,6 +19,8 @@ package pod
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/event/eventcommon"
 	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
@@ -33,6 +35,7 @@ type validation struct {
 	status            podcommon.Status
 	podHelper         kubecommon.PodHelper
 	containerHelper   kubecommon.ContainerHelper
+	namespaceHelper   kubecommon.NamespaceHelper
 	podEventPublisher eventcommon.PodEventPublisher
 }
 
@@ -40,12 +43,14 @@ func newValidation(
 	status podcommon.Status,
 	podHelper kubecommon.PodHelper,
 	containerHelper kubecommon.ContainerHelper,
+	namespaceHelper kubecommon.NamespaceHelper,
 	podEventPublisher eventcommon.PodEventPublisher,
 ) *validation {
 	return &validation{
 		status:            status,
 		podHelper:         podHelper,
 		containerHelper:   containerHelper,
+		namespaceHelper:   namespaceHelper,
 		podEventPublisher: podEventPublisher,
 	}
 }
@@ -79,6 +84,33 @@ func (v *validation) Validate(
 		}
 	}
 
+	// Ensure any override is valid.
+	if _, err = overrideFor(pod); err != nil {
+		return nil, v.updateStatusAndGetError(ctx, pod, err.Error(), nil, scaleConfigs)
+	}
+
+	// Ensure any dry-run annotation is valid.
+	if value, present := pod.Annotations[kubecommon.AnnotationDryRun]; present {
+		if _, err = strconv.ParseBool(value); err != nil {
+			return nil, v.updateStatusAndGetError(
+				ctx, pod,
+				fmt.Sprintf("unable to parse '%s' annotation value ('%s')", kubecommon.AnnotationDryRun, value),
+				err,
+				scaleConfigs,
+			)
+		}
+	}
+
+	// Ensure any max concurrent startups annotation is valid.
+	if _, err = maxConcurrentStartupsFor(pod); err != nil {
+		return nil, v.updateStatusAndGetError(ctx, pod, err.Error(), nil, scaleConfigs)
+	}
+
+	// Ensure any eviction fallback annotation is valid.
+	if _, err = evictionFallbackFor(pod); err != nil {
+		return nil, v.updateStatusAndGetError(ctx, pod, err.Error(), nil, scaleConfigs)
+	}
+
 	// Ensure target container is within pod spec.
 	if !v.podHelper.IsContainerInSpec(pod, targetContainerName) {
 		return nil, v.updateStatusAndGetError(ctx, pod, "target container not in pod spec", nil, scaleConfigs)
@@ -109,6 +141,20 @@ func (v *validation) Validate(
 		return nil, v.updateStatusAndGetError(ctx, pod, err.Error(), nil, scaleConfigs)
 	}
 
+	// Ensure startup and post-startup resources are within the constraints of any limit ranges, which would otherwise
+	// cause resizes to be rejected. Limit ranges that can't be determined are left for the Kube API to enforce.
+	limitRanges, err := v.namespaceHelper.LimitRanges(ctx, pod.Namespace)
+	if err != nil {
+		logging.Errorf(ctx, err, "unable to get limit ranges (will not validate against them)")
+	} else if violations := limitRangeViolations(limitRanges, pod, targetContainerName, scaleConfigs); len(violations) > 0 {
+		return nil, v.updateStatusAndGetError(
+			ctx, pod,
+			fmt.Sprintf("resources not within limit range constraints (%s)", strings.Join(violations, ", ")),
+			nil,
+			scaleConfigs,
+		)
+	}
+
 	return ctr, nil
 }
 
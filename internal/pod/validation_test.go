@@ -31,19 +31,23 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 )
 
 func TestNewValidation(t *testing.T) {
-	podHelper := kube.NewPodHelper(nil)
+	podHelper := kube.NewPodHelper(nil, kube.NewDisabledCircuitBreaker())
 	containerHelper := kube.NewContainerHelper()
-	stat := newStatus(&record.FakeRecorder{}, podHelper)
+	stat := newStatus(&record.FakeRecorder{}, podHelper, nil)
+	namespaceHelper := kube.NewNamespaceHelper(nil)
 	publisher := event.DefaultPodEventPublisher
-	val := newValidation(stat, podHelper, containerHelper, publisher)
+	val := newValidation(stat, podHelper, containerHelper, namespaceHelper, publisher)
 	expected := &validation{
 		status:            stat,
 		podHelper:         podHelper,
 		containerHelper:   containerHelper,
+		namespaceHelper:   namespaceHelper,
 		podEventPublisher: publisher,
 	}
 	assert.Equal(t, expected, val)
@@ -241,6 +245,7 @@ func TestValidationValidate(t *testing.T) {
 				podtest.NewMockStatusWithRun(tt.configStatusMockFunc, run),
 				kubetest.NewMockPodHelper(tt.configPodHelperMockFunc),
 				kubetest.NewMockContainerHelper(tt.configContHelperMockFunc),
+				kubetest.NewMockNamespaceHelper(nil),
 				nil,
 			)
 			configs := scaletest.NewMockConfigurations(tt.configScaleConfigsMockFunc)
@@ -271,6 +276,209 @@ func TestValidationValidate(t *testing.T) {
 	}
 }
 
+func TestValidationValidateInvalidOverride(t *testing.T) {
+	statusUpdated := false
+	v := newValidation(
+		podtest.NewMockStatusWithRun(
+			func(m *podtest.MockStatus, run func()) { m.UpdateDefaultAndRun(run) },
+			func() { statusUpdated = true },
+		),
+		kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {
+			m.On("HasAnnotation", mock.Anything, mock.Anything).Return(false, "")
+			m.ExpectedLabelValueAsDefault()
+		}),
+		kubetest.NewMockContainerHelper(nil),
+		kubetest.NewMockNamespaceHelper(nil),
+		nil,
+	)
+
+	container, err := v.Validate(
+		contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+		kubetest.NewPodBuilder().AdditionalAnnotations(map[string]string{kubecommon.AnnotationForceState: "test"}).Build(),
+		"",
+		scaletest.NewMockConfigurations(nil),
+	)
+	assert.True(t, errors.As(err, &validationError{}))
+	assert.ErrorContains(t, err, "'csa.expediagroup.com/force-state' annotation value must be 'startup' or 'post-startup'")
+	assert.Nil(t, container)
+	assert.True(t, statusUpdated)
+}
+
+func TestValidationValidateInvalidDryRun(t *testing.T) {
+	statusUpdated := false
+	v := newValidation(
+		podtest.NewMockStatusWithRun(
+			func(m *podtest.MockStatus, run func()) { m.UpdateDefaultAndRun(run) },
+			func() { statusUpdated = true },
+		),
+		kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {
+			m.On("HasAnnotation", mock.Anything, mock.Anything).Return(false, "")
+			m.ExpectedLabelValueAsDefault()
+		}),
+		kubetest.NewMockContainerHelper(nil),
+		kubetest.NewMockNamespaceHelper(nil),
+		nil,
+	)
+
+	container, err := v.Validate(
+		contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+		kubetest.NewPodBuilder().AdditionalAnnotations(map[string]string{kubecommon.AnnotationDryRun: "test"}).Build(),
+		"",
+		scaletest.NewMockConfigurations(nil),
+	)
+	assert.True(t, errors.As(err, &validationError{}))
+	assert.ErrorContains(t, err, "unable to parse 'csa.expediagroup.com/dry-run' annotation value ('test')")
+	assert.Nil(t, container)
+	assert.True(t, statusUpdated)
+}
+
+func TestValidationValidateInvalidMaxConcurrentStartups(t *testing.T) {
+	statusUpdated := false
+	v := newValidation(
+		podtest.NewMockStatusWithRun(
+			func(m *podtest.MockStatus, run func()) { m.UpdateDefaultAndRun(run) },
+			func() { statusUpdated = true },
+		),
+		kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {
+			m.On("HasAnnotation", mock.Anything, mock.Anything).Return(false, "")
+			m.ExpectedLabelValueAsDefault()
+		}),
+		kubetest.NewMockContainerHelper(nil),
+		kubetest.NewMockNamespaceHelper(nil),
+		nil,
+	)
+
+	container, err := v.Validate(
+		contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+		kubetest.NewPodBuilder().
+			AdditionalAnnotations(map[string]string{kubecommon.AnnotationMaxConcurrentStartups: "test"}).
+			Build(),
+		"",
+		scaletest.NewMockConfigurations(nil),
+	)
+	assert.True(t, errors.As(err, &validationError{}))
+	assert.ErrorContains(t, err, "'csa.expediagroup.com/max-concurrent-startups' annotation value must be a positive integer ('test')")
+	assert.Nil(t, container)
+	assert.True(t, statusUpdated)
+}
+
+func TestValidationValidateInvalidEvictionFallback(t *testing.T) {
+	statusUpdated := false
+	v := newValidation(
+		podtest.NewMockStatusWithRun(
+			func(m *podtest.MockStatus, run func()) { m.UpdateDefaultAndRun(run) },
+			func() { statusUpdated = true },
+		),
+		kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {
+			m.On("HasAnnotation", mock.Anything, mock.Anything).Return(false, "")
+			m.ExpectedLabelValueAsDefault()
+		}),
+		kubetest.NewMockContainerHelper(nil),
+		kubetest.NewMockNamespaceHelper(nil),
+		nil,
+	)
+
+	container, err := v.Validate(
+		contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+		kubetest.NewPodBuilder().
+			AdditionalAnnotations(map[string]string{kubecommon.AnnotationEvictionFallback: "test"}).
+			Build(),
+		"",
+		scaletest.NewMockConfigurations(nil),
+	)
+	assert.True(t, errors.As(err, &validationError{}))
+	assert.ErrorContains(t, err, "'csa.expediagroup.com/eviction-fallback' annotation value must be a boolean ('test')")
+	assert.Nil(t, container)
+	assert.True(t, statusUpdated)
+}
+
+func TestValidationValidateLimitRanges(t *testing.T) {
+	tests := []struct {
+		name                          string
+		configNamespaceHelperMockFunc func(*kubetest.MockNamespaceHelper)
+		wantErrMsg                    string
+	}{
+		{
+			"UnableToGetLimitRanges",
+			func(m *kubetest.MockNamespaceHelper) {
+				m.On("LimitRanges", mock.Anything, mock.Anything).Return([]v1.LimitRange{}, errors.New(""))
+			},
+			"",
+		},
+		{
+			"NotWithinLimitRange",
+			func(m *kubetest.MockNamespaceHelper) {
+				m.On("LimitRanges", mock.Anything, mock.Anything).Return(
+					[]v1.LimitRange{{
+						ObjectMeta: metav1.ObjectMeta{Name: "limitrange"},
+						Spec: v1.LimitRangeSpec{Limits: []v1.LimitRangeItem{{
+							Type: v1.LimitTypeContainer,
+							Max:  v1.ResourceList{v1.ResourceCPU: resource.MustParse("2m")},
+						}}},
+					}},
+					nil,
+				)
+			},
+			"resources not within limit range constraints " +
+				"(cpu startup requests 3m above max 2m (limit range 'limitrange' container), " +
+				"cpu startup limits 3m above max 2m (limit range 'limitrange' container))",
+		},
+		{
+			"WithinLimitRange",
+			func(m *kubetest.MockNamespaceHelper) {
+				m.On("LimitRanges", mock.Anything, mock.Anything).Return(
+					[]v1.LimitRange{{
+						ObjectMeta: metav1.ObjectMeta{Name: "limitrange"},
+						Spec: v1.LimitRangeSpec{Limits: []v1.LimitRangeItem{{
+							Type: v1.LimitTypeContainer,
+							Max:  v1.ResourceList{v1.ResourceCPU: resource.MustParse("3m")},
+						}}},
+					}},
+					nil,
+				)
+			},
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statusUpdated := false
+			v := newValidation(
+				podtest.NewMockStatusWithRun(
+					func(m *podtest.MockStatus, run func()) { m.UpdateDefaultAndRun(run) },
+					func() { statusUpdated = true },
+				),
+				kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {
+					m.On("HasAnnotation", mock.Anything, mock.Anything).Return(false, "")
+					m.ExpectedLabelValueAsDefault()
+					m.IsContainerInSpecDefault()
+					m.QOSClassDefault()
+				}),
+				kubetest.NewMockContainerHelper(nil),
+				kubetest.NewMockNamespaceHelper(tt.configNamespaceHelperMockFunc),
+				nil,
+			)
+
+			container, err := v.Validate(
+				contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+				&v1.Pod{},
+				"",
+				scaletest.NewMockConfigurations(nil),
+			)
+			if tt.wantErrMsg != "" {
+				assert.True(t, errors.As(err, &validationError{}))
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+				assert.Nil(t, container)
+				assert.True(t, statusUpdated)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, container)
+				assert.False(t, statusUpdated)
+			}
+		})
+	}
+}
+
 func TestValidationUpdateStatusAndGetError(t *testing.T) {
 	t.Run("UnableToUpdateStatus", func(t *testing.T) {
 		configStatusMockFunc := func(m *podtest.MockStatus) {
@@ -282,6 +490,7 @@ func TestValidationUpdateStatusAndGetError(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
 		)
 
 		buffer := &bytes.Buffer{}
@@ -0,0 +1,212 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// workloadStartupPendingPeriod is the period after acquisition during which a pod is counted as a holder regardless of
+// its status annotation, allowing time for the annotation to reflect that startup resources are held.
+const workloadStartupPendingPeriod = 30 * time.Second
+
+// workloadStartups is the default implementation of podcommon.WorkloadStartups. The pods of a workload that hold startup
+// resources are derived from their status annotations upon each acquisition, so are consistent across replicas (e.g.
+// when sharding) and leader changes. Acquisitions are also recorded in memory for workloadStartupPendingPeriod, until
+// status annotations reflect them.
+type workloadStartups struct {
+	podHelper kubecommon.PodHelper
+	apiReader client.Reader
+	now       func() time.Time
+
+	mutex sync.Mutex
+	// pending is per replica: when sharding, pods of the same workload may be reconciled by different replicas, each of
+	// which may grant the last slot to one of its pods before either pod's status annotation reflects it. The limit
+	// may therefore be briefly exceeded by up to one pod per additional replica.
+	pending map[string]map[types.NamespacedName]time.Time
+}
+
+func newWorkloadStartups(podHelper kubecommon.PodHelper, apiReader client.Reader) *workloadStartups {
+	return &workloadStartups{
+		podHelper: podHelper,
+		apiReader: apiReader,
+		now:       time.Now,
+		pending:   map[string]map[types.NamespacedName]time.Time{},
+	}
+}
+
+// Limit returns the maximum number of pods of the supplied pod's workload that may concurrently hold startup
+// resources, or 0 if not limited. The limit is read from the annotations of the workload (e.g. the Deployment or
+// StatefulSet) if present there, otherwise from those of the pod. Only workloads within the apps API group are
+// examined, since others may not be readable.
+func (w *workloadStartups) Limit(ctx context.Context, pod *v1.Pod) (int, error) {
+	key := workloadKeyFor(pod)
+	if key == "" {
+		return maxConcurrentStartupsFor(pod)
+	}
+
+	gv, err := schema.ParseGroupVersion(metav1.GetControllerOf(pod).APIVersion)
+	if err != nil || gv.Group != appsv1.GroupName {
+		return maxConcurrentStartupsFor(pod)
+	}
+
+	// Keys are of the form namespace/kind/name.
+	keyParts := strings.Split(key, "/")
+	workload := &metav1.PartialObjectMetadata{}
+	workload.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind(keyParts[1]))
+	err = w.apiReader.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: keyParts[2]}, workload)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return 0, common.WrapErrorf(err, "unable to get workload")
+	}
+
+	if _, present := workload.Annotations[kubecommon.AnnotationMaxConcurrentStartups]; err == nil && present {
+		return maxConcurrentStartupsFor(workload)
+	}
+
+	return maxConcurrentStartupsFor(pod)
+}
+
+// Acquire returns whether the supplied pod may hold startup resources without exceeding limit pods of its workload
+// doing so concurrently, along with the number of the workload's pods that currently hold them. The pod is recorded as
+// pending if acquired. Pods that aren't controlled by a workload are always acquired.
+func (w *workloadStartups) Acquire(ctx context.Context, pod *v1.Pod, limit int) (bool, int, error) {
+	key := workloadKeyFor(pod)
+	if key == "" {
+		return true, 0, nil
+	}
+
+	// Listed outside the lock since pods may be listed from the Kube API.
+	pods, err := w.podHelper.List(ctx, pod.Namespace)
+	if err != nil {
+		return false, 0, common.WrapErrorf(err, "unable to list workload pods")
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	name := types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
+	holders := w.holders(key, pods)
+	if holders[name] {
+		return true, len(holders), nil
+	}
+
+	if len(holders) >= limit {
+		return false, len(holders), nil
+	}
+
+	if w.pending[key] == nil {
+		w.pending[key] = map[types.NamespacedName]time.Time{}
+	}
+	w.pending[key][name] = w.now()
+	return true, len(holders) + 1, nil
+}
+
+// holders returns the pods with the supplied workload key that hold startup resources: those within the supplied pods
+// whose status annotation indicates so, and those pending. Pending pods that no longer exist, or that were acquired
+// longer than workloadStartupPendingPeriod ago, are removed.
+func (w *workloadStartups) holders(key string, pods []v1.Pod) map[types.NamespacedName]bool {
+	ret := map[types.NamespacedName]bool{}
+	exists := map[types.NamespacedName]bool{}
+
+	for i := range pods {
+		name := types.NamespacedName{Namespace: pods[i].Namespace, Name: pods[i].Name}
+		exists[name] = true
+		if workloadKeyFor(&pods[i]) == key && holdsStartupResources(&pods[i]) {
+			ret[name] = true
+		}
+	}
+
+	pending := w.pending[key]
+	for name, acquired := range pending {
+		if !exists[name] || w.now().Sub(acquired) >= workloadStartupPendingPeriod {
+			delete(pending, name)
+			continue
+		}
+
+		ret[name] = true
+	}
+
+	if len(pending) == 0 {
+		delete(w.pending, key)
+	}
+
+	return ret
+}
+
+// maxConcurrentStartupsFor returns the maximum number of pods of a workload that may concurrently hold startup
+// resources, as indicated by the annotations of the supplied object (a pod or its workload). Returns 0 if not limited.
+func maxConcurrentStartupsFor(obj metav1.Object) (int, error) {
+	value, present := obj.GetAnnotations()[kubecommon.AnnotationMaxConcurrentStartups]
+	if !present {
+		return 0, nil
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 {
+		return 0, fmt.Errorf(
+			"'%s' annotation value must be a positive integer ('%s')",
+			kubecommon.AnnotationMaxConcurrentStartups, value,
+		)
+	}
+
+	return limit, nil
+}
+
+// workloadKeyFor returns the key of the workload that controls the supplied pod, or an empty string if not controlled.
+// Pods controlled by a Deployment's ReplicaSet are keyed by the Deployment so that limits apply across rollouts.
+func workloadKeyFor(pod *v1.Pod) string {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return ""
+	}
+
+	kind, name := owner.Kind, owner.Name
+	if hash, hasHash := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; kind == "ReplicaSet" && hasHash {
+		if deploymentName, isTrimmed := strings.CutSuffix(name, "-"+hash); isTrimmed {
+			kind, name = "Deployment", deploymentName
+		}
+	}
+
+	return fmt.Sprintf("%s/%s/%s", pod.Namespace, kind, name)
+}
+
+// holdsStartupResources returns whether the status annotation of the supplied pod indicates that startup resources are
+// held.
+func holdsStartupResources(pod *v1.Pod) bool {
+	stat, err := podcommon.StatusAnnotationFromString(pod.Annotations[kubecommon.AnnotationStatus])
+	if err != nil {
+		return false
+	}
+
+	return stat.Scale.StartupResourcesHeld
+}
@@ -0,0 +1,437 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/context/contexttest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubetest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/pod/podcommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestNewWorkloadStartups(t *testing.T) {
+	podHelper := kube.NewPodHelper(nil, kube.NewDisabledCircuitBreaker())
+	apiReader := fake.NewClientBuilder().Build()
+	startups := newWorkloadStartups(podHelper, apiReader)
+	assert.Equal(t, podHelper, startups.podHelper)
+	assert.Equal(t, apiReader, startups.apiReader)
+	assert.NotNil(t, startups.now)
+	assert.Empty(t, startups.pending)
+}
+
+func TestWorkloadStartupsLimit(t *testing.T) {
+	isController := true
+	limitAnnotations := func(value string) map[string]string {
+		return map[string]string{kubecommon.AnnotationMaxConcurrentStartups: value}
+	}
+	newPod := func(owner *metav1.OwnerReference) *v1.Pod {
+		pod := workloadStartupsPod("pod", false)
+		pod.Annotations[kubecommon.AnnotationMaxConcurrentStartups] = "2"
+		pod.OwnerReferences = nil
+		if owner != nil {
+			pod.OwnerReferences = []metav1.OwnerReference{*owner}
+		}
+		return pod
+	}
+	replicaSetOwner := &metav1.OwnerReference{
+		APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "deployment-abc", Controller: &isController,
+	}
+	deployment := func(annotations map[string]string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "deployment", Annotations: annotations},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		pod        *v1.Pod
+		objects    []client.Object
+		getErr     error
+		want       int
+		wantErrMsg string
+		wantGotten bool
+	}{
+		{"NotControlled", newPod(nil), nil, nil, 2, "", false},
+		{
+			"NotAppsWorkload",
+			newPod(&metav1.OwnerReference{
+				APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout", Name: "rollout", Controller: &isController,
+			}),
+			nil,
+			nil,
+			2,
+			"",
+			false,
+		},
+		{"UnableToGetWorkload", newPod(replicaSetOwner), nil, errors.New(""), 0, "unable to get workload", true},
+		{"WorkloadNotFound", newPod(replicaSetOwner), nil, nil, 2, "", true},
+		{"WorkloadNotAnnotated", newPod(replicaSetOwner), []client.Object{deployment(nil)}, nil, 2, "", true},
+		{
+			"WorkloadAnnotationInvalid",
+			newPod(replicaSetOwner),
+			[]client.Object{deployment(limitAnnotations("test"))},
+			nil,
+			0,
+			"'" + kubecommon.AnnotationMaxConcurrentStartups + "' annotation value must be a positive integer ('test')",
+			true,
+		},
+		{"DeploymentAnnotated", newPod(replicaSetOwner), []client.Object{deployment(limitAnnotations("4"))}, nil, 4, "", true},
+		{
+			"StatefulSetAnnotated",
+			newPod(&metav1.OwnerReference{
+				APIVersion: "apps/v1", Kind: "StatefulSet", Name: "statefulset", Controller: &isController,
+			}),
+			[]client.Object{&appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "namespace",
+					Name:        "statefulset",
+					Annotations: limitAnnotations("5"),
+				},
+			}},
+			nil,
+			5,
+			"",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotten := false
+			apiReader := fake.NewClientBuilder().
+				WithObjects(tt.objects...).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Get: func(
+						ctx context.Context,
+						c client.WithWatch,
+						key client.ObjectKey,
+						obj client.Object,
+						opts ...client.GetOption,
+					) error {
+						gotten = true
+						if tt.getErr != nil {
+							return tt.getErr
+						}
+						return c.Get(ctx, key, obj, opts...)
+					},
+				}).
+				Build()
+			w := newWorkloadStartups(nil, apiReader)
+
+			got, err := w.Limit(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), tt.pod)
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantGotten, gotten)
+		})
+	}
+}
+
+func TestWorkloadStartupsAcquire(t *testing.T) {
+	now := time.Now()
+	nowFunc := func() time.Time { return now }
+	key := "namespace/Deployment/deployment"
+	name := func(podName string) types.NamespacedName {
+		return types.NamespacedName{Namespace: "namespace", Name: podName}
+	}
+	listFunc := func(pods ...v1.Pod) func(*kubetest.MockPodHelper) {
+		return func(m *kubetest.MockPodHelper) {
+			m.On("List", mock.Anything, "namespace").Return(pods, nil)
+		}
+	}
+
+	t.Run("NotControlled", func(t *testing.T) {
+		mockPodHelper := kubetest.NewMockPodHelper(nil)
+		w := newWorkloadStartups(mockPodHelper, nil)
+
+		acquired, inUse, err := w.Acquire(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(), &v1.Pod{}, 1)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+		assert.Equal(t, 0, inUse)
+		mockPodHelper.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+	})
+
+	t.Run("UnableToList", func(t *testing.T) {
+		w := newWorkloadStartups(kubetest.NewMockPodHelper(func(m *kubetest.MockPodHelper) {
+			m.On("List", mock.Anything, mock.Anything).Return([]v1.Pod(nil), errors.New(""))
+		}), nil)
+
+		acquired, _, err := w.Acquire(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod1", false),
+			1,
+		)
+		assert.ErrorContains(t, err, "unable to list workload pods")
+		assert.False(t, acquired)
+		assert.Empty(t, w.pending)
+	})
+
+	t.Run("Acquired", func(t *testing.T) {
+		other := *workloadStartupsPod("other", true)
+		other.OwnerReferences[0].Name = "other-abc"
+		w := newWorkloadStartups(kubetest.NewMockPodHelper(listFunc(
+			*workloadStartupsPod("pod1", true),
+			*workloadStartupsPod("pod2", false),
+			v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "pod3"}},
+			*workloadStartupsPod("pod4", false),
+			other,
+		)), nil)
+		w.now = nowFunc
+
+		acquired, inUse, err := w.Acquire(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod4", false),
+			2,
+		)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+		assert.Equal(t, 2, inUse)
+		assert.Equal(t, map[types.NamespacedName]time.Time{name("pod4"): now}, w.pending[key])
+	})
+
+	t.Run("AlreadyHolder", func(t *testing.T) {
+		w := newWorkloadStartups(kubetest.NewMockPodHelper(listFunc(*workloadStartupsPod("pod1", true))), nil)
+
+		acquired, inUse, err := w.Acquire(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod1", true),
+			1,
+		)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+		assert.Equal(t, 1, inUse)
+		assert.Empty(t, w.pending)
+	})
+
+	t.Run("LimitReachedHeld", func(t *testing.T) {
+		w := newWorkloadStartups(kubetest.NewMockPodHelper(listFunc(
+			*workloadStartupsPod("pod1", true),
+			*workloadStartupsPod("pod2", false),
+		)), nil)
+
+		acquired, inUse, err := w.Acquire(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod2", false),
+			1,
+		)
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+		assert.Equal(t, 1, inUse)
+		assert.Empty(t, w.pending)
+	})
+
+	t.Run("LimitReachedPending", func(t *testing.T) {
+		w := newWorkloadStartups(kubetest.NewMockPodHelper(listFunc(
+			*workloadStartupsPod("pod1", false),
+			*workloadStartupsPod("pod2", false),
+		)), nil)
+		w.now = nowFunc
+		w.pending[key] = map[types.NamespacedName]time.Time{name("pod1"): now}
+
+		acquired, inUse, err := w.Acquire(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod2", false),
+			1,
+		)
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+		assert.Equal(t, 1, inUse)
+	})
+
+	t.Run("AcquiredAfterPendingRemoved", func(t *testing.T) {
+		w := newWorkloadStartups(kubetest.NewMockPodHelper(listFunc(
+			*workloadStartupsPod("expired", false),
+			*workloadStartupsPod("pod3", false),
+		)), nil)
+		w.now = nowFunc
+		w.pending[key] = map[types.NamespacedName]time.Time{
+			name("deleted"): now,
+			name("expired"): now.Add(-workloadStartupPendingPeriod),
+		}
+
+		acquired, inUse, err := w.Acquire(
+			contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build(),
+			workloadStartupsPod("pod3", false),
+			1,
+		)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+		assert.Equal(t, 1, inUse)
+		assert.Equal(t, map[types.NamespacedName]time.Time{name("pod3"): now}, w.pending[key])
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		var pods []v1.Pod
+		for i := 0; i < 10; i++ {
+			pods = append(pods, *workloadStartupsPod(strconv.Itoa(i), false))
+		}
+		w := newWorkloadStartups(kubetest.NewMockPodHelper(listFunc(pods...)), nil)
+
+		ctx := contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build()
+		var acquiredCount atomic.Int32
+		var wg sync.WaitGroup
+		for i := range pods {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				acquired, _, err := w.Acquire(ctx, &pods[i], 3)
+				assert.NoError(t, err)
+				if acquired {
+					acquiredCount.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(3), acquiredCount.Load())
+	})
+}
+
+func TestMaxConcurrentStartupsFor(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      *string
+		want       int
+		wantErrMsg string
+	}{
+		{"NotPresent", nil, 0, ""},
+		{"NotInteger", ptr("test"), 0, "'" + kubecommon.AnnotationMaxConcurrentStartups + "' annotation value must be a positive integer ('test')"},
+		{"NotPositive", ptr("0"), 0, "'" + kubecommon.AnnotationMaxConcurrentStartups + "' annotation value must be a positive integer ('0')"},
+		{"Ok", ptr("2"), 2, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{}
+			if tt.value != nil {
+				pod.Annotations = map[string]string{kubecommon.AnnotationMaxConcurrentStartups: *tt.value}
+			}
+
+			got, err := maxConcurrentStartupsFor(pod)
+			if tt.wantErrMsg != "" {
+				assert.EqualError(t, err, tt.wantErrMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWorkloadKeyFor(t *testing.T) {
+	isController := true
+	newPod := func(owner *metav1.OwnerReference, labels map[string]string) *v1.Pod {
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Labels: labels}}
+		if owner != nil {
+			pod.OwnerReferences = []metav1.OwnerReference{*owner}
+		}
+		return pod
+	}
+
+	tests := []struct {
+		name string
+		pod  *v1.Pod
+		want string
+	}{
+		{"NoOwner", newPod(nil, nil), ""},
+		{"NotController", newPod(&metav1.OwnerReference{Kind: "ReplicaSet", Name: "replicaset"}, nil), ""},
+		{
+			"StatefulSet",
+			newPod(&metav1.OwnerReference{Kind: "StatefulSet", Name: "statefulset", Controller: &isController}, nil),
+			"namespace/StatefulSet/statefulset",
+		},
+		{
+			"ReplicaSetWithoutHash",
+			newPod(&metav1.OwnerReference{Kind: "ReplicaSet", Name: "replicaset", Controller: &isController}, nil),
+			"namespace/ReplicaSet/replicaset",
+		},
+		{
+			"ReplicaSetHashMismatch",
+			newPod(
+				&metav1.OwnerReference{Kind: "ReplicaSet", Name: "replicaset", Controller: &isController},
+				map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "abc"},
+			),
+			"namespace/ReplicaSet/replicaset",
+		},
+		{
+			"Deployment",
+			newPod(
+				&metav1.OwnerReference{Kind: "ReplicaSet", Name: "deployment-abc", Controller: &isController},
+				map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "abc"},
+			),
+			"namespace/Deployment/deployment",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, workloadKeyFor(tt.pod))
+		})
+	}
+}
+
+func TestHoldsStartupResources(t *testing.T) {
+	t.Run("NoStatusAnnotation", func(t *testing.T) {
+		assert.False(t, holdsStartupResources(&v1.Pod{}))
+	})
+
+	t.Run("NotHeld", func(t *testing.T) {
+		assert.False(t, holdsStartupResources(workloadStartupsPod("pod", false)))
+	})
+
+	t.Run("Held", func(t *testing.T) {
+		assert.True(t, holdsStartupResources(workloadStartupsPod("pod", true)))
+	})
+}
+
+func workloadStartupsPod(name string, held bool) *v1.Pod {
+	isController := true
+	scale := podcommon.NewEmptyStatusAnnotationScale([]v1.ResourceName{v1.ResourceCPU})
+	scale.StartupResourcesHeld = held
+
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "namespace",
+			Name:      name,
+			Labels:    map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "abc"},
+			Annotations: map[string]string{
+				kubecommon.AnnotationStatus: podcommon.NewStatusAnnotation("test", scale, "").Json(),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "ReplicaSet", Name: "deployment-abc", Controller: &isController},
+			},
+		},
+	}
+}
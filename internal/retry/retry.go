@@ -18,14 +18,29 @@ package retry
 
 import (
 	"context"
+	"math/rand/v2"
 	"time"
 
 	ccontext "github.com/ExpediaGroup/container-startup-autoscaler/internal/context"
 	"github.com/avast/retry-go/v4"
 )
 
+const (
+	// StrategyFixed indicates that retry attempts are separated by a fixed delay.
+	StrategyFixed = "fixed"
+
+	// StrategyExponential indicates that the delay between retry attempts doubles upon each attempt, up to a maximum.
+	StrategyExponential = "exponential"
+
+	// StrategyExponentialJitter indicates that the delay between retry attempts is a random duration between zero and
+	// that of StrategyExponential ('full jitter').
+	StrategyExponentialJitter = "exponential-jitter"
+)
+
+// randInt64N is replaceable for test purposes.
+var randInt64N = rand.Int64N
+
 var baseConfig = []retry.Option{
-	retry.DelayType(retry.FixedDelay),
 	retry.RetryIf(retry.IsRecoverable),
 	retry.LastErrorOnly(true),
 }
@@ -33,10 +48,38 @@ var baseConfig = []retry.Option{
 // StandardRetryConfig returns the configuration necessary to perform a standard retry.
 func StandardRetryConfig(ctx context.Context) []retry.Option {
 	newConfig := append(baseConfig, retry.Attempts(uint(ccontext.StandardRetryAttempts(ctx))))
-	newConfig = append(newConfig, retry.Delay(time.Duration(ccontext.StandardRetryDelaySecs(ctx))*time.Second))
+	newConfig = append(newConfig, retry.DelayType(StandardDelayFunc(ctx)))
 	return append(newConfig, retry.Context(ctx))
 }
 
+// StandardDelayFunc returns a function that returns the delay before each standard retry attempt, according to the
+// strategy within ctx.
+func StandardDelayFunc(ctx context.Context) retry.DelayTypeFunc {
+	delay := time.Duration(ccontext.StandardRetryDelaySecs(ctx)) * time.Second
+	maxDelay := time.Duration(ccontext.StandardRetryMaxDelaySecs(ctx)) * time.Second
+
+	// n is 1 for the first retry attempt.
+	switch ccontext.StandardRetryStrategy(ctx) {
+	case StrategyExponential:
+		return func(n uint, _ error, _ *retry.Config) time.Duration {
+			return exponentialDelay(max(n, 1)-1, delay, maxDelay)
+		}
+	case StrategyExponentialJitter:
+		return func(n uint, _ error, _ *retry.Config) time.Duration {
+			exponential := exponentialDelay(max(n, 1)-1, delay, maxDelay)
+			if exponential <= 0 {
+				return 0
+			}
+
+			return time.Duration(randInt64N(int64(exponential) + 1))
+		}
+	default:
+		return func(uint, error, *retry.Config) time.Duration {
+			return delay
+		}
+	}
+}
+
 // DoStandardRetry performs a standard retry for the supplied function.
 func DoStandardRetry(ctx context.Context, function retry.RetryableFunc) error {
 	return retry.Do(function, StandardRetryConfig(ctx)...)
@@ -47,3 +90,12 @@ func DoStandardRetryWithMoreOpts(ctx context.Context, function retry.RetryableFu
 	opts := append(StandardRetryConfig(ctx), moreOpts...)
 	return retry.Do(function, opts...)
 }
+
+// exponentialDelay returns delay doubled n times, capped at maxDelay.
+func exponentialDelay(n uint, delay time.Duration, maxDelay time.Duration) time.Duration {
+	for i := uint(0); i < n && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDelay)
+}
@@ -19,6 +19,7 @@ package retry
 import (
 	"bytes"
 	"errors"
+	"math/rand/v2"
 	"testing"
 	"time"
 
@@ -30,7 +31,62 @@ import (
 
 func TestStandardRetryConfig(t *testing.T) {
 	got := StandardRetryConfig(contexttest.NewCtxBuilder(contexttest.NewNoRetryCtxConfig(nil)).Build())
-	assert.Len(t, got, 6)
+	assert.Len(t, got, 5)
+}
+
+func TestStandardDelayFunc(t *testing.T) {
+	randInt64N = func(n int64) int64 { return n / 2 }
+	defer func() { randInt64N = rand.Int64N }()
+
+	tests := []struct {
+		name     string
+		strategy string
+		want     []time.Duration
+	}{
+		{"NotSet", "", []time.Duration{2 * time.Second, 2 * time.Second, 2 * time.Second, 2 * time.Second}},
+		{"Fixed", StrategyFixed, []time.Duration{2 * time.Second, 2 * time.Second, 2 * time.Second, 2 * time.Second}},
+		{
+			"Exponential",
+			StrategyExponential,
+			[]time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second},
+		},
+		{
+			"ExponentialJitter",
+			StrategyExponentialJitter,
+			[]time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := contexttest.NewCtxBuilder(contexttest.NewCtxConfig()).
+				StandardRetryDelaySecs(2).
+				StandardRetryMaxDelaySecs(10).
+				StandardRetryStrategy(tt.strategy).
+				Build()
+
+			delayFunc := StandardDelayFunc(ctx)
+			for n, want := range tt.want {
+				assert.Equal(t, want, delayFunc(uint(n+1), nil, nil))
+			}
+		})
+	}
+
+	t.Run("ExponentialJitterZeroDelay", func(t *testing.T) {
+		ctx := contexttest.NewCtxBuilder(contexttest.NewCtxConfig()).
+			StandardRetryDelaySecs(0).
+			StandardRetryMaxDelaySecs(10).
+			StandardRetryStrategy(StrategyExponentialJitter).
+			Build()
+
+		assert.Equal(t, time.Duration(0), StandardDelayFunc(ctx)(1, nil, nil))
+	})
+}
+
+func TestExponentialDelay(t *testing.T) {
+	assert.Equal(t, time.Second, exponentialDelay(0, time.Second, time.Minute))
+	assert.Equal(t, 8*time.Second, exponentialDelay(3, time.Second, time.Minute))
+	assert.Equal(t, time.Minute, exponentialDelay(100, time.Second, time.Minute))
+	assert.Equal(t, 5*time.Second, exponentialDelay(0, 10*time.Second, 5*time.Second))
 }
 
 func TestDoStandardRetry(t *testing.T) {
@@ -0,0 +1,96 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+)
+
+// virtualNodesPerMember is the number of points each member occupies on the ring. More points give a more even
+// distribution of keys between members.
+const virtualNodesPerMember = 128
+
+// ring is an immutable consistent hash ring. Each key is owned by the member of the first point on the ring at or
+// after the key's hash, so that only around 1/n of keys move when a member joins or leaves.
+type ring struct {
+	members []string
+	points  []uint64
+	owners  []string
+}
+
+func newRing(members []string) *ring {
+	r := &ring{members: members}
+
+	type point struct {
+		hash  uint64
+		owner string
+	}
+	points := make([]point, 0, len(members)*virtualNodesPerMember)
+	for _, member := range members {
+		for i := 0; i < virtualNodesPerMember; i++ {
+			points = append(points, point{hash: hashOf(fmt.Sprintf("%s#%d", member, i)), owner: member})
+		}
+	}
+
+	// Ties are broken by owner so that all replicas build an identical ring.
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].hash == points[j].hash {
+			return points[i].owner < points[j].owner
+		}
+		return points[i].hash < points[j].hash
+	})
+
+	for _, p := range points {
+		r.points = append(r.points, p.hash)
+		r.owners = append(r.owners, p.owner)
+	}
+
+	return r
+}
+
+// owner returns the member that owns key, or an empty string if the ring has no members.
+func (r *ring) owner(key string) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+
+	hash := hashOf(key)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= hash })
+	if i == len(r.points) {
+		// Wrap around.
+		i = 0
+	}
+
+	return r.owners[i]
+}
+
+// hashOf returns the 64-bit FNV-1a hash of s, finalized with the MurmurHash3 64-bit mixer since FNV alone distributes
+// similar keys (e.g. pods of the same workload) poorly around the ring.
+func hashOf(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+
+	hash := h.Sum64()
+	hash ^= hash >> 33
+	hash *= 0xff51afd7ed558ccd
+	hash ^= hash >> 33
+	hash *= 0xc4ceb9fe1a85ec53
+	hash ^= hash >> 33
+	return hash
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRing(t *testing.T) {
+	r := newRing([]string{"a", "b"})
+	assert.Equal(t, []string{"a", "b"}, r.members)
+	assert.Len(t, r.points, 2*virtualNodesPerMember)
+	assert.Len(t, r.owners, 2*virtualNodesPerMember)
+	assert.IsIncreasing(t, r.points)
+}
+
+func TestRingOwner(t *testing.T) {
+	t.Run("NoMembers", func(t *testing.T) {
+		assert.Equal(t, "", newRing(nil).owner("key"))
+	})
+
+	t.Run("OneMember", func(t *testing.T) {
+		assert.Equal(t, "a", newRing([]string{"a"}).owner("key"))
+	})
+
+	t.Run("Deterministic", func(t *testing.T) {
+		r1, r2 := newRing([]string{"a", "b", "c"}), newRing([]string{"a", "b", "c"})
+		for i := 0; i < 100; i++ {
+			key := fmt.Sprintf("namespace/pod-%d", i)
+			assert.Equal(t, r1.owner(key), r2.owner(key))
+		}
+	})
+
+	t.Run("Distributed", func(t *testing.T) {
+		r := newRing([]string{"a", "b", "c"})
+		counts := map[string]int{}
+		for i := 0; i < 3000; i++ {
+			counts[r.owner(fmt.Sprintf("namespace/pod-%d", i))]++
+		}
+
+		for _, member := range []string{"a", "b", "c"} {
+			assert.Greater(t, counts[member], 500, member)
+		}
+	})
+
+	t.Run("MinimalMovementOnJoin", func(t *testing.T) {
+		before, after := newRing([]string{"a", "b", "c"}), newRing([]string{"a", "b", "c", "d"})
+		moved := 0
+		for i := 0; i < 3000; i++ {
+			key := fmt.Sprintf("namespace/pod-%d", i)
+			ownerBefore, ownerAfter := before.owner(key), after.owner(key)
+			if ownerBefore != ownerAfter {
+				// Keys only move to the joining member.
+				assert.Equal(t, "d", ownerAfter)
+				moved++
+			}
+		}
+
+		assert.Less(t, moved, 1500)
+	})
+}
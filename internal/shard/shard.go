@@ -0,0 +1,283 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/common"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	metricsshard "github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/shard"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/shard/shardcommon"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// leaseDuration is how long a member's Lease remains valid after it was last renewed. Members whose Leases have
+	// expired are no longer considered members.
+	leaseDuration = 30 * time.Second
+
+	// renewInterval is how frequently members renew their Lease and observe membership.
+	renewInterval = 10 * time.Second
+
+	// leaveTimeout is how long to wait for a member's Lease to be deleted upon leaving.
+	leaveTimeout = 5 * time.Second
+)
+
+// shard is the default implementation of shardcommon.Shard. Each member maintains its own Lease within a group, and
+// pods are distributed between members whose Leases haven't expired via a consistent hash of their namespace/name.
+type shard struct {
+	client        client.Client
+	apiReader     client.Reader
+	namespace     string
+	group         string
+	identity      string
+	onRebalance   func()
+	ring          atomic.Pointer[ring]
+	lastRenewed   time.Time
+	now           func() time.Time
+	renewInterval time.Duration
+}
+
+// NewShard returns a shardcommon.Shard that coordinates membership via Leases within namespace. Leases are read via
+// apiReader so that they're not cached. onRebalance is invoked each time ownership changes.
+func NewShard(
+	client client.Client,
+	apiReader client.Reader,
+	namespace string,
+	group string,
+	identity string,
+	onRebalance func(),
+) shardcommon.Shard {
+	return &shard{
+		client:        client,
+		apiReader:     apiReader,
+		namespace:     namespace,
+		group:         group,
+		identity:      identity,
+		onRebalance:   onRebalance,
+		now:           time.Now,
+		renewInterval: renewInterval,
+	}
+}
+
+// Start joins the group and periodically renews membership until ctx is done, after which the group is left. Nothing
+// is owned until membership is first observed.
+func (s *shard) Start(ctx context.Context) error {
+	defer s.leave()
+
+	ticker := time.NewTicker(s.renewInterval)
+	defer ticker.Stop()
+
+	for {
+		s.sync(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable so that membership is maintained regardless of
+// leadership.
+func (s *shard) NeedLeaderElection() bool {
+	return false
+}
+
+// Owns returns whether this member owns the pod with the supplied namespace and name.
+func (s *shard) Owns(namespace string, name string) bool {
+	r := s.ring.Load()
+	if r == nil {
+		return false
+	}
+
+	return r.owner(fmt.Sprintf("%s/%s", namespace, name)) == s.identity
+}
+
+// sync renews this member's Lease and observes current membership. If this member's Lease can't be renewed for longer
+// than the Lease duration, other members will consider it to have left, so it relinquishes ownership of all pods to
+// avoid reconciling pods that other members now own.
+func (s *shard) sync(ctx context.Context) {
+	if err := s.renew(ctx); err != nil {
+		logging.Errorf(nil, err, "unable to renew shard membership lease")
+		if s.now().Sub(s.lastRenewed) > leaseDuration {
+			s.setMembers(nil)
+		}
+		return
+	}
+	s.lastRenewed = s.now()
+
+	members, err := s.liveMembers(ctx)
+	if err != nil {
+		logging.Errorf(nil, err, "unable to observe shard membership (ownership unchanged)")
+		return
+	}
+
+	s.setMembers(members)
+}
+
+// renew creates or renews this member's Lease.
+func (s *shard) renew(ctx context.Context) error {
+	now := metav1.NewMicroTime(s.now())
+	durationSecs := int32(leaseDuration.Seconds())
+
+	lease := &coordinationv1.Lease{}
+	err := s.apiReader.Get(ctx, types.NamespacedName{Namespace: s.namespace, Name: s.leaseName()}, lease)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return common.WrapErrorf(err, "unable to get lease")
+		}
+
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: s.namespace,
+				Name:      s.leaseName(),
+				Labels:    map[string]string{kubecommon.LabelShardGroup: s.group},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &s.identity,
+				LeaseDurationSeconds: &durationSecs,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		if err = s.client.Create(ctx, lease); err != nil {
+			return common.WrapErrorf(err, "unable to create lease")
+		}
+
+		return nil
+	}
+
+	lease.Spec.HolderIdentity = &s.identity
+	lease.Spec.LeaseDurationSeconds = &durationSecs
+	lease.Spec.RenewTime = &now
+	if err = s.client.Update(ctx, lease); err != nil {
+		return common.WrapErrorf(err, "unable to update lease")
+	}
+
+	return nil
+}
+
+// liveMembers returns the sorted identities of members whose Leases haven't expired.
+func (s *shard) liveMembers(ctx context.Context) ([]string, error) {
+	leases := &coordinationv1.LeaseList{}
+	if err := s.apiReader.List(
+		ctx,
+		leases,
+		client.InNamespace(s.namespace),
+		client.MatchingLabels{kubecommon.LabelShardGroup: s.group},
+	); err != nil {
+		return nil, common.WrapErrorf(err, "unable to list leases")
+	}
+
+	now := s.now()
+	var members []string
+	for _, lease := range leases.Items {
+		spec := lease.Spec
+		if spec.HolderIdentity == nil || spec.RenewTime == nil || spec.LeaseDurationSeconds == nil {
+			continue
+		}
+
+		expiry := spec.RenewTime.Add(time.Duration(*spec.LeaseDurationSeconds) * time.Second)
+		if now.Before(expiry) && !slices.Contains(members, *spec.HolderIdentity) {
+			members = append(members, *spec.HolderIdentity)
+		}
+	}
+
+	slices.Sort(members)
+	return members, nil
+}
+
+// setMembers rebuilds the ring if members differ from current membership, and notifies of the rebalance.
+func (s *shard) setMembers(members []string) {
+	var currentMembers []string
+	if current := s.ring.Load(); current != nil {
+		currentMembers = current.members
+	}
+
+	if slices.Equal(currentMembers, members) {
+		return
+	}
+
+	if len(members) == 0 {
+		s.ring.Store(nil)
+	} else {
+		s.ring.Store(newRing(members))
+	}
+
+	logging.Infof(
+		nil, logging.VInfo,
+		"shard membership changed (%d members: %s)",
+		len(members), strings.Join(members, ", "),
+	)
+	metricsshard.Members().Set(float64(len(members)))
+	metricsshard.Rebalance().Inc()
+
+	if s.onRebalance != nil {
+		s.onRebalance()
+	}
+}
+
+// leave deletes this member's Lease so that other members rebalance immediately rather than once it expires.
+func (s *shard) leave() {
+	ctx, cancel := context.WithTimeout(context.Background(), leaveTimeout)
+	defer cancel()
+
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Namespace: s.namespace, Name: s.leaseName()},
+	}
+	if err := s.client.Delete(ctx, lease); err != nil && !kerrors.IsNotFound(err) {
+		logging.Errorf(nil, err, "unable to delete shard membership lease upon leaving")
+		return
+	}
+
+	logging.Infof(nil, logging.VInfo, "left shard group")
+}
+
+// leaseName returns the name of this member's Lease.
+func (s *shard) leaseName() string {
+	return fmt.Sprintf("%s-shard-%s", s.group, s.identity)
+}
+
+// unsharded is an implementation of shardcommon.Shard for when sharding isn't enabled, which owns all pods.
+type unsharded struct{}
+
+func NewUnsharded() shardcommon.Shard {
+	return &unsharded{}
+}
+
+// Start returns immediately since there's no membership to maintain.
+func (u *unsharded) Start(_ context.Context) error {
+	return nil
+}
+
+// Owns always returns true.
+func (u *unsharded) Owns(_ string, _ string) bool {
+	return true
+}
@@ -0,0 +1,340 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubecommon"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/kube/kubetest"
+	"github.com/ExpediaGroup/container-startup-autoscaler/internal/logging"
+	metricsshard "github.com/ExpediaGroup/container-startup-autoscaler/internal/metrics/shard"
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/component-base/metrics/testutil"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const (
+	testNamespace = "namespace"
+	testGroup     = "group"
+	testIdentity  = "member1"
+)
+
+var testNow = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func TestNewShard(t *testing.T) {
+	c := fake.NewClientBuilder().Build()
+	got := NewShard(c, c, testNamespace, testGroup, testIdentity, func() {}).(*shard)
+	assert.Equal(t, c, got.client)
+	assert.Equal(t, c, got.apiReader)
+	assert.Equal(t, testNamespace, got.namespace)
+	assert.Equal(t, testGroup, got.group)
+	assert.Equal(t, testIdentity, got.identity)
+	assert.NotNil(t, got.onRebalance)
+	assert.NotNil(t, got.now)
+	assert.Equal(t, renewInterval, got.renewInterval)
+}
+
+func TestShardStart(t *testing.T) {
+	c := fake.NewClientBuilder().Build()
+	rebalanced := make(chan struct{}, 1)
+	s := NewShard(c, c, testNamespace, testGroup, testIdentity, func() { rebalanced <- struct{}{} }).(*shard)
+	s.renewInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- s.Start(ctx)
+	}()
+
+	select {
+	case <-rebalanced:
+	case <-time.After(time.Second):
+		assert.Fail(t, "not rebalanced")
+	}
+	assert.True(t, s.Owns("namespace", "name"))
+
+	cancel()
+	assert.NoError(t, <-done)
+
+	// Lease is deleted upon leaving.
+	leases := &coordinationv1.LeaseList{}
+	assert.NoError(t, c.List(context.Background(), leases))
+	assert.Empty(t, leases.Items)
+}
+
+func TestShardNeedLeaderElection(t *testing.T) {
+	assert.False(t, (&shard{}).NeedLeaderElection())
+}
+
+func TestShardOwns(t *testing.T) {
+	t.Run("NoRing", func(t *testing.T) {
+		s := &shard{identity: testIdentity}
+		assert.False(t, s.Owns("namespace", "name"))
+	})
+
+	t.Run("SoleMember", func(t *testing.T) {
+		s := &shard{identity: testIdentity}
+		s.ring.Store(newRing([]string{testIdentity}))
+		assert.True(t, s.Owns("namespace", "name"))
+	})
+
+	t.Run("OtherMember", func(t *testing.T) {
+		s := &shard{identity: testIdentity}
+		s.ring.Store(newRing([]string{"member2"}))
+		assert.False(t, s.Owns("namespace", "name"))
+	})
+}
+
+func TestShardSync(t *testing.T) {
+	tests := []struct {
+		name        string
+		client      client.Client
+		lastRenewed time.Time
+		initialRing []string
+		wantMembers []string
+		wantLogMsg  string
+	}{
+		{
+			name:        "UnableToRenewWithinLeaseDuration",
+			client:      fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{Get: kubetest.InterceptorFuncGetFail()}).Build(),
+			lastRenewed: testNow.Add(-leaseDuration),
+			initialRing: []string{testIdentity},
+			wantMembers: []string{testIdentity},
+			wantLogMsg:  "unable to renew shard membership lease",
+		},
+		{
+			name:        "UnableToRenewBeyondLeaseDuration",
+			client:      fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{Get: kubetest.InterceptorFuncGetFail()}).Build(),
+			lastRenewed: testNow.Add(-leaseDuration - time.Second),
+			initialRing: []string{testIdentity},
+			wantMembers: nil,
+			wantLogMsg:  "unable to renew shard membership lease",
+		},
+		{
+			name: "UnableToObserveMembership",
+			client: fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+				List: func(_ context.Context, _ client.WithWatch, _ client.ObjectList, _ ...client.ListOption) error {
+					return errors.New("")
+				},
+			}).Build(),
+			initialRing: []string{testIdentity, "member2"},
+			wantMembers: []string{testIdentity, "member2"},
+			wantLogMsg:  "unable to observe shard membership (ownership unchanged)",
+		},
+		{
+			name: "Ok",
+			client: fake.NewClientBuilder().WithObjects(
+				testLease("member2", testNow.Add(-leaseDuration/2)),
+				testLease("member3", testNow.Add(-leaseDuration)),
+			).Build(),
+			wantMembers: []string{testIdentity, "member2"},
+			wantLogMsg:  "shard membership changed (2 members: member1, member2)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewShard(tt.client, tt.client, testNamespace, testGroup, testIdentity, nil).(*shard)
+			s.now = func() time.Time { return testNow }
+			s.lastRenewed = tt.lastRenewed
+			if tt.initialRing != nil {
+				s.ring.Store(newRing(tt.initialRing))
+			}
+
+			buffer := &bytes.Buffer{}
+			logging.Init(buffer, logging.VTrace, false)
+			s.sync(context.Background())
+
+			var gotMembers []string
+			if r := s.ring.Load(); r != nil {
+				gotMembers = r.members
+			}
+			assert.Equal(t, tt.wantMembers, gotMembers)
+			assert.Contains(t, buffer.String(), tt.wantLogMsg)
+		})
+	}
+}
+
+func TestShardRenew(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		c := fake.NewClientBuilder().Build()
+		s := NewShard(c, c, testNamespace, testGroup, testIdentity, nil).(*shard)
+		s.now = func() time.Time { return testNow }
+		assert.NoError(t, s.renew(context.Background()))
+
+		lease := &coordinationv1.Lease{}
+		assert.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: testNamespace, Name: "group-shard-member1"}, lease))
+		assert.Equal(t, testGroup, lease.Labels[kubecommon.LabelShardGroup])
+		assert.Equal(t, testIdentity, *lease.Spec.HolderIdentity)
+		assert.Equal(t, int32(leaseDuration.Seconds()), *lease.Spec.LeaseDurationSeconds)
+		assert.True(t, testNow.Equal(lease.Spec.RenewTime.Time))
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithObjects(testLease(testIdentity, testNow.Add(-time.Minute))).Build()
+		s := NewShard(c, c, testNamespace, testGroup, testIdentity, nil).(*shard)
+		s.now = func() time.Time { return testNow }
+		assert.NoError(t, s.renew(context.Background()))
+
+		lease := &coordinationv1.Lease{}
+		assert.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: testNamespace, Name: "group-shard-member1"}, lease))
+		assert.True(t, testNow.Equal(lease.Spec.RenewTime.Time))
+	})
+
+	t.Run("UnableToGet", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{Get: kubetest.InterceptorFuncGetFail()}).Build()
+		s := NewShard(c, c, testNamespace, testGroup, testIdentity, nil).(*shard)
+		assert.ErrorContains(t, s.renew(context.Background()), "unable to get lease")
+	})
+
+	t.Run("UnableToCreate", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.CreateOption) error {
+				return errors.New("")
+			},
+		}).Build()
+		s := NewShard(c, c, testNamespace, testGroup, testIdentity, nil).(*shard)
+		assert.ErrorContains(t, s.renew(context.Background()), "unable to create lease")
+	})
+
+	t.Run("UnableToUpdate", func(t *testing.T) {
+		c := fake.NewClientBuilder().
+			WithObjects(testLease(testIdentity, testNow)).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Update: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.UpdateOption) error {
+					return errors.New("")
+				},
+			}).
+			Build()
+		s := NewShard(c, c, testNamespace, testGroup, testIdentity, nil).(*shard)
+		assert.ErrorContains(t, s.renew(context.Background()), "unable to update lease")
+	})
+}
+
+func TestShardLiveMembers(t *testing.T) {
+	otherGroupLease := testLease("member4", testNow)
+	otherGroupLease.Labels[kubecommon.LabelShardGroup] = "other"
+	noHolderLease := testLease("member5", testNow)
+	noHolderLease.Spec.HolderIdentity = nil
+
+	c := fake.NewClientBuilder().WithObjects(
+		testLease("member2", testNow),
+		testLease(testIdentity, testNow.Add(-leaseDuration/2)),
+		testLease("member3", testNow.Add(-leaseDuration)),
+		otherGroupLease,
+		noHolderLease,
+	).Build()
+	s := NewShard(c, c, testNamespace, testGroup, testIdentity, nil).(*shard)
+	s.now = func() time.Time { return testNow }
+
+	members, err := s.liveMembers(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{testIdentity, "member2"}, members)
+}
+
+func TestShardSetMembers(t *testing.T) {
+	t.Run("Unchanged", func(t *testing.T) {
+		metricsshard.ResetMetrics()
+		rebalanced := false
+		s := &shard{onRebalance: func() { rebalanced = true }}
+		s.ring.Store(newRing([]string{testIdentity}))
+		s.setMembers([]string{testIdentity})
+		assert.False(t, rebalanced)
+		value, _ := testutil.GetCounterMetricValue(metricsshard.Rebalance())
+		assert.Equal(t, float64(0), value)
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		metricsshard.ResetMetrics()
+		rebalanced := false
+		s := &shard{onRebalance: func() { rebalanced = true }}
+		s.setMembers([]string{testIdentity, "member2"})
+		assert.True(t, rebalanced)
+		assert.Equal(t, []string{testIdentity, "member2"}, s.ring.Load().members)
+		value, _ := testutil.GetGaugeMetricValue(metricsshard.Members())
+		assert.Equal(t, float64(2), value)
+		value, _ = testutil.GetCounterMetricValue(metricsshard.Rebalance())
+		assert.Equal(t, float64(1), value)
+	})
+
+	t.Run("Emptied", func(t *testing.T) {
+		s := &shard{}
+		s.ring.Store(newRing([]string{testIdentity}))
+		s.setMembers(nil)
+		assert.Nil(t, s.ring.Load())
+	})
+}
+
+func TestShardLeave(t *testing.T) {
+	t.Run("UnableToDelete", func(t *testing.T) {
+		c := fake.NewClientBuilder().
+			WithInterceptorFuncs(interceptor.Funcs{
+				Delete: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.DeleteOption) error {
+					return errors.New("")
+				},
+			}).
+			Build()
+		s := NewShard(c, c, testNamespace, testGroup, testIdentity, nil).(*shard)
+
+		buffer := &bytes.Buffer{}
+		logging.Init(buffer, logging.VTrace, false)
+		s.leave()
+		assert.Contains(t, buffer.String(), "unable to delete shard membership lease upon leaving")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		c := fake.NewClientBuilder().Build()
+		s := NewShard(c, c, testNamespace, testGroup, testIdentity, nil).(*shard)
+
+		buffer := &bytes.Buffer{}
+		logging.Init(buffer, logging.VTrace, false)
+		s.leave()
+		assert.Contains(t, buffer.String(), "left shard group")
+	})
+}
+
+func TestUnsharded(t *testing.T) {
+	u := NewUnsharded()
+	assert.NoError(t, u.Start(context.Background()))
+	assert.True(t, u.Owns("namespace", "name"))
+}
+
+func testLease(identity string, renewTime time.Time) *coordinationv1.Lease {
+	durationSecs := int32(leaseDuration.Seconds())
+	renew := metav1.NewMicroTime(renewTime)
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testGroup + "-shard-" + identity,
+			Labels:    map[string]string{kubecommon.LabelShardGroup: testGroup},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &identity,
+			LeaseDurationSeconds: &durationSecs,
+			RenewTime:            &renew,
+		},
+	}
+}
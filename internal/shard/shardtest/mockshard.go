@@ -0,0 +1,61 @@
+/*
+Copyright 2025 Expedia Group, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shardtest
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockShard struct {
+	mock.Mock
+}
+
+func NewMockShard(configFunc func(*MockShard)) *MockShard {
+	m := &MockShard{}
+	if configFunc != nil {
+		configFunc(m)
+	} else {
+		m.AllDefaults()
+	}
+
+	return m
+}
+
+func (m *MockShard) Start(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockShard) Owns(namespace string, name string) bool {
+	args := m.Called(namespace, name)
+	return args.Bool(0)
+}
+
+func (m *MockShard) StartDefault() {
+	m.On("Start", mock.Anything).Return(nil)
+}
+
+func (m *MockShard) OwnsDefault() {
+	m.On("Owns", mock.Anything, mock.Anything).Return(true)
+}
+
+func (m *MockShard) AllDefaults() {
+	m.StartDefault()
+	m.OwnsDefault()
+}
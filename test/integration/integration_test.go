@@ -723,10 +723,14 @@ func TestValidationFailure(t *testing.T) {
 		require.Contains(t, statusAnn.Status, "cpu post-startup requests (150m) is greater than startup value (100m)")
 		require.NotEmpty(t, statusAnn.LastUpdated)
 		expectedScale := podcommon.StatusAnnotationScale{
-			EnabledForResources: []v1.ResourceName{v1.ResourceCPU},
-			LastCommanded:       "",
-			LastEnacted:         "",
-			LastFailed:          "",
+			EnabledForResources:      []v1.ResourceName{v1.ResourceCPU},
+			LastCommanded:            "",
+			LastEnacted:              "",
+			LastFailed:               "",
+			LastAppliedConfiguration: "",
+			AdmittedResources:        "",
+			Override:                 "",
+			DryRun:                   false,
 		}
 		require.Equal(t, expectedScale, statusAnn.Scale)
 	}